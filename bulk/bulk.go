@@ -0,0 +1,398 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+// Package bulk runs an operation (start, stop, reboot, scale, destroy or any
+// custom operation) on many virtual machines at once, with bounded concurrency
+// and a result per virtual machine.
+package bulk
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/xanzy/go-cloudstack/v2/cloudstack"
+	"github.com/xanzy/go-cloudstack/v2/internal/common"
+	"github.com/xanzy/go-cloudstack/v2/waiter"
+)
+
+// DefaultParallelism is the number of operations that run at the same time
+// when no other parallelism is configured
+const DefaultParallelism = 10
+
+// pageSize is the number of virtual machines listed per request
+const pageSize = 500
+
+// Operation is executed once per virtual machine. It returns the ID of the async
+// job that was started, or an empty string if the API call was synchronous.
+type Operation func(cs *cloudstack.CloudStackClient, vm *cloudstack.VirtualMachine) (string, error)
+
+// Start starts a virtual machine
+func Start() Operation {
+	return func(cs *cloudstack.CloudStackClient, vm *cloudstack.VirtualMachine) (string, error) {
+		r, err := cs.VirtualMachine.StartVirtualMachine(cs.VirtualMachine.NewStartVirtualMachineParams(vm.Id))
+		if err != nil {
+			return "", err
+		}
+		return r.JobID, nil
+	}
+}
+
+// Stop stops a virtual machine, optionally forcing the stop
+func Stop(forced bool) Operation {
+	return func(cs *cloudstack.CloudStackClient, vm *cloudstack.VirtualMachine) (string, error) {
+		p := cs.VirtualMachine.NewStopVirtualMachineParams(vm.Id)
+		if forced {
+			p.SetForced(true)
+		}
+		r, err := cs.VirtualMachine.StopVirtualMachine(p)
+		if err != nil {
+			return "", err
+		}
+		return r.JobID, nil
+	}
+}
+
+// Reboot reboots a virtual machine
+func Reboot() Operation {
+	return func(cs *cloudstack.CloudStackClient, vm *cloudstack.VirtualMachine) (string, error) {
+		r, err := cs.VirtualMachine.RebootVirtualMachine(cs.VirtualMachine.NewRebootVirtualMachineParams(vm.Id))
+		if err != nil {
+			return "", err
+		}
+		return r.JobID, nil
+	}
+}
+
+// Scale changes the service offering of a (running) virtual machine
+func Scale(serviceofferingid string) Operation {
+	return func(cs *cloudstack.CloudStackClient, vm *cloudstack.VirtualMachine) (string, error) {
+		r, err := cs.VirtualMachine.ScaleVirtualMachine(cs.VirtualMachine.NewScaleVirtualMachineParams(vm.Id, serviceofferingid))
+		if err != nil {
+			return "", err
+		}
+		return r.JobID, nil
+	}
+}
+
+// Destroy destroys a virtual machine, optionally expunging it immediately
+func Destroy(expunge bool) Operation {
+	return func(cs *cloudstack.CloudStackClient, vm *cloudstack.VirtualMachine) (string, error) {
+		p := cs.VirtualMachine.NewDestroyVirtualMachineParams(vm.Id)
+		if expunge {
+			p.SetExpunge(true)
+		}
+		r, err := cs.VirtualMachine.DestroyVirtualMachine(p)
+		if err != nil {
+			return "", err
+		}
+		return r.JobID, nil
+	}
+}
+
+// Filter selects the virtual machines to run an operation on. If Params is nil,
+// all virtual machines (matching the tags) are selected. Paging parameters in
+// Params are overwritten, as all pages are listed.
+type Filter struct {
+	Params *cloudstack.ListVirtualMachinesParams
+	Tags   map[string]string
+}
+
+// Result is the result of running an operation on a single virtual machine
+type Result struct {
+	VirtualMachine *cloudstack.VirtualMachine
+	JobID          string
+	Err            error
+	Skipped        bool // True if the operation did not run (dry-run or cancelled)
+}
+
+// Results are the results of running an operation on many virtual machines
+type Results []*Result
+
+// Failed returns the results of the virtual machines the operation failed for
+func (rs Results) Failed() Results {
+	var failed Results
+	for _, r := range rs {
+		if r.Err != nil {
+			failed = append(failed, r)
+		}
+	}
+	return failed
+}
+
+// Option can be passed to New to set custom options
+type Option func(*Executor)
+
+// WithParallelism sets the maximum number of operations running at the same time
+func WithParallelism(n int) Option {
+	return func(e *Executor) {
+		if n > 0 {
+			e.parallelism = n
+		}
+	}
+}
+
+// WithHostLimit sets the maximum number of operations running at the same time
+// for virtual machines on the same host (0 means no limit)
+func WithHostLimit(n int) Option {
+	return func(e *Executor) {
+		e.hostLimit = n
+	}
+}
+
+// WithClusterLimit sets the maximum number of operations running at the same
+// time for virtual machines in the same cluster (0 means no limit)
+func WithClusterLimit(n int) Option {
+	return func(e *Executor) {
+		e.clusterLimit = n
+	}
+}
+
+// WithDryRun only lists the targets, without running the operation
+func WithDryRun(dryRun bool) Option {
+	return func(e *Executor) {
+		e.dryRun = dryRun
+	}
+}
+
+// WithTimeout sets the time to wait for the async job of a single operation
+func WithTimeout(timeout time.Duration) Option {
+	return func(e *Executor) {
+		e.timeout = timeout
+	}
+}
+
+// WithOptions sets option functions (e.g. cloudstack.WithProject) that are
+// applied when listing the virtual machines and looking up their hosts
+func WithOptions(opts ...cloudstack.OptionFunc) Option {
+	return func(e *Executor) {
+		e.opts = append(e.opts, opts...)
+	}
+}
+
+// Executor runs operations on many virtual machines
+type Executor struct {
+	cs           *cloudstack.CloudStackClient
+	parallelism  int
+	hostLimit    int
+	clusterLimit int
+	dryRun       bool
+	timeout      time.Duration
+	opts         []cloudstack.OptionFunc
+}
+
+// New returns a new executor using the given client
+func New(cs *cloudstack.CloudStackClient, options ...Option) *Executor {
+	e := &Executor{
+		cs:          cs,
+		parallelism: DefaultParallelism,
+	}
+
+	for _, fn := range options {
+		fn(e)
+	}
+
+	return e
+}
+
+// Targets returns all virtual machines selected by the filter
+func (e *Executor) Targets(f Filter) ([]*cloudstack.VirtualMachine, error) {
+	// The params are changed below, so copy them to leave those of the caller untouched
+	var p *cloudstack.ListVirtualMachinesParams
+	if f.Params != nil {
+		p = f.Params.Copy()
+	} else {
+		p = e.cs.VirtualMachine.NewListVirtualMachinesParams()
+	}
+	if len(f.Tags) > 0 {
+		p.SetTags(f.Tags)
+	}
+	if err := common.ApplyOptions(e.cs, p, e.opts...); err != nil {
+		return nil, err
+	}
+	p.SetPagesize(pageSize)
+
+	var vms []*cloudstack.VirtualMachine
+	for page := 1; ; page++ {
+		p.SetPage(page)
+
+		l, err := e.cs.VirtualMachine.ListVirtualMachines(p)
+		if err != nil {
+			return nil, err
+		}
+		vms = append(vms, l.VirtualMachines...)
+
+		if len(l.VirtualMachines) < pageSize || len(vms) >= l.Count {
+			return vms, nil
+		}
+	}
+}
+
+// Run runs the operation on all virtual machines selected by the filter and
+// returns a result for each of them, in the order they were listed. The
+// returned error is only set if the targets could not be determined; errors of
+// individual operations are part of the results.
+func (e *Executor) Run(ctx context.Context, f Filter, op Operation) (Results, error) {
+	vms, err := e.Targets(f)
+	if err != nil {
+		return nil, err
+	}
+	return e.RunOn(ctx, vms, op), nil
+}
+
+// RunOn runs the operation on the given virtual machines
+func (e *Executor) RunOn(ctx context.Context, vms []*cloudstack.VirtualMachine, op Operation) Results {
+	results := make(Results, len(vms))
+	for i, vm := range vms {
+		results[i] = &Result{VirtualMachine: vm, Skipped: true}
+	}
+
+	if e.dryRun {
+		return results
+	}
+
+	clusters := e.clusters(vms)
+	w := waiter.New(e.cs, waiter.WithTimeout(e.timeout))
+
+	global := newLimiter(e.parallelism)
+	hosts := newLimiter(e.hostLimit)
+	cluster := newLimiter(e.clusterLimit)
+
+	var wg sync.WaitGroup
+	for _, r := range results {
+		wg.Add(1)
+		go func(r *Result) {
+			defer wg.Done()
+
+			vm := r.VirtualMachine
+
+			// Always acquire in the same order, to prevent deadlocks
+			release, err := acquireAll(
+				func() (func(), error) { return cluster.acquire(ctx, clusters[vm.Hostid]) },
+				func() (func(), error) { return hosts.acquire(ctx, vm.Hostid) },
+				func() (func(), error) { return global.acquire(ctx, "*") },
+			)
+			if err != nil {
+				r.Err = err
+				return
+			}
+			defer release()
+
+			r.Skipped = false
+			r.JobID, r.Err = op(e.cs, vm)
+			if r.Err != nil || r.JobID == "" {
+				return
+			}
+
+			r.Err = w.WaitForJob(ctx, r.JobID)
+		}(r)
+	}
+	wg.Wait()
+
+	return results
+}
+
+// clusters returns the cluster ID of each host the virtual machines run on
+func (e *Executor) clusters(vms []*cloudstack.VirtualMachine) map[string]string {
+	clusters := make(map[string]string)
+	if e.clusterLimit <= 0 {
+		return clusters
+	}
+
+	for _, vm := range vms {
+		if vm.Hostid == "" {
+			continue
+		}
+		if _, ok := clusters[vm.Hostid]; ok {
+			continue
+		}
+
+		// If the host cannot be found the virtual machine is simply not
+		// limited per cluster, which is better than not running at all.
+		host, _, err := e.cs.Host.GetHostByID(vm.Hostid, e.opts...)
+		if err != nil {
+			clusters[vm.Hostid] = ""
+			continue
+		}
+		clusters[vm.Hostid] = host.Clusterid
+	}
+
+	return clusters
+}
+
+// limiter limits the number of concurrent operations per key
+type limiter struct {
+	mu    sync.Mutex
+	limit int
+	slots map[string]chan struct{}
+}
+
+func newLimiter(limit int) *limiter {
+	return &limiter{limit: limit, slots: make(map[string]chan struct{})}
+}
+
+// acquire blocks until a slot for the key is available and returns a function
+// releasing the slot. Empty keys (e.g. the host of a stopped virtual machine)
+// are not limited, and neither are any keys if the limit is 0.
+func (l *limiter) acquire(ctx context.Context, key string) (func(), error) {
+	// A free slot would win the select below at random, so check first
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("Operation cancelled: %w", err)
+	}
+	if l.limit <= 0 || key == "" {
+		return func() {}, nil
+	}
+
+	l.mu.Lock()
+	slot, ok := l.slots[key]
+	if !ok {
+		slot = make(chan struct{}, l.limit)
+		l.slots[key] = slot
+	}
+	l.mu.Unlock()
+
+	select {
+	case slot <- struct{}{}:
+		return func() { <-slot }, nil
+	case <-ctx.Done():
+		return nil, fmt.Errorf("Operation cancelled: %w", ctx.Err())
+	}
+}
+
+// acquireAll acquires all slots in order, releasing the acquired slots on error
+func acquireAll(fns ...func() (func(), error)) (func(), error) {
+	var releases []func()
+	release := func() {
+		for i := len(releases) - 1; i >= 0; i-- {
+			releases[i]()
+		}
+	}
+
+	for _, fn := range fns {
+		r, err := fn()
+		if err != nil {
+			release()
+			return nil, err
+		}
+		releases = append(releases, r)
+	}
+
+	return release, nil
+}
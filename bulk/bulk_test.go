@@ -0,0 +1,233 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package bulk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/xanzy/go-cloudstack/v2/cloudstack"
+)
+
+func TestLimiter(t *testing.T) {
+	l := newLimiter(1)
+
+	release, err := l.acquire(context.Background(), "h1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Other keys and empty keys are not blocked
+	if _, err := l.acquire(context.Background(), "h2"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := l.acquire(context.Background(), ""); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if _, err := l.acquire(ctx, "h1"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected the slot of h1 to be taken, got: %v", err)
+	}
+
+	release()
+	if _, err := l.acquire(context.Background(), "h1"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAcquireAll(t *testing.T) {
+	var released []string
+	slot := func(name string, err error) func() (func(), error) {
+		return func() (func(), error) {
+			if err != nil {
+				return nil, err
+			}
+			return func() { released = append(released, name) }, nil
+		}
+	}
+
+	release, err := acquireAll(slot("a", nil), slot("b", nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	release()
+	if strings.Join(released, ",") != "b,a" {
+		t.Fatalf("Expected the slots to be released in reverse order, got %v", released)
+	}
+
+	released = nil
+	if _, err := acquireAll(slot("a", nil), slot("b", errors.New("failed")), slot("c", nil)); err == nil {
+		t.Fatal("Expected an error")
+	}
+	if strings.Join(released, ",") != "a" {
+		t.Fatalf("Expected only the acquired slots to be released, got %v", released)
+	}
+}
+
+func newVirtualMachines(n int, host func(i int) string) []*cloudstack.VirtualMachine {
+	vms := make([]*cloudstack.VirtualMachine, n)
+	for i := range vms {
+		vms[i] = &cloudstack.VirtualMachine{Id: fmt.Sprintf("vm%d", i), Hostid: host(i)}
+	}
+	return vms
+}
+
+func TestRunOnLimits(t *testing.T) {
+	vms := newVirtualMachines(20, func(i int) string { return fmt.Sprintf("h%d", i%2) })
+
+	var mu sync.Mutex
+	running := make(map[string]int)
+	var total, maxTotal int
+	maxHost := make(map[string]int)
+
+	op := func(cs *cloudstack.CloudStackClient, vm *cloudstack.VirtualMachine) (string, error) {
+		mu.Lock()
+		running[vm.Hostid]++
+		total++
+		if running[vm.Hostid] > maxHost[vm.Hostid] {
+			maxHost[vm.Hostid] = running[vm.Hostid]
+		}
+		if total > maxTotal {
+			maxTotal = total
+		}
+		mu.Unlock()
+
+		time.Sleep(2 * time.Millisecond)
+
+		mu.Lock()
+		running[vm.Hostid]--
+		total--
+		mu.Unlock()
+
+		if vm.Id == "vm3" {
+			return "", errors.New("failed")
+		}
+		return "", nil
+	}
+
+	e := New(nil, WithParallelism(3), WithHostLimit(1))
+	results := e.RunOn(context.Background(), vms, op)
+
+	if maxTotal > 2 {
+		t.Fatalf("Expected at most 2 operations at once (one per host), got %d", maxTotal)
+	}
+	for host, n := range maxHost {
+		if n != 1 {
+			t.Fatalf("Expected at most 1 operation at once on %s, got %d", host, n)
+		}
+	}
+
+	for i, r := range results {
+		if r.VirtualMachine != vms[i] || r.Skipped {
+			t.Fatalf("Expected result %d to be for %s and not skipped", i, vms[i].Id)
+		}
+	}
+
+	failed := results.Failed()
+	if len(failed) != 1 || failed[0].VirtualMachine.Id != "vm3" {
+		t.Fatalf("Expected only vm3 to fail, got %d failures", len(failed))
+	}
+}
+
+func TestRunOnDryRunAndCancel(t *testing.T) {
+	vms := newVirtualMachines(3, func(int) string { return "" })
+
+	op := func(cs *cloudstack.CloudStackClient, vm *cloudstack.VirtualMachine) (string, error) {
+		t.Errorf("Unexpected operation on %s", vm.Id)
+		return "", nil
+	}
+
+	results := New(nil, WithDryRun(true)).RunOn(context.Background(), vms, op)
+	for _, r := range results {
+		if !r.Skipped || r.Err != nil {
+			t.Fatalf("Expected %s to be skipped without an error", r.VirtualMachine.Id)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	results = New(nil, WithParallelism(1)).RunOn(ctx, vms, op)
+	for _, r := range results {
+		if !r.Skipped || !errors.Is(r.Err, context.Canceled) {
+			t.Fatalf("Expected %s to be skipped as cancelled, got: %v", r.VirtualMachine.Id, r.Err)
+		}
+	}
+}
+
+func TestTargets(t *testing.T) {
+	const count = pageSize + 2
+
+	tagged := true
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("state") != "Running" {
+			t.Errorf("Expected the params of the filter, got: %v", q)
+		}
+		if tagged != (q.Get("tags[0].key") == "env" && q.Get("tags[0].value") == "test") {
+			t.Errorf("Expected the tag filter to be set: %t, got: %v", tagged, q)
+		}
+
+		page, _ := strconv.Atoi(q.Get("page"))
+		start := (page - 1) * pageSize
+		end := start + pageSize
+		if end > count {
+			end = count
+		}
+
+		var vms []string
+		for i := start; i < end; i++ {
+			vms = append(vms, fmt.Sprintf(`{"id":"vm%d"}`, i))
+		}
+		fmt.Fprintf(w, `{"listvirtualmachinesresponse":{"count":%d,"virtualmachine":[%s]}}`, count, strings.Join(vms, ","))
+	}))
+	defer srv.Close()
+
+	cs := cloudstack.NewClient(srv.URL, "key", "secret", false)
+	e := New(cs)
+
+	p := cs.VirtualMachine.NewListVirtualMachinesParams()
+	p.SetState("Running")
+
+	vms, err := e.Targets(Filter{Params: p, Tags: map[string]string{"env": "test"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(vms) != count || vms[count-1].Id != fmt.Sprintf("vm%d", count-1) {
+		t.Fatalf("Expected all %d virtual machines of both pages, got %d", count, len(vms))
+	}
+
+	// The params of the caller are not changed, so they can be reused without the tags
+	tagged = false
+	if _, err := e.Targets(Filter{Params: p}); err != nil {
+		t.Fatal(err)
+	}
+}
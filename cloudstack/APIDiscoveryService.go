@@ -24,10 +24,24 @@ import (
 	"net/url"
 )
 
+type APIDiscoveryServiceIface interface {
+	NewListApisParams() *ListApisParams
+	ListApis(p *ListApisParams) (*ListApisResponse, error)
+}
+
 type ListApisParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListApisParams) Copy() *ListApisParams {
+	c := &ListApisParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListApisParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -27,10 +27,50 @@ import (
 	"strings"
 )
 
+type AccountServiceIface interface {
+	NewAddAccountToProjectParams(projectid string) *AddAccountToProjectParams
+	AddAccountToProject(p *AddAccountToProjectParams) (*AddAccountToProjectResponse, error)
+	NewCreateAccountParams(email string, firstname string, lastname string, password string, username string) *CreateAccountParams
+	CreateAccount(p *CreateAccountParams) (*CreateAccountResponse, error)
+	NewDeleteAccountParams(id string) *DeleteAccountParams
+	DeleteAccount(p *DeleteAccountParams) (*DeleteAccountResponse, error)
+	NewDeleteAccountFromProjectParams(account string, projectid string) *DeleteAccountFromProjectParams
+	DeleteAccountFromProject(p *DeleteAccountFromProjectParams) (*DeleteAccountFromProjectResponse, error)
+	NewDisableAccountParams(lock bool) *DisableAccountParams
+	DisableAccount(p *DisableAccountParams) (*DisableAccountResponse, error)
+	NewEnableAccountParams() *EnableAccountParams
+	EnableAccount(p *EnableAccountParams) (*EnableAccountResponse, error)
+	NewGetSolidFireAccountIdParams(accountid string, storageid string) *GetSolidFireAccountIdParams
+	GetSolidFireAccountId(p *GetSolidFireAccountIdParams) (*GetSolidFireAccountIdResponse, error)
+	NewListAccountsParams() *ListAccountsParams
+	GetAccountID(name string, opts ...OptionFunc) (string, int, error)
+	GetAccountByName(name string, opts ...OptionFunc) (*Account, int, error)
+	GetAccountByID(id string, opts ...OptionFunc) (*Account, int, error)
+	ListAccounts(p *ListAccountsParams) (*ListAccountsResponse, error)
+	NewListProjectAccountsParams(projectid string) *ListProjectAccountsParams
+	GetProjectAccountID(keyword string, projectid string, opts ...OptionFunc) (string, int, error)
+	ListProjectAccounts(p *ListProjectAccountsParams) (*ListProjectAccountsResponse, error)
+	NewLockAccountParams(account string, domainid string) *LockAccountParams
+	LockAccount(p *LockAccountParams) (*LockAccountResponse, error)
+	NewMarkDefaultZoneForAccountParams(account string, domainid string, zoneid string) *MarkDefaultZoneForAccountParams
+	MarkDefaultZoneForAccount(p *MarkDefaultZoneForAccountParams) (*MarkDefaultZoneForAccountResponse, error)
+	NewUpdateAccountParams() *UpdateAccountParams
+	UpdateAccount(p *UpdateAccountParams) (*UpdateAccountResponse, error)
+}
+
 type AddAccountToProjectParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *AddAccountToProjectParams) Copy() *AddAccountToProjectParams {
+	c := &AddAccountToProjectParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddAccountToProjectParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -119,6 +159,15 @@ type CreateAccountParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CreateAccountParams) Copy() *CreateAccountParams {
+	c := &CreateAccountParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateAccountParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -393,6 +442,15 @@ type DeleteAccountParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteAccountParams) Copy() *DeleteAccountParams {
+	c := &DeleteAccountParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteAccountParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -461,6 +519,15 @@ type DeleteAccountFromProjectParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteAccountFromProjectParams) Copy() *DeleteAccountFromProjectParams {
+	c := &DeleteAccountFromProjectParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteAccountFromProjectParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -540,6 +607,15 @@ type DisableAccountParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DisableAccountParams) Copy() *DisableAccountParams {
+	c := &DisableAccountParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DisableAccountParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -722,6 +798,15 @@ type EnableAccountParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *EnableAccountParams) Copy() *EnableAccountParams {
+	c := &EnableAccountParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *EnableAccountParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -872,6 +957,15 @@ type GetSolidFireAccountIdParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *GetSolidFireAccountIdParams) Copy() *GetSolidFireAccountIdParams {
+	c := &GetSolidFireAccountIdParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *GetSolidFireAccountIdParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -935,6 +1029,15 @@ type ListAccountsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListAccountsParams) Copy() *ListAccountsParams {
+	c := &ListAccountsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListAccountsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1259,6 +1362,15 @@ type ListProjectAccountsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListProjectAccountsParams) Copy() *ListProjectAccountsParams {
+	c := &ListProjectAccountsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListProjectAccountsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1461,6 +1573,15 @@ type LockAccountParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *LockAccountParams) Copy() *LockAccountParams {
+	c := &LockAccountParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *LockAccountParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1603,6 +1724,15 @@ type MarkDefaultZoneForAccountParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *MarkDefaultZoneForAccountParams) Copy() *MarkDefaultZoneForAccountParams {
+	c := &MarkDefaultZoneForAccountParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *MarkDefaultZoneForAccountParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1776,6 +1906,15 @@ type UpdateAccountParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdateAccountParams) Copy() *UpdateAccountParams {
+	c := &UpdateAccountParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateAccountParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -27,10 +27,31 @@ import (
 	"strings"
 )
 
+type AddressServiceIface interface {
+	NewAssociateIpAddressParams() *AssociateIpAddressParams
+	AssociateIpAddress(p *AssociateIpAddressParams) (*AssociateIpAddressResponse, error)
+	NewDisassociateIpAddressParams(id string) *DisassociateIpAddressParams
+	DisassociateIpAddress(p *DisassociateIpAddressParams) (*DisassociateIpAddressResponse, error)
+	NewListPublicIpAddressesParams() *ListPublicIpAddressesParams
+	GetPublicIpAddressByID(id string, opts ...OptionFunc) (*PublicIpAddress, int, error)
+	ListPublicIpAddresses(p *ListPublicIpAddressesParams) (*ListPublicIpAddressesResponse, error)
+	NewUpdateIpAddressParams(id string) *UpdateIpAddressParams
+	UpdateIpAddress(p *UpdateIpAddressParams) (*UpdateIpAddressResponse, error)
+}
+
 type AssociateIpAddressParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *AssociateIpAddressParams) Copy() *AssociateIpAddressParams {
+	c := &AssociateIpAddressParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AssociateIpAddressParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -214,6 +235,15 @@ type DisassociateIpAddressParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DisassociateIpAddressParams) Copy() *DisassociateIpAddressParams {
+	c := &DisassociateIpAddressParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DisassociateIpAddressParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -282,6 +312,15 @@ type ListPublicIpAddressesParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListPublicIpAddressesParams) Copy() *ListPublicIpAddressesParams {
+	c := &ListPublicIpAddressesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListPublicIpAddressesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -634,6 +673,15 @@ type UpdateIpAddressParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdateIpAddressParams) Copy() *UpdateIpAddressParams {
+	c := &UpdateIpAddressParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateIpAddressParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
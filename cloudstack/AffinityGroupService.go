@@ -27,10 +27,35 @@ import (
 	"strings"
 )
 
+type AffinityGroupServiceIface interface {
+	NewCreateAffinityGroupParams(name string, affinityGroupType string) *CreateAffinityGroupParams
+	CreateAffinityGroup(p *CreateAffinityGroupParams) (*CreateAffinityGroupResponse, error)
+	NewDeleteAffinityGroupParams() *DeleteAffinityGroupParams
+	DeleteAffinityGroup(p *DeleteAffinityGroupParams) (*DeleteAffinityGroupResponse, error)
+	NewListAffinityGroupTypesParams() *ListAffinityGroupTypesParams
+	ListAffinityGroupTypes(p *ListAffinityGroupTypesParams) (*ListAffinityGroupTypesResponse, error)
+	NewListAffinityGroupsParams() *ListAffinityGroupsParams
+	GetAffinityGroupID(name string, opts ...OptionFunc) (string, int, error)
+	GetAffinityGroupByName(name string, opts ...OptionFunc) (*AffinityGroup, int, error)
+	GetAffinityGroupByID(id string, opts ...OptionFunc) (*AffinityGroup, int, error)
+	ListAffinityGroups(p *ListAffinityGroupsParams) (*ListAffinityGroupsResponse, error)
+	NewUpdateVMAffinityGroupParams(id string) *UpdateVMAffinityGroupParams
+	UpdateVMAffinityGroup(p *UpdateVMAffinityGroupParams) (*UpdateVMAffinityGroupResponse, error)
+}
+
 type CreateAffinityGroupParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CreateAffinityGroupParams) Copy() *CreateAffinityGroupParams {
+	c := &CreateAffinityGroupParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateAffinityGroupParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -163,6 +188,15 @@ type DeleteAffinityGroupParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteAffinityGroupParams) Copy() *DeleteAffinityGroupParams {
+	c := &DeleteAffinityGroupParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteAffinityGroupParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -270,6 +304,15 @@ type ListAffinityGroupTypesParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListAffinityGroupTypesParams) Copy() *ListAffinityGroupTypesParams {
+	c := &ListAffinityGroupTypesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListAffinityGroupTypesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -348,6 +391,15 @@ type ListAffinityGroupsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListAffinityGroupsParams) Copy() *ListAffinityGroupsParams {
+	c := &ListAffinityGroupsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListAffinityGroupsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -618,6 +670,15 @@ type UpdateVMAffinityGroupParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdateVMAffinityGroupParams) Copy() *UpdateVMAffinityGroupParams {
+	c := &UpdateVMAffinityGroupParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateVMAffinityGroupParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -27,10 +27,33 @@ import (
 	"strings"
 )
 
+type AlertServiceIface interface {
+	NewArchiveAlertsParams() *ArchiveAlertsParams
+	ArchiveAlerts(p *ArchiveAlertsParams) (*ArchiveAlertsResponse, error)
+	NewDeleteAlertsParams() *DeleteAlertsParams
+	DeleteAlerts(p *DeleteAlertsParams) (*DeleteAlertsResponse, error)
+	NewGenerateAlertParams(description string, name string, alertType int) *GenerateAlertParams
+	GenerateAlert(p *GenerateAlertParams) (*GenerateAlertResponse, error)
+	NewListAlertsParams() *ListAlertsParams
+	GetAlertID(name string, opts ...OptionFunc) (string, int, error)
+	GetAlertByName(name string, opts ...OptionFunc) (*Alert, int, error)
+	GetAlertByID(id string, opts ...OptionFunc) (*Alert, int, error)
+	ListAlerts(p *ListAlertsParams) (*ListAlertsResponse, error)
+}
+
 type ArchiveAlertsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ArchiveAlertsParams) Copy() *ArchiveAlertsParams {
+	c := &ArchiveAlertsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ArchiveAlertsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -141,6 +164,15 @@ type DeleteAlertsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteAlertsParams) Copy() *DeleteAlertsParams {
+	c := &DeleteAlertsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteAlertsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -251,6 +283,15 @@ type GenerateAlertParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *GenerateAlertParams) Copy() *GenerateAlertParams {
+	c := &GenerateAlertParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *GenerateAlertParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -362,6 +403,15 @@ type ListAlertsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListAlertsParams) Copy() *ListAlertsParams {
+	c := &ListAlertsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListAlertsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
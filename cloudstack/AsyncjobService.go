@@ -26,10 +26,26 @@ import (
 	"time"
 )
 
+type AsyncjobServiceIface interface {
+	NewListAsyncJobsParams() *ListAsyncJobsParams
+	ListAsyncJobs(p *ListAsyncJobsParams) (*ListAsyncJobsResponse, error)
+	NewQueryAsyncJobResultParams(jobid string) *QueryAsyncJobResultParams
+	QueryAsyncJobResult(p *QueryAsyncJobResultParams) (*QueryAsyncJobResultResponse, error)
+}
+
 type ListAsyncJobsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListAsyncJobsParams) Copy() *ListAsyncJobsParams {
+	c := &ListAsyncJobsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListAsyncJobsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -170,6 +186,15 @@ type QueryAsyncJobResultParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *QueryAsyncJobResultParams) Copy() *QueryAsyncJobResultParams {
+	c := &QueryAsyncJobResultParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *QueryAsyncJobResultParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
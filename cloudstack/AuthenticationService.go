@@ -25,10 +25,26 @@ import (
 	"strconv"
 )
 
+type AuthenticationServiceIface interface {
+	NewLoginParams(password string, username string) *LoginParams
+	Login(p *LoginParams) (*LoginResponse, error)
+	NewLogoutParams() *LogoutParams
+	Logout(p *LogoutParams) (*LogoutResponse, error)
+}
+
 type LoginParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *LoginParams) Copy() *LoginParams {
+	c := &LoginParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *LoginParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -124,6 +140,15 @@ type LogoutParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *LogoutParams) Copy() *LogoutParams {
+	c := &LogoutParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *LogoutParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -27,10 +27,69 @@ import (
 	"strings"
 )
 
+type AutoScaleServiceIface interface {
+	NewCreateAutoScalePolicyParams(action string, conditionids []string, duration int) *CreateAutoScalePolicyParams
+	CreateAutoScalePolicy(p *CreateAutoScalePolicyParams) (*CreateAutoScalePolicyResponse, error)
+	NewCreateAutoScaleVmGroupParams(lbruleid string, maxmembers int, minmembers int, scaledownpolicyids []string, scaleuppolicyids []string, vmprofileid string) *CreateAutoScaleVmGroupParams
+	CreateAutoScaleVmGroup(p *CreateAutoScaleVmGroupParams) (*CreateAutoScaleVmGroupResponse, error)
+	NewCreateAutoScaleVmProfileParams(serviceofferingid string, templateid string, zoneid string) *CreateAutoScaleVmProfileParams
+	CreateAutoScaleVmProfile(p *CreateAutoScaleVmProfileParams) (*CreateAutoScaleVmProfileResponse, error)
+	NewCreateConditionParams(counterid string, relationaloperator string, threshold int64) *CreateConditionParams
+	CreateCondition(p *CreateConditionParams) (*CreateConditionResponse, error)
+	NewCreateCounterParams(name string, source string, value string) *CreateCounterParams
+	CreateCounter(p *CreateCounterParams) (*CreateCounterResponse, error)
+	NewDeleteAutoScalePolicyParams(id string) *DeleteAutoScalePolicyParams
+	DeleteAutoScalePolicy(p *DeleteAutoScalePolicyParams) (*DeleteAutoScalePolicyResponse, error)
+	NewDeleteAutoScaleVmGroupParams(id string) *DeleteAutoScaleVmGroupParams
+	DeleteAutoScaleVmGroup(p *DeleteAutoScaleVmGroupParams) (*DeleteAutoScaleVmGroupResponse, error)
+	NewDeleteAutoScaleVmProfileParams(id string) *DeleteAutoScaleVmProfileParams
+	DeleteAutoScaleVmProfile(p *DeleteAutoScaleVmProfileParams) (*DeleteAutoScaleVmProfileResponse, error)
+	NewDeleteConditionParams(id string) *DeleteConditionParams
+	DeleteCondition(p *DeleteConditionParams) (*DeleteConditionResponse, error)
+	NewDeleteCounterParams(id string) *DeleteCounterParams
+	DeleteCounter(p *DeleteCounterParams) (*DeleteCounterResponse, error)
+	NewDisableAutoScaleVmGroupParams(id string) *DisableAutoScaleVmGroupParams
+	DisableAutoScaleVmGroup(p *DisableAutoScaleVmGroupParams) (*DisableAutoScaleVmGroupResponse, error)
+	NewEnableAutoScaleVmGroupParams(id string) *EnableAutoScaleVmGroupParams
+	EnableAutoScaleVmGroup(p *EnableAutoScaleVmGroupParams) (*EnableAutoScaleVmGroupResponse, error)
+	NewListAutoScalePoliciesParams() *ListAutoScalePoliciesParams
+	GetAutoScalePolicyByID(id string, opts ...OptionFunc) (*AutoScalePolicy, int, error)
+	ListAutoScalePolicies(p *ListAutoScalePoliciesParams) (*ListAutoScalePoliciesResponse, error)
+	NewListAutoScaleVmGroupsParams() *ListAutoScaleVmGroupsParams
+	GetAutoScaleVmGroupByID(id string, opts ...OptionFunc) (*AutoScaleVmGroup, int, error)
+	ListAutoScaleVmGroups(p *ListAutoScaleVmGroupsParams) (*ListAutoScaleVmGroupsResponse, error)
+	NewListAutoScaleVmProfilesParams() *ListAutoScaleVmProfilesParams
+	GetAutoScaleVmProfileByID(id string, opts ...OptionFunc) (*AutoScaleVmProfile, int, error)
+	ListAutoScaleVmProfiles(p *ListAutoScaleVmProfilesParams) (*ListAutoScaleVmProfilesResponse, error)
+	NewListConditionsParams() *ListConditionsParams
+	GetConditionByID(id string, opts ...OptionFunc) (*Condition, int, error)
+	ListConditions(p *ListConditionsParams) (*ListConditionsResponse, error)
+	NewListCountersParams() *ListCountersParams
+	GetCounterID(name string, opts ...OptionFunc) (string, int, error)
+	GetCounterByName(name string, opts ...OptionFunc) (*Counter, int, error)
+	GetCounterByID(id string, opts ...OptionFunc) (*Counter, int, error)
+	ListCounters(p *ListCountersParams) (*ListCountersResponse, error)
+	NewUpdateAutoScalePolicyParams(id string) *UpdateAutoScalePolicyParams
+	UpdateAutoScalePolicy(p *UpdateAutoScalePolicyParams) (*UpdateAutoScalePolicyResponse, error)
+	NewUpdateAutoScaleVmGroupParams(id string) *UpdateAutoScaleVmGroupParams
+	UpdateAutoScaleVmGroup(p *UpdateAutoScaleVmGroupParams) (*UpdateAutoScaleVmGroupResponse, error)
+	NewUpdateAutoScaleVmProfileParams(id string) *UpdateAutoScaleVmProfileParams
+	UpdateAutoScaleVmProfile(p *UpdateAutoScaleVmProfileParams) (*UpdateAutoScaleVmProfileResponse, error)
+}
+
 type CreateAutoScalePolicyParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CreateAutoScalePolicyParams) Copy() *CreateAutoScalePolicyParams {
+	c := &CreateAutoScalePolicyParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateAutoScalePolicyParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -147,6 +206,15 @@ type CreateAutoScaleVmGroupParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CreateAutoScaleVmGroupParams) Copy() *CreateAutoScaleVmGroupParams {
+	c := &CreateAutoScaleVmGroupParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateAutoScaleVmGroupParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -314,6 +382,15 @@ type CreateAutoScaleVmProfileParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CreateAutoScaleVmProfileParams) Copy() *CreateAutoScaleVmProfileParams {
+	c := &CreateAutoScaleVmProfileParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateAutoScaleVmProfileParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -476,6 +553,15 @@ type CreateConditionParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CreateConditionParams) Copy() *CreateConditionParams {
+	c := &CreateConditionParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateConditionParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -600,6 +686,15 @@ type CreateCounterParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CreateCounterParams) Copy() *CreateCounterParams {
+	c := &CreateCounterParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateCounterParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -698,6 +793,15 @@ type DeleteAutoScalePolicyParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteAutoScalePolicyParams) Copy() *DeleteAutoScalePolicyParams {
+	c := &DeleteAutoScalePolicyParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteAutoScalePolicyParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -766,6 +870,15 @@ type DeleteAutoScaleVmGroupParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteAutoScaleVmGroupParams) Copy() *DeleteAutoScaleVmGroupParams {
+	c := &DeleteAutoScaleVmGroupParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteAutoScaleVmGroupParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -834,6 +947,15 @@ type DeleteAutoScaleVmProfileParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteAutoScaleVmProfileParams) Copy() *DeleteAutoScaleVmProfileParams {
+	c := &DeleteAutoScaleVmProfileParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteAutoScaleVmProfileParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -902,6 +1024,15 @@ type DeleteConditionParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteConditionParams) Copy() *DeleteConditionParams {
+	c := &DeleteConditionParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteConditionParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -970,6 +1101,15 @@ type DeleteCounterParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteCounterParams) Copy() *DeleteCounterParams {
+	c := &DeleteCounterParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteCounterParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1038,6 +1178,15 @@ type DisableAutoScaleVmGroupParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DisableAutoScaleVmGroupParams) Copy() *DisableAutoScaleVmGroupParams {
+	c := &DisableAutoScaleVmGroupParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DisableAutoScaleVmGroupParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1124,6 +1273,15 @@ type EnableAutoScaleVmGroupParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *EnableAutoScaleVmGroupParams) Copy() *EnableAutoScaleVmGroupParams {
+	c := &EnableAutoScaleVmGroupParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *EnableAutoScaleVmGroupParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1210,6 +1368,15 @@ type ListAutoScalePoliciesParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListAutoScalePoliciesParams) Copy() *ListAutoScalePoliciesParams {
+	c := &ListAutoScalePoliciesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListAutoScalePoliciesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1412,6 +1579,15 @@ type ListAutoScaleVmGroupsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListAutoScaleVmGroupsParams) Copy() *ListAutoScaleVmGroupsParams {
+	c := &ListAutoScaleVmGroupsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListAutoScaleVmGroupsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1650,6 +1826,15 @@ type ListAutoScaleVmProfilesParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListAutoScaleVmProfilesParams) Copy() *ListAutoScaleVmProfilesParams {
+	c := &ListAutoScaleVmProfilesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListAutoScaleVmProfilesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1886,6 +2071,15 @@ type ListConditionsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListConditionsParams) Copy() *ListConditionsParams {
+	c := &ListConditionsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListConditionsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2078,6 +2272,15 @@ type ListCountersParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListCountersParams) Copy() *ListCountersParams {
+	c := &ListCountersParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListCountersParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2273,6 +2476,15 @@ type UpdateAutoScalePolicyParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdateAutoScalePolicyParams) Copy() *UpdateAutoScalePolicyParams {
+	c := &UpdateAutoScalePolicyParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateAutoScalePolicyParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2387,6 +2599,15 @@ type UpdateAutoScaleVmGroupParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdateAutoScaleVmGroupParams) Copy() *UpdateAutoScaleVmGroupParams {
+	c := &UpdateAutoScaleVmGroupParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateAutoScaleVmGroupParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2549,6 +2770,15 @@ type UpdateAutoScaleVmProfileParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdateAutoScaleVmProfileParams) Copy() *UpdateAutoScaleVmProfileParams {
+	c := &UpdateAutoScaleVmProfileParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateAutoScaleVmProfileParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
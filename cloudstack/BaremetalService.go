@@ -25,10 +25,40 @@ import (
 	"strconv"
 )
 
+type BaremetalServiceIface interface {
+	NewAddBaremetalDhcpParams(dhcpservertype string, password string, physicalnetworkid string, url string, username string) *AddBaremetalDhcpParams
+	AddBaremetalDhcp(p *AddBaremetalDhcpParams) (*AddBaremetalDhcpResponse, error)
+	NewAddBaremetalPxeKickStartServerParams(password string, physicalnetworkid string, pxeservertype string, tftpdir string, url string, username string) *AddBaremetalPxeKickStartServerParams
+	AddBaremetalPxeKickStartServer(p *AddBaremetalPxeKickStartServerParams) (*AddBaremetalPxeKickStartServerResponse, error)
+	NewAddBaremetalPxePingServerParams(password string, physicalnetworkid string, pingdir string, pingstorageserverip string, pxeservertype string, tftpdir string, url string, username string) *AddBaremetalPxePingServerParams
+	AddBaremetalPxePingServer(p *AddBaremetalPxePingServerParams) (*AddBaremetalPxePingServerResponse, error)
+	NewAddBaremetalRctParams(baremetalrcturl string) *AddBaremetalRctParams
+	AddBaremetalRct(p *AddBaremetalRctParams) (*AddBaremetalRctResponse, error)
+	NewDeleteBaremetalRctParams(id string) *DeleteBaremetalRctParams
+	DeleteBaremetalRct(p *DeleteBaremetalRctParams) (*DeleteBaremetalRctResponse, error)
+	NewListBaremetalDhcpParams(physicalnetworkid string) *ListBaremetalDhcpParams
+	ListBaremetalDhcp(p *ListBaremetalDhcpParams) (*ListBaremetalDhcpResponse, error)
+	NewListBaremetalPxeServersParams(physicalnetworkid string) *ListBaremetalPxeServersParams
+	ListBaremetalPxeServers(p *ListBaremetalPxeServersParams) (*ListBaremetalPxeServersResponse, error)
+	NewListBaremetalRctParams() *ListBaremetalRctParams
+	ListBaremetalRct(p *ListBaremetalRctParams) (*ListBaremetalRctResponse, error)
+	NewNotifyBaremetalProvisionDoneParams(mac string) *NotifyBaremetalProvisionDoneParams
+	NotifyBaremetalProvisionDone(p *NotifyBaremetalProvisionDoneParams) (*NotifyBaremetalProvisionDoneResponse, error)
+}
+
 type AddBaremetalDhcpParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *AddBaremetalDhcpParams) Copy() *AddBaremetalDhcpParams {
+	c := &AddBaremetalDhcpParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddBaremetalDhcpParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -149,6 +179,15 @@ type AddBaremetalPxeKickStartServerParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *AddBaremetalPxeKickStartServerParams) Copy() *AddBaremetalPxeKickStartServerParams {
+	c := &AddBaremetalPxeKickStartServerParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddBaremetalPxeKickStartServerParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -290,6 +329,15 @@ type AddBaremetalPxePingServerParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *AddBaremetalPxePingServerParams) Copy() *AddBaremetalPxePingServerParams {
+	c := &AddBaremetalPxePingServerParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddBaremetalPxePingServerParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -475,6 +523,15 @@ type AddBaremetalRctParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *AddBaremetalRctParams) Copy() *AddBaremetalRctParams {
+	c := &AddBaremetalRctParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddBaremetalRctParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -548,6 +605,15 @@ type DeleteBaremetalRctParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteBaremetalRctParams) Copy() *DeleteBaremetalRctParams {
+	c := &DeleteBaremetalRctParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteBaremetalRctParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -616,6 +682,15 @@ type ListBaremetalDhcpParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListBaremetalDhcpParams) Copy() *ListBaremetalDhcpParams {
+	c := &ListBaremetalDhcpParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListBaremetalDhcpParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -730,6 +805,15 @@ type ListBaremetalPxeServersParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListBaremetalPxeServersParams) Copy() *ListBaremetalPxeServersParams {
+	c := &ListBaremetalPxeServersParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListBaremetalPxeServersParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -833,6 +917,15 @@ type ListBaremetalRctParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListBaremetalRctParams) Copy() *ListBaremetalRctParams {
+	c := &ListBaremetalRctParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListBaremetalRctParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -912,6 +1005,15 @@ type NotifyBaremetalProvisionDoneParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *NotifyBaremetalProvisionDoneParams) Copy() *NotifyBaremetalProvisionDoneParams {
+	c := &NotifyBaremetalProvisionDoneParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *NotifyBaremetalProvisionDoneParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
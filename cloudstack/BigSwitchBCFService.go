@@ -25,10 +25,28 @@ import (
 	"strconv"
 )
 
+type BigSwitchBCFServiceIface interface {
+	NewAddBigSwitchBcfDeviceParams(hostname string, nat bool, password string, physicalnetworkid string, username string) *AddBigSwitchBcfDeviceParams
+	AddBigSwitchBcfDevice(p *AddBigSwitchBcfDeviceParams) (*AddBigSwitchBcfDeviceResponse, error)
+	NewDeleteBigSwitchBcfDeviceParams(bcfdeviceid string) *DeleteBigSwitchBcfDeviceParams
+	DeleteBigSwitchBcfDevice(p *DeleteBigSwitchBcfDeviceParams) (*DeleteBigSwitchBcfDeviceResponse, error)
+	NewListBigSwitchBcfDevicesParams() *ListBigSwitchBcfDevicesParams
+	ListBigSwitchBcfDevices(p *ListBigSwitchBcfDevicesParams) (*ListBigSwitchBcfDevicesResponse, error)
+}
+
 type AddBigSwitchBcfDeviceParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *AddBigSwitchBcfDeviceParams) Copy() *AddBigSwitchBcfDeviceParams {
+	c := &AddBigSwitchBcfDeviceParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddBigSwitchBcfDeviceParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -153,6 +171,15 @@ type DeleteBigSwitchBcfDeviceParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteBigSwitchBcfDeviceParams) Copy() *DeleteBigSwitchBcfDeviceParams {
+	c := &DeleteBigSwitchBcfDeviceParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteBigSwitchBcfDeviceParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -180,7 +207,7 @@ func (s *BigSwitchBCFService) NewDeleteBigSwitchBcfDeviceParams(bcfdeviceid stri
 	return p
 }
 
-//  delete a BigSwitch BCF Controller device
+// delete a BigSwitch BCF Controller device
 func (s *BigSwitchBCFService) DeleteBigSwitchBcfDevice(p *DeleteBigSwitchBcfDeviceParams) (*DeleteBigSwitchBcfDeviceResponse, error) {
 	resp, err := s.cs.newRequest("deleteBigSwitchBcfDevice", p.toURLValues())
 	if err != nil {
@@ -221,6 +248,15 @@ type ListBigSwitchBcfDevicesParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListBigSwitchBcfDevicesParams) Copy() *ListBigSwitchBcfDevicesParams {
+	c := &ListBigSwitchBcfDevicesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListBigSwitchBcfDevicesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -26,10 +26,31 @@ import (
 	"strconv"
 )
 
+type BrocadeVCSServiceIface interface {
+	NewAddBrocadeVcsDeviceParams(hostname string, password string, physicalnetworkid string, username string) *AddBrocadeVcsDeviceParams
+	AddBrocadeVcsDevice(p *AddBrocadeVcsDeviceParams) (*AddBrocadeVcsDeviceResponse, error)
+	NewDeleteBrocadeVcsDeviceParams(vcsdeviceid string) *DeleteBrocadeVcsDeviceParams
+	DeleteBrocadeVcsDevice(p *DeleteBrocadeVcsDeviceParams) (*DeleteBrocadeVcsDeviceResponse, error)
+	NewListBrocadeVcsDeviceNetworksParams(vcsdeviceid string) *ListBrocadeVcsDeviceNetworksParams
+	GetBrocadeVcsDeviceNetworkID(keyword string, vcsdeviceid string, opts ...OptionFunc) (string, int, error)
+	ListBrocadeVcsDeviceNetworks(p *ListBrocadeVcsDeviceNetworksParams) (*ListBrocadeVcsDeviceNetworksResponse, error)
+	NewListBrocadeVcsDevicesParams() *ListBrocadeVcsDevicesParams
+	ListBrocadeVcsDevices(p *ListBrocadeVcsDevicesParams) (*ListBrocadeVcsDevicesResponse, error)
+}
+
 type AddBrocadeVcsDeviceParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *AddBrocadeVcsDeviceParams) Copy() *AddBrocadeVcsDeviceParams {
+	c := &AddBrocadeVcsDeviceParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddBrocadeVcsDeviceParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -139,6 +160,15 @@ type DeleteBrocadeVcsDeviceParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteBrocadeVcsDeviceParams) Copy() *DeleteBrocadeVcsDeviceParams {
+	c := &DeleteBrocadeVcsDeviceParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteBrocadeVcsDeviceParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -166,7 +196,7 @@ func (s *BrocadeVCSService) NewDeleteBrocadeVcsDeviceParams(vcsdeviceid string)
 	return p
 }
 
-//  delete a Brocade VCS Switch
+// delete a Brocade VCS Switch
 func (s *BrocadeVCSService) DeleteBrocadeVcsDevice(p *DeleteBrocadeVcsDeviceParams) (*DeleteBrocadeVcsDeviceResponse, error) {
 	resp, err := s.cs.newRequest("deleteBrocadeVcsDevice", p.toURLValues())
 	if err != nil {
@@ -207,6 +237,15 @@ type ListBrocadeVcsDeviceNetworksParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListBrocadeVcsDeviceNetworksParams) Copy() *ListBrocadeVcsDeviceNetworksParams {
+	c := &ListBrocadeVcsDeviceNetworksParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListBrocadeVcsDeviceNetworksParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -404,6 +443,15 @@ type ListBrocadeVcsDevicesParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListBrocadeVcsDevicesParams) Copy() *ListBrocadeVcsDevicesParams {
+	c := &ListBrocadeVcsDevicesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListBrocadeVcsDevicesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -25,10 +25,24 @@ import (
 	"strconv"
 )
 
+type CertificateServiceIface interface {
+	NewUploadCustomCertificateParams(certificate string, domainsuffix string) *UploadCustomCertificateParams
+	UploadCustomCertificate(p *UploadCustomCertificateParams) (*UploadCustomCertificateResponse, error)
+}
+
 type UploadCustomCertificateParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UploadCustomCertificateParams) Copy() *UploadCustomCertificateParams {
+	c := &UploadCustomCertificateParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UploadCustomCertificateParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -24,10 +24,24 @@ import (
 	"net/url"
 )
 
+type CloudIdentifierServiceIface interface {
+	NewGetCloudIdentifierParams(userid string) *GetCloudIdentifierParams
+	GetCloudIdentifier(p *GetCloudIdentifierParams) (*GetCloudIdentifierResponse, error)
+}
+
 type GetCloudIdentifierParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *GetCloudIdentifierParams) Copy() *GetCloudIdentifierParams {
+	c := &GetCloudIdentifierParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *GetCloudIdentifierParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
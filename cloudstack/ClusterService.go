@@ -27,10 +27,48 @@ import (
 	"strings"
 )
 
+type ClusterServiceIface interface {
+	NewAddClusterParams(clustername string, clustertype string, hypervisor string, podid string, zoneid string) *AddClusterParams
+	AddCluster(p *AddClusterParams) (*AddClusterResponse, error)
+	NewDedicateClusterParams(clusterid string, domainid string) *DedicateClusterParams
+	DedicateCluster(p *DedicateClusterParams) (*DedicateClusterResponse, error)
+	NewDeleteClusterParams(id string) *DeleteClusterParams
+	DeleteCluster(p *DeleteClusterParams) (*DeleteClusterResponse, error)
+	NewDisableOutOfBandManagementForClusterParams(clusterid string) *DisableOutOfBandManagementForClusterParams
+	DisableOutOfBandManagementForCluster(p *DisableOutOfBandManagementForClusterParams) (*DisableOutOfBandManagementForClusterResponse, error)
+	NewEnableOutOfBandManagementForClusterParams(clusterid string) *EnableOutOfBandManagementForClusterParams
+	EnableOutOfBandManagementForCluster(p *EnableOutOfBandManagementForClusterParams) (*EnableOutOfBandManagementForClusterResponse, error)
+	NewListClustersParams() *ListClustersParams
+	GetClusterID(name string, opts ...OptionFunc) (string, int, error)
+	GetClusterByName(name string, opts ...OptionFunc) (*Cluster, int, error)
+	GetClusterByID(id string, opts ...OptionFunc) (*Cluster, int, error)
+	ListClusters(p *ListClustersParams) (*ListClustersResponse, error)
+	NewListClustersMetricsParams() *ListClustersMetricsParams
+	GetClustersMetricID(name string, opts ...OptionFunc) (string, int, error)
+	GetClustersMetricByName(name string, opts ...OptionFunc) (*ClustersMetric, int, error)
+	GetClustersMetricByID(id string, opts ...OptionFunc) (*ClustersMetric, int, error)
+	ListClustersMetrics(p *ListClustersMetricsParams) (*ListClustersMetricsResponse, error)
+	NewListDedicatedClustersParams() *ListDedicatedClustersParams
+	ListDedicatedClusters(p *ListDedicatedClustersParams) (*ListDedicatedClustersResponse, error)
+	NewReleaseDedicatedClusterParams(clusterid string) *ReleaseDedicatedClusterParams
+	ReleaseDedicatedCluster(p *ReleaseDedicatedClusterParams) (*ReleaseDedicatedClusterResponse, error)
+	NewUpdateClusterParams(id string) *UpdateClusterParams
+	UpdateCluster(p *UpdateClusterParams) (*UpdateClusterResponse, error)
+}
+
 type AddClusterParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *AddClusterParams) Copy() *AddClusterParams {
+	c := &AddClusterParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddClusterParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -296,6 +334,15 @@ type DedicateClusterParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DedicateClusterParams) Copy() *DedicateClusterParams {
+	c := &DedicateClusterParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DedicateClusterParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -394,6 +441,15 @@ type DeleteClusterParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteClusterParams) Copy() *DeleteClusterParams {
+	c := &DeleteClusterParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteClusterParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -474,6 +530,15 @@ type DisableOutOfBandManagementForClusterParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DisableOutOfBandManagementForClusterParams) Copy() *DisableOutOfBandManagementForClusterParams {
+	c := &DisableOutOfBandManagementForClusterParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DisableOutOfBandManagementForClusterParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -556,6 +621,15 @@ type EnableOutOfBandManagementForClusterParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *EnableOutOfBandManagementForClusterParams) Copy() *EnableOutOfBandManagementForClusterParams {
+	c := &EnableOutOfBandManagementForClusterParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *EnableOutOfBandManagementForClusterParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -638,6 +712,15 @@ type ListClustersParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListClustersParams) Copy() *ListClustersParams {
+	c := &ListClustersParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListClustersParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -919,6 +1002,15 @@ type ListClustersMetricsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListClustersMetricsParams) Copy() *ListClustersMetricsParams {
+	c := &ListClustersMetricsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListClustersMetricsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1218,6 +1310,15 @@ type ListDedicatedClustersParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListDedicatedClustersParams) Copy() *ListDedicatedClustersParams {
+	c := &ListDedicatedClustersParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListDedicatedClustersParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1341,6 +1442,15 @@ type ReleaseDedicatedClusterParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ReleaseDedicatedClusterParams) Copy() *ReleaseDedicatedClusterParams {
+	c := &ReleaseDedicatedClusterParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ReleaseDedicatedClusterParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1409,6 +1519,15 @@ type UpdateClusterParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdateClusterParams) Copy() *UpdateClusterParams {
+	c := &UpdateClusterParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateClusterParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -25,10 +25,30 @@ import (
 	"strconv"
 )
 
+type ConfigurationServiceIface interface {
+	NewListCapabilitiesParams() *ListCapabilitiesParams
+	ListCapabilities(p *ListCapabilitiesParams) (*ListCapabilitiesResponse, error)
+	NewListConfigurationsParams() *ListConfigurationsParams
+	ListConfigurations(p *ListConfigurationsParams) (*ListConfigurationsResponse, error)
+	NewListDeploymentPlannersParams() *ListDeploymentPlannersParams
+	ListDeploymentPlanners(p *ListDeploymentPlannersParams) (*ListDeploymentPlannersResponse, error)
+	NewUpdateConfigurationParams(name string) *UpdateConfigurationParams
+	UpdateConfiguration(p *UpdateConfigurationParams) (*UpdateConfigurationResponse, error)
+}
+
 type ListCapabilitiesParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListCapabilitiesParams) Copy() *ListCapabilitiesParams {
+	c := &ListCapabilitiesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListCapabilitiesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -88,6 +108,15 @@ type ListConfigurationsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListConfigurationsParams) Copy() *ListConfigurationsParams {
+	c := &ListConfigurationsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListConfigurationsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -251,6 +280,15 @@ type ListDeploymentPlannersParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListDeploymentPlannersParams) Copy() *ListDeploymentPlannersParams {
+	c := &ListDeploymentPlannersParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListDeploymentPlannersParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -329,6 +367,15 @@ type UpdateConfigurationParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdateConfigurationParams) Copy() *UpdateConfigurationParams {
+	c := &UpdateConfigurationParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateConfigurationParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -27,10 +27,23 @@ import (
 	"strings"
 )
 
+type CustomServiceIface interface {
+	CustomRequest(api string, p *CustomServiceParams, result interface{}) error
+}
+
 type CustomServiceParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CustomServiceParams) Copy() *CustomServiceParams {
+	c := &CustomServiceParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CustomServiceParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -27,10 +27,33 @@ import (
 	"strings"
 )
 
+type DiskOfferingServiceIface interface {
+	NewCreateDiskOfferingParams(displaytext string, name string) *CreateDiskOfferingParams
+	CreateDiskOffering(p *CreateDiskOfferingParams) (*CreateDiskOfferingResponse, error)
+	NewDeleteDiskOfferingParams(id string) *DeleteDiskOfferingParams
+	DeleteDiskOffering(p *DeleteDiskOfferingParams) (*DeleteDiskOfferingResponse, error)
+	NewListDiskOfferingsParams() *ListDiskOfferingsParams
+	GetDiskOfferingID(name string, opts ...OptionFunc) (string, int, error)
+	GetDiskOfferingByName(name string, opts ...OptionFunc) (*DiskOffering, int, error)
+	GetDiskOfferingByID(id string, opts ...OptionFunc) (*DiskOffering, int, error)
+	ListDiskOfferings(p *ListDiskOfferingsParams) (*ListDiskOfferingsResponse, error)
+	NewUpdateDiskOfferingParams(id string) *UpdateDiskOfferingParams
+	UpdateDiskOffering(p *UpdateDiskOfferingParams) (*UpdateDiskOfferingResponse, error)
+}
+
 type CreateDiskOfferingParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CreateDiskOfferingParams) Copy() *CreateDiskOfferingParams {
+	c := &CreateDiskOfferingParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateDiskOfferingParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -371,6 +394,15 @@ type DeleteDiskOfferingParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteDiskOfferingParams) Copy() *DeleteDiskOfferingParams {
+	c := &DeleteDiskOfferingParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteDiskOfferingParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -451,6 +483,15 @@ type ListDiskOfferingsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListDiskOfferingsParams) Copy() *ListDiskOfferingsParams {
+	c := &ListDiskOfferingsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListDiskOfferingsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -692,6 +733,15 @@ type UpdateDiskOfferingParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdateDiskOfferingParams) Copy() *UpdateDiskOfferingParams {
+	c := &UpdateDiskOfferingParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateDiskOfferingParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
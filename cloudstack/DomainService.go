@@ -27,10 +27,38 @@ import (
 	"strings"
 )
 
+type DomainServiceIface interface {
+	NewCreateDomainParams(name string) *CreateDomainParams
+	CreateDomain(p *CreateDomainParams) (*CreateDomainResponse, error)
+	NewDeleteDomainParams(id string) *DeleteDomainParams
+	DeleteDomain(p *DeleteDomainParams) (*DeleteDomainResponse, error)
+	NewListDomainChildrenParams() *ListDomainChildrenParams
+	GetDomainChildrenID(name string, opts ...OptionFunc) (string, int, error)
+	GetDomainChildrenByName(name string, opts ...OptionFunc) (*DomainChildren, int, error)
+	GetDomainChildrenByID(id string, opts ...OptionFunc) (*DomainChildren, int, error)
+	ListDomainChildren(p *ListDomainChildrenParams) (*ListDomainChildrenResponse, error)
+	NewListDomainsParams() *ListDomainsParams
+	GetDomainID(name string, opts ...OptionFunc) (string, int, error)
+	GetDomainByName(name string, opts ...OptionFunc) (*Domain, int, error)
+	GetDomainByID(id string, opts ...OptionFunc) (*Domain, int, error)
+	ListDomains(p *ListDomainsParams) (*ListDomainsResponse, error)
+	NewUpdateDomainParams(id string) *UpdateDomainParams
+	UpdateDomain(p *UpdateDomainParams) (*UpdateDomainResponse, error)
+}
+
 type CreateDomainParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CreateDomainParams) Copy() *CreateDomainParams {
+	c := &CreateDomainParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateDomainParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -157,6 +185,15 @@ type DeleteDomainParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteDomainParams) Copy() *DeleteDomainParams {
+	c := &DeleteDomainParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteDomainParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -236,6 +273,15 @@ type ListDomainChildrenParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListDomainChildrenParams) Copy() *ListDomainChildrenParams {
+	c := &ListDomainChildrenParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListDomainChildrenParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -483,6 +529,15 @@ type ListDomainsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListDomainsParams) Copy() *ListDomainsParams {
+	c := &ListDomainsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListDomainsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -741,6 +796,15 @@ type UpdateDomainParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdateDomainParams) Copy() *UpdateDomainParams {
+	c := &UpdateDomainParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateDomainParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -27,10 +27,31 @@ import (
 	"strings"
 )
 
+type EventServiceIface interface {
+	NewArchiveEventsParams() *ArchiveEventsParams
+	ArchiveEvents(p *ArchiveEventsParams) (*ArchiveEventsResponse, error)
+	NewDeleteEventsParams() *DeleteEventsParams
+	DeleteEvents(p *DeleteEventsParams) (*DeleteEventsResponse, error)
+	NewListEventTypesParams() *ListEventTypesParams
+	ListEventTypes(p *ListEventTypesParams) (*ListEventTypesResponse, error)
+	NewListEventsParams() *ListEventsParams
+	GetEventByID(id string, opts ...OptionFunc) (*Event, int, error)
+	ListEvents(p *ListEventsParams) (*ListEventsResponse, error)
+}
+
 type ArchiveEventsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ArchiveEventsParams) Copy() *ArchiveEventsParams {
+	c := &ArchiveEventsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ArchiveEventsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -141,6 +162,15 @@ type DeleteEventsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteEventsParams) Copy() *DeleteEventsParams {
+	c := &DeleteEventsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteEventsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -251,6 +281,15 @@ type ListEventTypesParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListEventTypesParams) Copy() *ListEventTypesParams {
+	c := &ListEventTypesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListEventTypesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -297,6 +336,15 @@ type ListEventsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListEventsParams) Copy() *ListEventsParams {
+	c := &ListEventsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListEventsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
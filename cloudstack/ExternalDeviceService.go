@@ -15,3 +15,6 @@
 //
 
 package cloudstack
+
+type ExternalDeviceServiceIface interface {
+}
@@ -27,6 +27,44 @@ import (
 	"strings"
 )
 
+type FirewallServiceIface interface {
+	NewAddPaloAltoFirewallParams(networkdevicetype string, password string, physicalnetworkid string, url string, username string) *AddPaloAltoFirewallParams
+	AddPaloAltoFirewall(p *AddPaloAltoFirewallParams) (*AddPaloAltoFirewallResponse, error)
+	NewConfigurePaloAltoFirewallParams(fwdeviceid string) *ConfigurePaloAltoFirewallParams
+	ConfigurePaloAltoFirewall(p *ConfigurePaloAltoFirewallParams) (*PaloAltoFirewallResponse, error)
+	NewCreateEgressFirewallRuleParams(networkid string, protocol string) *CreateEgressFirewallRuleParams
+	CreateEgressFirewallRule(p *CreateEgressFirewallRuleParams) (*CreateEgressFirewallRuleResponse, error)
+	NewCreateFirewallRuleParams(ipaddressid string, protocol string) *CreateFirewallRuleParams
+	CreateFirewallRule(p *CreateFirewallRuleParams) (*CreateFirewallRuleResponse, error)
+	NewCreatePortForwardingRuleParams(ipaddressid string, privateport int, protocol string, publicport int, virtualmachineid string) *CreatePortForwardingRuleParams
+	CreatePortForwardingRule(p *CreatePortForwardingRuleParams) (*CreatePortForwardingRuleResponse, error)
+	NewDeleteEgressFirewallRuleParams(id string) *DeleteEgressFirewallRuleParams
+	DeleteEgressFirewallRule(p *DeleteEgressFirewallRuleParams) (*DeleteEgressFirewallRuleResponse, error)
+	NewDeleteFirewallRuleParams(id string) *DeleteFirewallRuleParams
+	DeleteFirewallRule(p *DeleteFirewallRuleParams) (*DeleteFirewallRuleResponse, error)
+	NewDeletePaloAltoFirewallParams(fwdeviceid string) *DeletePaloAltoFirewallParams
+	DeletePaloAltoFirewall(p *DeletePaloAltoFirewallParams) (*DeletePaloAltoFirewallResponse, error)
+	NewDeletePortForwardingRuleParams(id string) *DeletePortForwardingRuleParams
+	DeletePortForwardingRule(p *DeletePortForwardingRuleParams) (*DeletePortForwardingRuleResponse, error)
+	NewListEgressFirewallRulesParams() *ListEgressFirewallRulesParams
+	GetEgressFirewallRuleByID(id string, opts ...OptionFunc) (*EgressFirewallRule, int, error)
+	ListEgressFirewallRules(p *ListEgressFirewallRulesParams) (*ListEgressFirewallRulesResponse, error)
+	NewListFirewallRulesParams() *ListFirewallRulesParams
+	GetFirewallRuleByID(id string, opts ...OptionFunc) (*FirewallRule, int, error)
+	ListFirewallRules(p *ListFirewallRulesParams) (*ListFirewallRulesResponse, error)
+	NewListPaloAltoFirewallsParams() *ListPaloAltoFirewallsParams
+	ListPaloAltoFirewalls(p *ListPaloAltoFirewallsParams) (*ListPaloAltoFirewallsResponse, error)
+	NewListPortForwardingRulesParams() *ListPortForwardingRulesParams
+	GetPortForwardingRuleByID(id string, opts ...OptionFunc) (*PortForwardingRule, int, error)
+	ListPortForwardingRules(p *ListPortForwardingRulesParams) (*ListPortForwardingRulesResponse, error)
+	NewUpdateEgressFirewallRuleParams(id string) *UpdateEgressFirewallRuleParams
+	UpdateEgressFirewallRule(p *UpdateEgressFirewallRuleParams) (*UpdateEgressFirewallRuleResponse, error)
+	NewUpdateFirewallRuleParams(id string) *UpdateFirewallRuleParams
+	UpdateFirewallRule(p *UpdateFirewallRuleParams) (*UpdateFirewallRuleResponse, error)
+	NewUpdatePortForwardingRuleParams(id string) *UpdatePortForwardingRuleParams
+	UpdatePortForwardingRule(p *UpdatePortForwardingRuleParams) (*UpdatePortForwardingRuleResponse, error)
+}
+
 // Helper function for maintaining backwards compatibility
 func convertFirewallServiceResponse(b []byte) ([]byte, error) {
 	var raw map[string]interface{}
@@ -81,6 +119,15 @@ type AddPaloAltoFirewallParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *AddPaloAltoFirewallParams) Copy() *AddPaloAltoFirewallParams {
+	c := &AddPaloAltoFirewallParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddPaloAltoFirewallParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -217,6 +264,15 @@ type ConfigurePaloAltoFirewallParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ConfigurePaloAltoFirewallParams) Copy() *ConfigurePaloAltoFirewallParams {
+	c := &ConfigurePaloAltoFirewallParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ConfigurePaloAltoFirewallParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -320,6 +376,15 @@ type CreateEgressFirewallRuleParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CreateEgressFirewallRuleParams) Copy() *CreateEgressFirewallRuleParams {
+	c := &CreateEgressFirewallRuleParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateEgressFirewallRuleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -508,6 +573,15 @@ type CreateFirewallRuleParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CreateFirewallRuleParams) Copy() *CreateFirewallRuleParams {
+	c := &CreateFirewallRuleParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateFirewallRuleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -685,6 +759,15 @@ type CreatePortForwardingRuleParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CreatePortForwardingRuleParams) Copy() *CreatePortForwardingRuleParams {
+	c := &CreatePortForwardingRuleParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreatePortForwardingRuleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -899,6 +982,15 @@ type DeleteEgressFirewallRuleParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteEgressFirewallRuleParams) Copy() *DeleteEgressFirewallRuleParams {
+	c := &DeleteEgressFirewallRuleParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteEgressFirewallRuleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -972,6 +1064,15 @@ type DeleteFirewallRuleParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteFirewallRuleParams) Copy() *DeleteFirewallRuleParams {
+	c := &DeleteFirewallRuleParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteFirewallRuleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1045,6 +1146,15 @@ type DeletePaloAltoFirewallParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeletePaloAltoFirewallParams) Copy() *DeletePaloAltoFirewallParams {
+	c := &DeletePaloAltoFirewallParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeletePaloAltoFirewallParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1072,7 +1182,7 @@ func (s *FirewallService) NewDeletePaloAltoFirewallParams(fwdeviceid string) *De
 	return p
 }
 
-//  delete a Palo Alto firewall device
+// delete a Palo Alto firewall device
 func (s *FirewallService) DeletePaloAltoFirewall(p *DeletePaloAltoFirewallParams) (*DeletePaloAltoFirewallResponse, error) {
 	resp, err := s.cs.newRequest("deletePaloAltoFirewall", p.toURLValues())
 	if err != nil {
@@ -1118,6 +1228,15 @@ type DeletePortForwardingRuleParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeletePortForwardingRuleParams) Copy() *DeletePortForwardingRuleParams {
+	c := &DeletePortForwardingRuleParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeletePortForwardingRuleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1191,6 +1310,15 @@ type ListEgressFirewallRulesParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListEgressFirewallRulesParams) Copy() *ListEgressFirewallRulesParams {
+	c := &ListEgressFirewallRulesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListEgressFirewallRulesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1427,6 +1555,15 @@ type ListFirewallRulesParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListFirewallRulesParams) Copy() *ListFirewallRulesParams {
+	c := &ListFirewallRulesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListFirewallRulesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1663,6 +1800,15 @@ type ListPaloAltoFirewallsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListPaloAltoFirewallsParams) Copy() *ListPaloAltoFirewallsParams {
+	c := &ListPaloAltoFirewallsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListPaloAltoFirewallsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1781,6 +1927,15 @@ type ListPortForwardingRulesParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListPortForwardingRulesParams) Copy() *ListPortForwardingRulesParams {
+	c := &ListPortForwardingRulesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListPortForwardingRulesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2020,6 +2175,15 @@ type UpdateEgressFirewallRuleParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdateEgressFirewallRuleParams) Copy() *UpdateEgressFirewallRuleParams {
+	c := &UpdateEgressFirewallRuleParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateEgressFirewallRuleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2131,6 +2295,15 @@ type UpdateFirewallRuleParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdateFirewallRuleParams) Copy() *UpdateFirewallRuleParams {
+	c := &UpdateFirewallRuleParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateFirewallRuleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2242,6 +2415,15 @@ type UpdatePortForwardingRuleParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdatePortForwardingRuleParams) Copy() *UpdatePortForwardingRuleParams {
+	c := &UpdatePortForwardingRuleParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdatePortForwardingRuleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -27,10 +27,45 @@ import (
 	"strings"
 )
 
+type GuestOSServiceIface interface {
+	NewAddGuestOsParams(details map[string]string, oscategoryid string, osdisplayname string) *AddGuestOsParams
+	AddGuestOs(p *AddGuestOsParams) (*AddGuestOsResponse, error)
+	NewAddGuestOsMappingParams(hypervisor string, hypervisorversion string, osnameforhypervisor string) *AddGuestOsMappingParams
+	AddGuestOsMapping(p *AddGuestOsMappingParams) (*AddGuestOsMappingResponse, error)
+	NewListGuestOsMappingParams() *ListGuestOsMappingParams
+	GetGuestOsMappingByID(id string, opts ...OptionFunc) (*GuestOsMapping, int, error)
+	ListGuestOsMapping(p *ListGuestOsMappingParams) (*ListGuestOsMappingResponse, error)
+	NewListOsCategoriesParams() *ListOsCategoriesParams
+	GetOsCategoryID(name string, opts ...OptionFunc) (string, int, error)
+	GetOsCategoryByName(name string, opts ...OptionFunc) (*OsCategory, int, error)
+	GetOsCategoryByID(id string, opts ...OptionFunc) (*OsCategory, int, error)
+	ListOsCategories(p *ListOsCategoriesParams) (*ListOsCategoriesResponse, error)
+	NewListOsTypesParams() *ListOsTypesParams
+	GetOsTypeByID(id string, opts ...OptionFunc) (*OsType, int, error)
+	ListOsTypes(p *ListOsTypesParams) (*ListOsTypesResponse, error)
+	NewRemoveGuestOsParams(id string) *RemoveGuestOsParams
+	RemoveGuestOs(p *RemoveGuestOsParams) (*RemoveGuestOsResponse, error)
+	NewRemoveGuestOsMappingParams(id string) *RemoveGuestOsMappingParams
+	RemoveGuestOsMapping(p *RemoveGuestOsMappingParams) (*RemoveGuestOsMappingResponse, error)
+	NewUpdateGuestOsParams(details map[string]string, id string, osdisplayname string) *UpdateGuestOsParams
+	UpdateGuestOs(p *UpdateGuestOsParams) (*UpdateGuestOsResponse, error)
+	NewUpdateGuestOsMappingParams(id string, osnameforhypervisor string) *UpdateGuestOsMappingParams
+	UpdateGuestOsMapping(p *UpdateGuestOsMappingParams) (*UpdateGuestOsMappingResponse, error)
+}
+
 type AddGuestOsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *AddGuestOsParams) Copy() *AddGuestOsParams {
+	c := &AddGuestOsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddGuestOsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -142,6 +177,15 @@ type AddGuestOsMappingParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *AddGuestOsMappingParams) Copy() *AddGuestOsMappingParams {
+	c := &AddGuestOsMappingParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddGuestOsMappingParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -289,6 +333,15 @@ type ListGuestOsMappingParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListGuestOsMappingParams) Copy() *ListGuestOsMappingParams {
+	c := &ListGuestOsMappingParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListGuestOsMappingParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -473,6 +526,15 @@ type ListOsCategoriesParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListOsCategoriesParams) Copy() *ListOsCategoriesParams {
+	c := &ListOsCategoriesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListOsCategoriesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -655,6 +717,15 @@ type ListOsTypesParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListOsTypesParams) Copy() *ListOsTypesParams {
+	c := &ListOsTypesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListOsTypesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -799,6 +870,15 @@ type RemoveGuestOsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *RemoveGuestOsParams) Copy() *RemoveGuestOsParams {
+	c := &RemoveGuestOsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *RemoveGuestOsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -867,6 +947,15 @@ type RemoveGuestOsMappingParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *RemoveGuestOsMappingParams) Copy() *RemoveGuestOsMappingParams {
+	c := &RemoveGuestOsMappingParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *RemoveGuestOsMappingParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -935,6 +1024,15 @@ type UpdateGuestOsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdateGuestOsParams) Copy() *UpdateGuestOsParams {
+	c := &UpdateGuestOsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateGuestOsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1036,6 +1134,15 @@ type UpdateGuestOsMappingParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdateGuestOsMappingParams) Copy() *UpdateGuestOsMappingParams {
+	c := &UpdateGuestOsMappingParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateGuestOsMappingParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
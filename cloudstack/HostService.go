@@ -27,10 +27,69 @@ import (
 	"strings"
 )
 
+type HostServiceIface interface {
+	NewAddBaremetalHostParams(hypervisor string, password string, podid string, url string, username string, zoneid string) *AddBaremetalHostParams
+	AddBaremetalHost(p *AddBaremetalHostParams) (*AddBaremetalHostResponse, error)
+	NewAddGloboDnsHostParams(password string, physicalnetworkid string, url string, username string) *AddGloboDnsHostParams
+	AddGloboDnsHost(p *AddGloboDnsHostParams) (*AddGloboDnsHostResponse, error)
+	NewAddHostParams(hypervisor string, password string, podid string, url string, username string, zoneid string) *AddHostParams
+	AddHost(p *AddHostParams) (*AddHostResponse, error)
+	NewAddSecondaryStorageParams(url string) *AddSecondaryStorageParams
+	AddSecondaryStorage(p *AddSecondaryStorageParams) (*AddSecondaryStorageResponse, error)
+	NewCancelHostMaintenanceParams(id string) *CancelHostMaintenanceParams
+	CancelHostMaintenance(p *CancelHostMaintenanceParams) (*CancelHostMaintenanceResponse, error)
+	NewDedicateHostParams(domainid string, hostid string) *DedicateHostParams
+	DedicateHost(p *DedicateHostParams) (*DedicateHostResponse, error)
+	NewDeleteHostParams(id string) *DeleteHostParams
+	DeleteHost(p *DeleteHostParams) (*DeleteHostResponse, error)
+	NewDisableOutOfBandManagementForHostParams(hostid string) *DisableOutOfBandManagementForHostParams
+	DisableOutOfBandManagementForHost(p *DisableOutOfBandManagementForHostParams) (*DisableOutOfBandManagementForHostResponse, error)
+	NewEnableOutOfBandManagementForHostParams(hostid string) *EnableOutOfBandManagementForHostParams
+	EnableOutOfBandManagementForHost(p *EnableOutOfBandManagementForHostParams) (*EnableOutOfBandManagementForHostResponse, error)
+	NewFindHostsForMigrationParams(virtualmachineid string) *FindHostsForMigrationParams
+	FindHostsForMigration(p *FindHostsForMigrationParams) (*FindHostsForMigrationResponse, error)
+	NewListDedicatedHostsParams() *ListDedicatedHostsParams
+	ListDedicatedHosts(p *ListDedicatedHostsParams) (*ListDedicatedHostsResponse, error)
+	NewListHostTagsParams() *ListHostTagsParams
+	GetHostTagID(keyword string, opts ...OptionFunc) (string, int, error)
+	ListHostTags(p *ListHostTagsParams) (*ListHostTagsResponse, error)
+	NewListHostsParams() *ListHostsParams
+	GetHostID(name string, opts ...OptionFunc) (string, int, error)
+	GetHostByName(name string, opts ...OptionFunc) (*Host, int, error)
+	GetHostByID(id string, opts ...OptionFunc) (*Host, int, error)
+	ListHosts(p *ListHostsParams) (*ListHostsResponse, error)
+	NewListHostsMetricsParams() *ListHostsMetricsParams
+	GetHostsMetricID(name string, opts ...OptionFunc) (string, int, error)
+	GetHostsMetricByName(name string, opts ...OptionFunc) (*HostsMetric, int, error)
+	GetHostsMetricByID(id string, opts ...OptionFunc) (*HostsMetric, int, error)
+	ListHostsMetrics(p *ListHostsMetricsParams) (*ListHostsMetricsResponse, error)
+	NewPrepareHostForMaintenanceParams(id string) *PrepareHostForMaintenanceParams
+	PrepareHostForMaintenance(p *PrepareHostForMaintenanceParams) (*PrepareHostForMaintenanceResponse, error)
+	NewReconnectHostParams(id string) *ReconnectHostParams
+	ReconnectHost(p *ReconnectHostParams) (*ReconnectHostResponse, error)
+	NewReleaseDedicatedHostParams(hostid string) *ReleaseDedicatedHostParams
+	ReleaseDedicatedHost(p *ReleaseDedicatedHostParams) (*ReleaseDedicatedHostResponse, error)
+	NewReleaseHostReservationParams(id string) *ReleaseHostReservationParams
+	ReleaseHostReservation(p *ReleaseHostReservationParams) (*ReleaseHostReservationResponse, error)
+	NewUpdateHostParams(id string) *UpdateHostParams
+	UpdateHost(p *UpdateHostParams) (*UpdateHostResponse, error)
+	NewUpdateHostPasswordParams(password string, username string) *UpdateHostPasswordParams
+	UpdateHostPassword(p *UpdateHostPasswordParams) (*UpdateHostPasswordResponse, error)
+}
+
 type AddBaremetalHostParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *AddBaremetalHostParams) Copy() *AddBaremetalHostParams {
+	c := &AddBaremetalHostParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddBaremetalHostParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -256,6 +315,15 @@ type AddGloboDnsHostParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *AddGloboDnsHostParams) Copy() *AddGloboDnsHostParams {
+	c := &AddGloboDnsHostParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddGloboDnsHostParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -357,6 +425,15 @@ type AddHostParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *AddHostParams) Copy() *AddHostParams {
+	c := &AddHostParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddHostParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -572,6 +649,15 @@ type AddSecondaryStorageParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *AddSecondaryStorageParams) Copy() *AddSecondaryStorageParams {
+	c := &AddSecondaryStorageParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddSecondaryStorageParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -641,6 +727,15 @@ type CancelHostMaintenanceParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CancelHostMaintenanceParams) Copy() *CancelHostMaintenanceParams {
+	c := &CancelHostMaintenanceParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CancelHostMaintenanceParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -780,6 +875,15 @@ type DedicateHostParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DedicateHostParams) Copy() *DedicateHostParams {
+	c := &DedicateHostParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DedicateHostParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -878,6 +982,15 @@ type DeleteHostParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteHostParams) Copy() *DeleteHostParams {
+	c := &DeleteHostParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteHostParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -980,6 +1093,15 @@ type DisableOutOfBandManagementForHostParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DisableOutOfBandManagementForHostParams) Copy() *DisableOutOfBandManagementForHostParams {
+	c := &DisableOutOfBandManagementForHostParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DisableOutOfBandManagementForHostParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1062,6 +1184,15 @@ type EnableOutOfBandManagementForHostParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *EnableOutOfBandManagementForHostParams) Copy() *EnableOutOfBandManagementForHostParams {
+	c := &EnableOutOfBandManagementForHostParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *EnableOutOfBandManagementForHostParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1144,6 +1275,15 @@ type FindHostsForMigrationParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *FindHostsForMigrationParams) Copy() *FindHostsForMigrationParams {
+	c := &FindHostsForMigrationParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *FindHostsForMigrationParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1272,6 +1412,15 @@ type ListDedicatedHostsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListDedicatedHostsParams) Copy() *ListDedicatedHostsParams {
+	c := &ListDedicatedHostsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListDedicatedHostsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1395,6 +1544,15 @@ type ListHostTagsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListHostTagsParams) Copy() *ListHostTagsParams {
+	c := &ListHostTagsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListHostTagsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1511,6 +1669,15 @@ type ListHostsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListHostsParams) Copy() *ListHostsParams {
+	c := &ListHostsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListHostsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1882,6 +2049,15 @@ type ListHostsMetricsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListHostsMetricsParams) Copy() *ListHostsMetricsParams {
+	c := &ListHostsMetricsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListHostsMetricsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2271,6 +2447,15 @@ type PrepareHostForMaintenanceParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *PrepareHostForMaintenanceParams) Copy() *PrepareHostForMaintenanceParams {
+	c := &PrepareHostForMaintenanceParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *PrepareHostForMaintenanceParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2410,6 +2595,15 @@ type ReconnectHostParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ReconnectHostParams) Copy() *ReconnectHostParams {
+	c := &ReconnectHostParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ReconnectHostParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2549,6 +2743,15 @@ type ReleaseDedicatedHostParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ReleaseDedicatedHostParams) Copy() *ReleaseDedicatedHostParams {
+	c := &ReleaseDedicatedHostParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ReleaseDedicatedHostParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2617,6 +2820,15 @@ type ReleaseHostReservationParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ReleaseHostReservationParams) Copy() *ReleaseHostReservationParams {
+	c := &ReleaseHostReservationParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ReleaseHostReservationParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2685,6 +2897,15 @@ type UpdateHostParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdateHostParams) Copy() *UpdateHostParams {
+	c := &UpdateHostParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateHostParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2855,6 +3076,15 @@ type UpdateHostPasswordParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdateHostPasswordParams) Copy() *UpdateHostPasswordParams {
+	c := &UpdateHostPasswordParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateHostPasswordParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -27,10 +27,29 @@ import (
 	"strings"
 )
 
+type HypervisorServiceIface interface {
+	NewListHypervisorCapabilitiesParams() *ListHypervisorCapabilitiesParams
+	GetHypervisorCapabilityByID(id string, opts ...OptionFunc) (*HypervisorCapability, int, error)
+	ListHypervisorCapabilities(p *ListHypervisorCapabilitiesParams) (*ListHypervisorCapabilitiesResponse, error)
+	NewListHypervisorsParams() *ListHypervisorsParams
+	ListHypervisors(p *ListHypervisorsParams) (*ListHypervisorsResponse, error)
+	NewUpdateHypervisorCapabilitiesParams() *UpdateHypervisorCapabilitiesParams
+	UpdateHypervisorCapabilities(p *UpdateHypervisorCapabilitiesParams) (*UpdateHypervisorCapabilitiesResponse, error)
+}
+
 type ListHypervisorCapabilitiesParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListHypervisorCapabilitiesParams) Copy() *ListHypervisorCapabilitiesParams {
+	c := &ListHypervisorCapabilitiesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListHypervisorCapabilitiesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -169,6 +188,15 @@ type ListHypervisorsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListHypervisorsParams) Copy() *ListHypervisorsParams {
+	c := &ListHypervisorsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListHypervisorsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -225,6 +253,15 @@ type UpdateHypervisorCapabilitiesParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdateHypervisorCapabilitiesParams) Copy() *UpdateHypervisorCapabilitiesParams {
+	c := &UpdateHypervisorCapabilitiesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateHypervisorCapabilitiesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
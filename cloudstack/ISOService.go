@@ -27,10 +27,46 @@ import (
 	"strings"
 )
 
+type ISOServiceIface interface {
+	NewAttachIsoParams(id string, virtualmachineid string) *AttachIsoParams
+	AttachIso(p *AttachIsoParams) (*AttachIsoResponse, error)
+	NewCopyIsoParams(id string) *CopyIsoParams
+	CopyIso(p *CopyIsoParams) (*CopyIsoResponse, error)
+	NewDeleteIsoParams(id string) *DeleteIsoParams
+	DeleteIso(p *DeleteIsoParams) (*DeleteIsoResponse, error)
+	NewDetachIsoParams(virtualmachineid string) *DetachIsoParams
+	DetachIso(p *DetachIsoParams) (*DetachIsoResponse, error)
+	NewExtractIsoParams(id string, mode string) *ExtractIsoParams
+	ExtractIso(p *ExtractIsoParams) (*ExtractIsoResponse, error)
+	NewListIsoPermissionsParams(id string) *ListIsoPermissionsParams
+	GetIsoPermissionByID(id string, opts ...OptionFunc) (*IsoPermission, int, error)
+	ListIsoPermissions(p *ListIsoPermissionsParams) (*ListIsoPermissionsResponse, error)
+	NewListIsosParams() *ListIsosParams
+	GetIsoID(name string, isofilter string, zoneid string, opts ...OptionFunc) (string, int, error)
+	GetIsoByName(name string, isofilter string, zoneid string, opts ...OptionFunc) (*Iso, int, error)
+	GetIsoByID(id string, opts ...OptionFunc) (*Iso, int, error)
+	ListIsos(p *ListIsosParams) (*ListIsosResponse, error)
+	NewRegisterIsoParams(displaytext string, name string, url string, zoneid string) *RegisterIsoParams
+	RegisterIso(p *RegisterIsoParams) (*RegisterIsoResponse, error)
+	NewUpdateIsoParams(id string) *UpdateIsoParams
+	UpdateIso(p *UpdateIsoParams) (*UpdateIsoResponse, error)
+	NewUpdateIsoPermissionsParams(id string) *UpdateIsoPermissionsParams
+	UpdateIsoPermissions(p *UpdateIsoPermissionsParams) (*UpdateIsoPermissionsResponse, error)
+}
+
 type AttachIsoParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *AttachIsoParams) Copy() *AttachIsoParams {
+	c := &AttachIsoParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AttachIsoParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -245,6 +281,15 @@ type CopyIsoParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CopyIsoParams) Copy() *CopyIsoParams {
+	c := &CopyIsoParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CopyIsoParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -416,6 +461,15 @@ type DeleteIsoParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteIsoParams) Copy() *DeleteIsoParams {
+	c := &DeleteIsoParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteIsoParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -494,6 +548,15 @@ type DetachIsoParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DetachIsoParams) Copy() *DetachIsoParams {
+	c := &DetachIsoParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DetachIsoParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -697,6 +760,15 @@ type ExtractIsoParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ExtractIsoParams) Copy() *ExtractIsoParams {
+	c := &ExtractIsoParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ExtractIsoParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -813,6 +885,15 @@ type ListIsoPermissionsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListIsoPermissionsParams) Copy() *ListIsoPermissionsParams {
+	c := &ListIsoPermissionsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListIsoPermissionsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -907,6 +988,15 @@ type ListIsosParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListIsosParams) Copy() *ListIsosParams {
+	c := &ListIsosParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListIsosParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1298,6 +1388,15 @@ type RegisterIsoParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *RegisterIsoParams) Copy() *RegisterIsoParams {
+	c := &RegisterIsoParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *RegisterIsoParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1588,6 +1687,15 @@ type UpdateIsoParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdateIsoParams) Copy() *UpdateIsoParams {
+	c := &UpdateIsoParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateIsoParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1849,6 +1957,15 @@ type UpdateIsoPermissionsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdateIsoPermissionsParams) Copy() *UpdateIsoPermissionsParams {
+	c := &UpdateIsoPermissionsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateIsoPermissionsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
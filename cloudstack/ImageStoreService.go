@@ -27,10 +27,44 @@ import (
 	"strings"
 )
 
+type ImageStoreServiceIface interface {
+	NewAddImageStoreParams(provider string) *AddImageStoreParams
+	AddImageStore(p *AddImageStoreParams) (*AddImageStoreResponse, error)
+	NewAddImageStoreS3Params(accesskey string, bucket string, endpoint string, secretkey string) *AddImageStoreS3Params
+	AddImageStoreS3(p *AddImageStoreS3Params) (*AddImageStoreS3Response, error)
+	NewCreateSecondaryStagingStoreParams(url string) *CreateSecondaryStagingStoreParams
+	CreateSecondaryStagingStore(p *CreateSecondaryStagingStoreParams) (*CreateSecondaryStagingStoreResponse, error)
+	NewDeleteImageStoreParams(id string) *DeleteImageStoreParams
+	DeleteImageStore(p *DeleteImageStoreParams) (*DeleteImageStoreResponse, error)
+	NewDeleteSecondaryStagingStoreParams(id string) *DeleteSecondaryStagingStoreParams
+	DeleteSecondaryStagingStore(p *DeleteSecondaryStagingStoreParams) (*DeleteSecondaryStagingStoreResponse, error)
+	NewListImageStoresParams() *ListImageStoresParams
+	GetImageStoreID(name string, opts ...OptionFunc) (string, int, error)
+	GetImageStoreByName(name string, opts ...OptionFunc) (*ImageStore, int, error)
+	GetImageStoreByID(id string, opts ...OptionFunc) (*ImageStore, int, error)
+	ListImageStores(p *ListImageStoresParams) (*ListImageStoresResponse, error)
+	NewListSecondaryStagingStoresParams() *ListSecondaryStagingStoresParams
+	GetSecondaryStagingStoreID(name string, opts ...OptionFunc) (string, int, error)
+	GetSecondaryStagingStoreByName(name string, opts ...OptionFunc) (*SecondaryStagingStore, int, error)
+	GetSecondaryStagingStoreByID(id string, opts ...OptionFunc) (*SecondaryStagingStore, int, error)
+	ListSecondaryStagingStores(p *ListSecondaryStagingStoresParams) (*ListSecondaryStagingStoresResponse, error)
+	NewUpdateCloudToUseObjectStoreParams(provider string) *UpdateCloudToUseObjectStoreParams
+	UpdateCloudToUseObjectStore(p *UpdateCloudToUseObjectStoreParams) (*UpdateCloudToUseObjectStoreResponse, error)
+}
+
 type AddImageStoreParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *AddImageStoreParams) Copy() *AddImageStoreParams {
+	c := &AddImageStoreParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddImageStoreParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -134,6 +168,15 @@ type AddImageStoreS3Params struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *AddImageStoreS3Params) Copy() *AddImageStoreS3Params {
+	c := &AddImageStoreS3Params{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddImageStoreS3Params) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -302,6 +345,15 @@ type CreateSecondaryStagingStoreParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CreateSecondaryStagingStoreParams) Copy() *CreateSecondaryStagingStoreParams {
+	c := &CreateSecondaryStagingStoreParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateSecondaryStagingStoreParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -405,6 +457,15 @@ type DeleteImageStoreParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteImageStoreParams) Copy() *DeleteImageStoreParams {
+	c := &DeleteImageStoreParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteImageStoreParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -485,6 +546,15 @@ type DeleteSecondaryStagingStoreParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteSecondaryStagingStoreParams) Copy() *DeleteSecondaryStagingStoreParams {
+	c := &DeleteSecondaryStagingStoreParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteSecondaryStagingStoreParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -565,6 +635,15 @@ type ListImageStoresParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListImageStoresParams) Copy() *ListImageStoresParams {
+	c := &ListImageStoresParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListImageStoresParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -783,6 +862,15 @@ type ListSecondaryStagingStoresParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListSecondaryStagingStoresParams) Copy() *ListSecondaryStagingStoresParams {
+	c := &ListSecondaryStagingStoresParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListSecondaryStagingStoresParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1001,6 +1089,15 @@ type UpdateCloudToUseObjectStoreParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdateCloudToUseObjectStoreParams) Copy() *UpdateCloudToUseObjectStoreParams {
+	c := &UpdateCloudToUseObjectStoreParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateCloudToUseObjectStoreParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
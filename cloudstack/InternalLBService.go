@@ -27,10 +27,38 @@ import (
 	"strings"
 )
 
+type InternalLBServiceIface interface {
+	NewConfigureInternalLoadBalancerElementParams(enabled bool, id string) *ConfigureInternalLoadBalancerElementParams
+	ConfigureInternalLoadBalancerElement(p *ConfigureInternalLoadBalancerElementParams) (*InternalLoadBalancerElementResponse, error)
+	NewCreateInternalLoadBalancerElementParams(nspid string) *CreateInternalLoadBalancerElementParams
+	CreateInternalLoadBalancerElement(p *CreateInternalLoadBalancerElementParams) (*CreateInternalLoadBalancerElementResponse, error)
+	NewListInternalLoadBalancerElementsParams() *ListInternalLoadBalancerElementsParams
+	GetInternalLoadBalancerElementByID(id string, opts ...OptionFunc) (*InternalLoadBalancerElement, int, error)
+	ListInternalLoadBalancerElements(p *ListInternalLoadBalancerElementsParams) (*ListInternalLoadBalancerElementsResponse, error)
+	NewListInternalLoadBalancerVMsParams() *ListInternalLoadBalancerVMsParams
+	GetInternalLoadBalancerVMID(name string, opts ...OptionFunc) (string, int, error)
+	GetInternalLoadBalancerVMByName(name string, opts ...OptionFunc) (*InternalLoadBalancerVM, int, error)
+	GetInternalLoadBalancerVMByID(id string, opts ...OptionFunc) (*InternalLoadBalancerVM, int, error)
+	ListInternalLoadBalancerVMs(p *ListInternalLoadBalancerVMsParams) (*ListInternalLoadBalancerVMsResponse, error)
+	NewStartInternalLoadBalancerVMParams(id string) *StartInternalLoadBalancerVMParams
+	StartInternalLoadBalancerVM(p *StartInternalLoadBalancerVMParams) (*StartInternalLoadBalancerVMResponse, error)
+	NewStopInternalLoadBalancerVMParams(id string) *StopInternalLoadBalancerVMParams
+	StopInternalLoadBalancerVM(p *StopInternalLoadBalancerVMParams) (*StopInternalLoadBalancerVMResponse, error)
+}
+
 type ConfigureInternalLoadBalancerElementParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ConfigureInternalLoadBalancerElementParams) Copy() *ConfigureInternalLoadBalancerElementParams {
+	c := &ConfigureInternalLoadBalancerElementParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ConfigureInternalLoadBalancerElementParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -117,6 +145,15 @@ type CreateInternalLoadBalancerElementParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CreateInternalLoadBalancerElementParams) Copy() *CreateInternalLoadBalancerElementParams {
+	c := &CreateInternalLoadBalancerElementParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateInternalLoadBalancerElementParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -191,6 +228,15 @@ type ListInternalLoadBalancerElementsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListInternalLoadBalancerElementsParams) Copy() *ListInternalLoadBalancerElementsParams {
+	c := &ListInternalLoadBalancerElementsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListInternalLoadBalancerElementsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -335,6 +381,15 @@ type ListInternalLoadBalancerVMsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListInternalLoadBalancerVMsParams) Copy() *ListInternalLoadBalancerVMsParams {
+	c := &ListInternalLoadBalancerVMsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListInternalLoadBalancerVMsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -684,6 +739,15 @@ type StartInternalLoadBalancerVMParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *StartInternalLoadBalancerVMParams) Copy() *StartInternalLoadBalancerVMParams {
+	c := &StartInternalLoadBalancerVMParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *StartInternalLoadBalancerVMParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -801,6 +865,15 @@ type StopInternalLoadBalancerVMParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *StopInternalLoadBalancerVMParams) Copy() *StopInternalLoadBalancerVMParams {
+	c := &StopInternalLoadBalancerVMParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *StopInternalLoadBalancerVMParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
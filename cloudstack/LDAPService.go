@@ -26,10 +26,42 @@ import (
 	"strconv"
 )
 
+type LDAPServiceIface interface {
+	NewAddLdapConfigurationParams(hostname string, port int) *AddLdapConfigurationParams
+	AddLdapConfiguration(p *AddLdapConfigurationParams) (*AddLdapConfigurationResponse, error)
+	NewDeleteLdapConfigurationParams(hostname string) *DeleteLdapConfigurationParams
+	DeleteLdapConfiguration(p *DeleteLdapConfigurationParams) (*DeleteLdapConfigurationResponse, error)
+	NewImportLdapUsersParams() *ImportLdapUsersParams
+	ImportLdapUsers(p *ImportLdapUsersParams) (*ImportLdapUsersResponse, error)
+	NewLdapConfigParams() *LdapConfigParams
+	LdapConfig(p *LdapConfigParams) (*LdapConfigResponse, error)
+	NewLdapCreateAccountParams(username string) *LdapCreateAccountParams
+	LdapCreateAccount(p *LdapCreateAccountParams) (*LdapCreateAccountResponse, error)
+	NewLdapRemoveParams() *LdapRemoveParams
+	LdapRemove(p *LdapRemoveParams) (*LdapRemoveResponse, error)
+	NewLinkDomainToLdapParams(accounttype int, domainid string, lDAPType string) *LinkDomainToLdapParams
+	LinkDomainToLdap(p *LinkDomainToLdapParams) (*LinkDomainToLdapResponse, error)
+	NewListLdapConfigurationsParams() *ListLdapConfigurationsParams
+	ListLdapConfigurations(p *ListLdapConfigurationsParams) (*ListLdapConfigurationsResponse, error)
+	NewListLdapUsersParams() *ListLdapUsersParams
+	ListLdapUsers(p *ListLdapUsersParams) (*ListLdapUsersResponse, error)
+	NewSearchLdapParams(query string) *SearchLdapParams
+	SearchLdap(p *SearchLdapParams) (*SearchLdapResponse, error)
+}
+
 type AddLdapConfigurationParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *AddLdapConfigurationParams) Copy() *AddLdapConfigurationParams {
+	c := &AddLdapConfigurationParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddLdapConfigurationParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -106,6 +138,15 @@ type DeleteLdapConfigurationParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteLdapConfigurationParams) Copy() *DeleteLdapConfigurationParams {
+	c := &DeleteLdapConfigurationParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteLdapConfigurationParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -181,6 +222,15 @@ type ImportLdapUsersParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ImportLdapUsersParams) Copy() *ImportLdapUsersParams {
+	c := &ImportLdapUsersParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ImportLdapUsersParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -334,6 +384,15 @@ type LdapConfigParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *LdapConfigParams) Copy() *LdapConfigParams {
+	c := &LdapConfigParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *LdapConfigParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -484,6 +543,15 @@ type LdapCreateAccountParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *LdapCreateAccountParams) Copy() *LdapCreateAccountParams {
+	c := &LdapCreateAccountParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *LdapCreateAccountParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -710,6 +778,15 @@ type LdapRemoveParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *LdapRemoveParams) Copy() *LdapRemoveParams {
+	c := &LdapRemoveParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *LdapRemoveParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -757,6 +834,15 @@ type LinkDomainToLdapParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *LinkDomainToLdapParams) Copy() *LinkDomainToLdapParams {
+	c := &LinkDomainToLdapParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *LinkDomainToLdapParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -867,6 +953,15 @@ type ListLdapConfigurationsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListLdapConfigurationsParams) Copy() *ListLdapConfigurationsParams {
+	c := &ListLdapConfigurationsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListLdapConfigurationsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -978,6 +1073,15 @@ type ListLdapUsersParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListLdapUsersParams) Copy() *ListLdapUsersParams {
+	c := &ListLdapUsersParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListLdapUsersParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1071,6 +1175,15 @@ type SearchLdapParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *SearchLdapParams) Copy() *SearchLdapParams {
+	c := &SearchLdapParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *SearchLdapParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
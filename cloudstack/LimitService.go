@@ -25,10 +25,32 @@ import (
 	"strconv"
 )
 
+type LimitServiceIface interface {
+	NewGetApiLimitParams() *GetApiLimitParams
+	GetApiLimit(p *GetApiLimitParams) (*GetApiLimitResponse, error)
+	NewListResourceLimitsParams() *ListResourceLimitsParams
+	ListResourceLimits(p *ListResourceLimitsParams) (*ListResourceLimitsResponse, error)
+	NewResetApiLimitParams() *ResetApiLimitParams
+	ResetApiLimit(p *ResetApiLimitParams) (*ResetApiLimitResponse, error)
+	NewUpdateResourceCountParams(domainid string) *UpdateResourceCountParams
+	UpdateResourceCount(p *UpdateResourceCountParams) (*UpdateResourceCountResponse, error)
+	NewUpdateResourceLimitParams(resourcetype int) *UpdateResourceLimitParams
+	UpdateResourceLimit(p *UpdateResourceLimitParams) (*UpdateResourceLimitResponse, error)
+}
+
 type GetApiLimitParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *GetApiLimitParams) Copy() *GetApiLimitParams {
+	c := &GetApiLimitParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *GetApiLimitParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -74,6 +96,15 @@ type ListResourceLimitsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListResourceLimitsParams) Copy() *ListResourceLimitsParams {
+	c := &ListResourceLimitsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListResourceLimitsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -243,6 +274,15 @@ type ResetApiLimitParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ResetApiLimitParams) Copy() *ResetApiLimitParams {
+	c := &ResetApiLimitParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ResetApiLimitParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -298,6 +338,15 @@ type UpdateResourceCountParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdateResourceCountParams) Copy() *UpdateResourceCountParams {
+	c := &UpdateResourceCountParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateResourceCountParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -388,6 +437,15 @@ type UpdateResourceLimitParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdateResourceLimitParams) Copy() *UpdateResourceLimitParams {
+	c := &UpdateResourceLimitParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateResourceLimitParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
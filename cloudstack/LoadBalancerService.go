@@ -27,10 +27,102 @@ import (
 	"strings"
 )
 
+type LoadBalancerServiceIface interface {
+	NewAddNetscalerLoadBalancerParams(networkdevicetype string, password string, physicalnetworkid string, url string, username string) *AddNetscalerLoadBalancerParams
+	AddNetscalerLoadBalancer(p *AddNetscalerLoadBalancerParams) (*AddNetscalerLoadBalancerResponse, error)
+	NewAssignCertToLoadBalancerParams(certid string, lbruleid string) *AssignCertToLoadBalancerParams
+	AssignCertToLoadBalancer(p *AssignCertToLoadBalancerParams) (*AssignCertToLoadBalancerResponse, error)
+	NewAssignToGlobalLoadBalancerRuleParams(id string, loadbalancerrulelist []string) *AssignToGlobalLoadBalancerRuleParams
+	AssignToGlobalLoadBalancerRule(p *AssignToGlobalLoadBalancerRuleParams) (*AssignToGlobalLoadBalancerRuleResponse, error)
+	NewAssignToLoadBalancerRuleParams(id string) *AssignToLoadBalancerRuleParams
+	AssignToLoadBalancerRule(p *AssignToLoadBalancerRuleParams) (*AssignToLoadBalancerRuleResponse, error)
+	NewConfigureNetscalerLoadBalancerParams(lbdeviceid string) *ConfigureNetscalerLoadBalancerParams
+	ConfigureNetscalerLoadBalancer(p *ConfigureNetscalerLoadBalancerParams) (*NetscalerLoadBalancerResponse, error)
+	NewCreateGlobalLoadBalancerRuleParams(gslbdomainname string, gslbservicetype string, name string, regionid int) *CreateGlobalLoadBalancerRuleParams
+	CreateGlobalLoadBalancerRule(p *CreateGlobalLoadBalancerRuleParams) (*CreateGlobalLoadBalancerRuleResponse, error)
+	NewCreateLBHealthCheckPolicyParams(lbruleid string) *CreateLBHealthCheckPolicyParams
+	CreateLBHealthCheckPolicy(p *CreateLBHealthCheckPolicyParams) (*CreateLBHealthCheckPolicyResponse, error)
+	NewCreateLBStickinessPolicyParams(lbruleid string, methodname string, name string) *CreateLBStickinessPolicyParams
+	CreateLBStickinessPolicy(p *CreateLBStickinessPolicyParams) (*CreateLBStickinessPolicyResponse, error)
+	NewCreateLoadBalancerParams(algorithm string, instanceport int, name string, networkid string, scheme string, sourceipaddressnetworkid string, sourceport int) *CreateLoadBalancerParams
+	CreateLoadBalancer(p *CreateLoadBalancerParams) (*CreateLoadBalancerResponse, error)
+	NewCreateLoadBalancerRuleParams(algorithm string, name string, privateport int, publicport int) *CreateLoadBalancerRuleParams
+	CreateLoadBalancerRule(p *CreateLoadBalancerRuleParams) (*CreateLoadBalancerRuleResponse, error)
+	NewDeleteGlobalLoadBalancerRuleParams(id string) *DeleteGlobalLoadBalancerRuleParams
+	DeleteGlobalLoadBalancerRule(p *DeleteGlobalLoadBalancerRuleParams) (*DeleteGlobalLoadBalancerRuleResponse, error)
+	NewDeleteLBHealthCheckPolicyParams(id string) *DeleteLBHealthCheckPolicyParams
+	DeleteLBHealthCheckPolicy(p *DeleteLBHealthCheckPolicyParams) (*DeleteLBHealthCheckPolicyResponse, error)
+	NewDeleteLBStickinessPolicyParams(id string) *DeleteLBStickinessPolicyParams
+	DeleteLBStickinessPolicy(p *DeleteLBStickinessPolicyParams) (*DeleteLBStickinessPolicyResponse, error)
+	NewDeleteLoadBalancerParams(id string) *DeleteLoadBalancerParams
+	DeleteLoadBalancer(p *DeleteLoadBalancerParams) (*DeleteLoadBalancerResponse, error)
+	NewDeleteLoadBalancerRuleParams(id string) *DeleteLoadBalancerRuleParams
+	DeleteLoadBalancerRule(p *DeleteLoadBalancerRuleParams) (*DeleteLoadBalancerRuleResponse, error)
+	NewDeleteNetscalerLoadBalancerParams(lbdeviceid string) *DeleteNetscalerLoadBalancerParams
+	DeleteNetscalerLoadBalancer(p *DeleteNetscalerLoadBalancerParams) (*DeleteNetscalerLoadBalancerResponse, error)
+	NewDeleteSslCertParams(id string) *DeleteSslCertParams
+	DeleteSslCert(p *DeleteSslCertParams) (*DeleteSslCertResponse, error)
+	NewListGlobalLoadBalancerRulesParams() *ListGlobalLoadBalancerRulesParams
+	GetGlobalLoadBalancerRuleID(keyword string, opts ...OptionFunc) (string, int, error)
+	GetGlobalLoadBalancerRuleByName(name string, opts ...OptionFunc) (*GlobalLoadBalancerRule, int, error)
+	GetGlobalLoadBalancerRuleByID(id string, opts ...OptionFunc) (*GlobalLoadBalancerRule, int, error)
+	ListGlobalLoadBalancerRules(p *ListGlobalLoadBalancerRulesParams) (*ListGlobalLoadBalancerRulesResponse, error)
+	NewListLBHealthCheckPoliciesParams() *ListLBHealthCheckPoliciesParams
+	GetLBHealthCheckPolicyByID(id string, opts ...OptionFunc) (*LBHealthCheckPolicy, int, error)
+	ListLBHealthCheckPolicies(p *ListLBHealthCheckPoliciesParams) (*ListLBHealthCheckPoliciesResponse, error)
+	NewListLBStickinessPoliciesParams() *ListLBStickinessPoliciesParams
+	GetLBStickinessPolicyByID(id string, opts ...OptionFunc) (*LBStickinessPolicy, int, error)
+	ListLBStickinessPolicies(p *ListLBStickinessPoliciesParams) (*ListLBStickinessPoliciesResponse, error)
+	NewListLoadBalancerRuleInstancesParams(id string) *ListLoadBalancerRuleInstancesParams
+	GetLoadBalancerRuleInstanceByID(id string, opts ...OptionFunc) (*VirtualMachine, int, error)
+	ListLoadBalancerRuleInstances(p *ListLoadBalancerRuleInstancesParams) (*ListLoadBalancerRuleInstancesResponse, error)
+	NewListLoadBalancerRulesParams() *ListLoadBalancerRulesParams
+	GetLoadBalancerRuleID(name string, opts ...OptionFunc) (string, int, error)
+	GetLoadBalancerRuleByName(name string, opts ...OptionFunc) (*LoadBalancerRule, int, error)
+	GetLoadBalancerRuleByID(id string, opts ...OptionFunc) (*LoadBalancerRule, int, error)
+	ListLoadBalancerRules(p *ListLoadBalancerRulesParams) (*ListLoadBalancerRulesResponse, error)
+	NewListLoadBalancersParams() *ListLoadBalancersParams
+	GetLoadBalancerID(name string, opts ...OptionFunc) (string, int, error)
+	GetLoadBalancerByName(name string, opts ...OptionFunc) (*LoadBalancer, int, error)
+	GetLoadBalancerByID(id string, opts ...OptionFunc) (*LoadBalancer, int, error)
+	ListLoadBalancers(p *ListLoadBalancersParams) (*ListLoadBalancersResponse, error)
+	NewListNetscalerLoadBalancersParams() *ListNetscalerLoadBalancersParams
+	ListNetscalerLoadBalancers(p *ListNetscalerLoadBalancersParams) (*ListNetscalerLoadBalancersResponse, error)
+	NewListSslCertsParams() *ListSslCertsParams
+	ListSslCerts(p *ListSslCertsParams) (*ListSslCertsResponse, error)
+	NewRemoveCertFromLoadBalancerParams(lbruleid string) *RemoveCertFromLoadBalancerParams
+	RemoveCertFromLoadBalancer(p *RemoveCertFromLoadBalancerParams) (*RemoveCertFromLoadBalancerResponse, error)
+	NewRemoveFromGlobalLoadBalancerRuleParams(id string, loadbalancerrulelist []string) *RemoveFromGlobalLoadBalancerRuleParams
+	RemoveFromGlobalLoadBalancerRule(p *RemoveFromGlobalLoadBalancerRuleParams) (*RemoveFromGlobalLoadBalancerRuleResponse, error)
+	NewRemoveFromLoadBalancerRuleParams(id string) *RemoveFromLoadBalancerRuleParams
+	RemoveFromLoadBalancerRule(p *RemoveFromLoadBalancerRuleParams) (*RemoveFromLoadBalancerRuleResponse, error)
+	NewUpdateGlobalLoadBalancerRuleParams(id string) *UpdateGlobalLoadBalancerRuleParams
+	UpdateGlobalLoadBalancerRule(p *UpdateGlobalLoadBalancerRuleParams) (*UpdateGlobalLoadBalancerRuleResponse, error)
+	NewUpdateLBHealthCheckPolicyParams(id string) *UpdateLBHealthCheckPolicyParams
+	UpdateLBHealthCheckPolicy(p *UpdateLBHealthCheckPolicyParams) (*UpdateLBHealthCheckPolicyResponse, error)
+	NewUpdateLBStickinessPolicyParams(id string) *UpdateLBStickinessPolicyParams
+	UpdateLBStickinessPolicy(p *UpdateLBStickinessPolicyParams) (*UpdateLBStickinessPolicyResponse, error)
+	NewUpdateLoadBalancerParams(id string) *UpdateLoadBalancerParams
+	UpdateLoadBalancer(p *UpdateLoadBalancerParams) (*UpdateLoadBalancerResponse, error)
+	NewUpdateLoadBalancerRuleParams(id string) *UpdateLoadBalancerRuleParams
+	UpdateLoadBalancerRule(p *UpdateLoadBalancerRuleParams) (*UpdateLoadBalancerRuleResponse, error)
+	NewUploadSslCertParams(certificate string, name string, privatekey string) *UploadSslCertParams
+	UploadSslCert(p *UploadSslCertParams) (*UploadSslCertResponse, error)
+}
+
 type AddNetscalerLoadBalancerParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *AddNetscalerLoadBalancerParams) Copy() *AddNetscalerLoadBalancerParams {
+	c := &AddNetscalerLoadBalancerParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddNetscalerLoadBalancerParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -203,6 +295,15 @@ type AssignCertToLoadBalancerParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *AssignCertToLoadBalancerParams) Copy() *AssignCertToLoadBalancerParams {
+	c := &AssignCertToLoadBalancerParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AssignCertToLoadBalancerParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -282,6 +383,15 @@ type AssignToGlobalLoadBalancerRuleParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *AssignToGlobalLoadBalancerRuleParams) Copy() *AssignToGlobalLoadBalancerRuleParams {
+	c := &AssignToGlobalLoadBalancerRuleParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AssignToGlobalLoadBalancerRuleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -290,8 +400,8 @@ func (p *AssignToGlobalLoadBalancerRuleParams) toURLValues() url.Values {
 	if v, found := p.p["gslblbruleweightsmap"]; found {
 		m := v.(map[string]string)
 		for i, k := range getSortedKeysFromMap(m) {
-			u.Set(fmt.Sprintf("gslblbruleweightsmap[%d].key", i), k)
-			u.Set(fmt.Sprintf("gslblbruleweightsmap[%d].value", i), m[k])
+			u.Set(fmt.Sprintf("gslblbruleweightsmap[%d].loadbalancerid", i), k)
+			u.Set(fmt.Sprintf("gslblbruleweightsmap[%d].weight", i), m[k])
 		}
 	}
 	if v, found := p.p["id"]; found {
@@ -376,6 +486,15 @@ type AssignToLoadBalancerRuleParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *AssignToLoadBalancerRuleParams) Copy() *AssignToLoadBalancerRuleParams {
+	c := &AssignToLoadBalancerRuleParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AssignToLoadBalancerRuleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -391,8 +510,8 @@ func (p *AssignToLoadBalancerRuleParams) toURLValues() url.Values {
 	if v, found := p.p["vmidipmap"]; found {
 		m := v.(map[string]string)
 		for i, k := range getSortedKeysFromMap(m) {
-			u.Set(fmt.Sprintf("vmidipmap[%d].key", i), k)
-			u.Set(fmt.Sprintf("vmidipmap[%d].value", i), m[k])
+			u.Set(fmt.Sprintf("vmidipmap[%d].vmid", i), k)
+			u.Set(fmt.Sprintf("vmidipmap[%d].vmip", i), m[k])
 		}
 	}
 	return u
@@ -469,6 +588,15 @@ type ConfigureNetscalerLoadBalancerParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ConfigureNetscalerLoadBalancerParams) Copy() *ConfigureNetscalerLoadBalancerParams {
+	c := &ConfigureNetscalerLoadBalancerParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ConfigureNetscalerLoadBalancerParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -599,6 +727,15 @@ type CreateGlobalLoadBalancerRuleParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CreateGlobalLoadBalancerRuleParams) Copy() *CreateGlobalLoadBalancerRuleParams {
+	c := &CreateGlobalLoadBalancerRuleParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateGlobalLoadBalancerRuleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -792,6 +929,15 @@ type CreateLBHealthCheckPolicyParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CreateLBHealthCheckPolicyParams) Copy() *CreateLBHealthCheckPolicyParams {
+	c := &CreateLBHealthCheckPolicyParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateLBHealthCheckPolicyParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -956,6 +1102,15 @@ type CreateLBStickinessPolicyParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CreateLBStickinessPolicyParams) Copy() *CreateLBStickinessPolicyParams {
+	c := &CreateLBStickinessPolicyParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateLBStickinessPolicyParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1103,6 +1258,15 @@ type CreateLoadBalancerParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CreateLoadBalancerParams) Copy() *CreateLoadBalancerParams {
+	c := &CreateLoadBalancerParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateLoadBalancerParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1302,6 +1466,15 @@ type CreateLoadBalancerRuleParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CreateLoadBalancerRuleParams) Copy() *CreateLoadBalancerRuleParams {
+	c := &CreateLoadBalancerRuleParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateLoadBalancerRuleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1532,6 +1705,15 @@ type DeleteGlobalLoadBalancerRuleParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteGlobalLoadBalancerRuleParams) Copy() *DeleteGlobalLoadBalancerRuleParams {
+	c := &DeleteGlobalLoadBalancerRuleParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteGlobalLoadBalancerRuleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1600,6 +1782,15 @@ type DeleteLBHealthCheckPolicyParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteLBHealthCheckPolicyParams) Copy() *DeleteLBHealthCheckPolicyParams {
+	c := &DeleteLBHealthCheckPolicyParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteLBHealthCheckPolicyParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1668,6 +1859,15 @@ type DeleteLBStickinessPolicyParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteLBStickinessPolicyParams) Copy() *DeleteLBStickinessPolicyParams {
+	c := &DeleteLBStickinessPolicyParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteLBStickinessPolicyParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1736,6 +1936,15 @@ type DeleteLoadBalancerParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteLoadBalancerParams) Copy() *DeleteLoadBalancerParams {
+	c := &DeleteLoadBalancerParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteLoadBalancerParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1804,6 +2013,15 @@ type DeleteLoadBalancerRuleParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteLoadBalancerRuleParams) Copy() *DeleteLoadBalancerRuleParams {
+	c := &DeleteLoadBalancerRuleParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteLoadBalancerRuleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1872,6 +2090,15 @@ type DeleteNetscalerLoadBalancerParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteNetscalerLoadBalancerParams) Copy() *DeleteNetscalerLoadBalancerParams {
+	c := &DeleteNetscalerLoadBalancerParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteNetscalerLoadBalancerParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1899,7 +2126,7 @@ func (s *LoadBalancerService) NewDeleteNetscalerLoadBalancerParams(lbdeviceid st
 	return p
 }
 
-//  delete a netscaler load balancer device
+// delete a netscaler load balancer device
 func (s *LoadBalancerService) DeleteNetscalerLoadBalancer(p *DeleteNetscalerLoadBalancerParams) (*DeleteNetscalerLoadBalancerResponse, error) {
 	resp, err := s.cs.newRequest("deleteNetscalerLoadBalancer", p.toURLValues())
 	if err != nil {
@@ -1940,6 +2167,15 @@ type DeleteSslCertParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteSslCertParams) Copy() *DeleteSslCertParams {
+	c := &DeleteSslCertParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteSslCertParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2020,6 +2256,15 @@ type ListGlobalLoadBalancerRulesParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListGlobalLoadBalancerRulesParams) Copy() *ListGlobalLoadBalancerRulesParams {
+	c := &ListGlobalLoadBalancerRulesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListGlobalLoadBalancerRulesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2305,6 +2550,15 @@ type ListLBHealthCheckPoliciesParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListLBHealthCheckPoliciesParams) Copy() *ListLBHealthCheckPoliciesParams {
+	c := &ListLBHealthCheckPoliciesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListLBHealthCheckPoliciesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2464,6 +2718,15 @@ type ListLBStickinessPoliciesParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListLBStickinessPoliciesParams) Copy() *ListLBStickinessPoliciesParams {
+	c := &ListLBStickinessPoliciesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListLBStickinessPoliciesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2624,6 +2887,15 @@ type ListLoadBalancerRuleInstancesParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListLoadBalancerRuleInstancesParams) Copy() *ListLoadBalancerRuleInstancesParams {
+	c := &ListLoadBalancerRuleInstancesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListLoadBalancerRuleInstancesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2770,6 +3042,15 @@ type ListLoadBalancerRulesParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListLoadBalancerRulesParams) Copy() *ListLoadBalancerRulesParams {
+	c := &ListLoadBalancerRulesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListLoadBalancerRulesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -3088,6 +3369,15 @@ type ListLoadBalancersParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListLoadBalancersParams) Copy() *ListLoadBalancersParams {
+	c := &ListLoadBalancersParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListLoadBalancersParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -3414,6 +3704,15 @@ type ListNetscalerLoadBalancersParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListNetscalerLoadBalancersParams) Copy() *ListNetscalerLoadBalancersParams {
+	c := &ListNetscalerLoadBalancersParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListNetscalerLoadBalancersParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -3526,6 +3825,15 @@ type ListSslCertsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListSslCertsParams) Copy() *ListSslCertsParams {
+	c := &ListSslCertsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListSslCertsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -3622,6 +3930,15 @@ type RemoveCertFromLoadBalancerParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *RemoveCertFromLoadBalancerParams) Copy() *RemoveCertFromLoadBalancerParams {
+	c := &RemoveCertFromLoadBalancerParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *RemoveCertFromLoadBalancerParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -3690,6 +4007,15 @@ type RemoveFromGlobalLoadBalancerRuleParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *RemoveFromGlobalLoadBalancerRuleParams) Copy() *RemoveFromGlobalLoadBalancerRuleParams {
+	c := &RemoveFromGlobalLoadBalancerRuleParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *RemoveFromGlobalLoadBalancerRuleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -3770,6 +4096,15 @@ type RemoveFromLoadBalancerRuleParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *RemoveFromLoadBalancerRuleParams) Copy() *RemoveFromLoadBalancerRuleParams {
+	c := &RemoveFromLoadBalancerRuleParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *RemoveFromLoadBalancerRuleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -3785,8 +4120,8 @@ func (p *RemoveFromLoadBalancerRuleParams) toURLValues() url.Values {
 	if v, found := p.p["vmidipmap"]; found {
 		m := v.(map[string]string)
 		for i, k := range getSortedKeysFromMap(m) {
-			u.Set(fmt.Sprintf("vmidipmap[%d].key", i), k)
-			u.Set(fmt.Sprintf("vmidipmap[%d].value", i), m[k])
+			u.Set(fmt.Sprintf("vmidipmap[%d].vmid", i), k)
+			u.Set(fmt.Sprintf("vmidipmap[%d].vmip", i), m[k])
 		}
 	}
 	return u
@@ -3863,6 +4198,15 @@ type UpdateGlobalLoadBalancerRuleParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdateGlobalLoadBalancerRuleParams) Copy() *UpdateGlobalLoadBalancerRuleParams {
+	c := &UpdateGlobalLoadBalancerRuleParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateGlobalLoadBalancerRuleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -4002,6 +4346,15 @@ type UpdateLBHealthCheckPolicyParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdateLBHealthCheckPolicyParams) Copy() *UpdateLBHealthCheckPolicyParams {
+	c := &UpdateLBHealthCheckPolicyParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateLBHealthCheckPolicyParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -4112,6 +4465,15 @@ type UpdateLBStickinessPolicyParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdateLBStickinessPolicyParams) Copy() *UpdateLBStickinessPolicyParams {
+	c := &UpdateLBStickinessPolicyParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateLBStickinessPolicyParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -4223,6 +4585,15 @@ type UpdateLoadBalancerParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdateLoadBalancerParams) Copy() *UpdateLoadBalancerParams {
+	c := &UpdateLoadBalancerParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateLoadBalancerParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -4344,6 +4715,15 @@ type UpdateLoadBalancerRuleParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdateLoadBalancerRuleParams) Copy() *UpdateLoadBalancerRuleParams {
+	c := &UpdateLoadBalancerRuleParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateLoadBalancerRuleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -4497,6 +4877,15 @@ type UploadSslCertParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UploadSslCertParams) Copy() *UploadSslCertParams {
+	c := &UploadSslCertParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UploadSslCertParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -27,10 +27,33 @@ import (
 	"strings"
 )
 
+type NATServiceIface interface {
+	NewCreateIpForwardingRuleParams(ipaddressid string, protocol string, startport int) *CreateIpForwardingRuleParams
+	CreateIpForwardingRule(p *CreateIpForwardingRuleParams) (*CreateIpForwardingRuleResponse, error)
+	NewDeleteIpForwardingRuleParams(id string) *DeleteIpForwardingRuleParams
+	DeleteIpForwardingRule(p *DeleteIpForwardingRuleParams) (*DeleteIpForwardingRuleResponse, error)
+	NewDisableStaticNatParams(ipaddressid string) *DisableStaticNatParams
+	DisableStaticNat(p *DisableStaticNatParams) (*DisableStaticNatResponse, error)
+	NewEnableStaticNatParams(ipaddressid string, virtualmachineid string) *EnableStaticNatParams
+	EnableStaticNat(p *EnableStaticNatParams) (*EnableStaticNatResponse, error)
+	NewListIpForwardingRulesParams() *ListIpForwardingRulesParams
+	GetIpForwardingRuleByID(id string, opts ...OptionFunc) (*IpForwardingRule, int, error)
+	ListIpForwardingRules(p *ListIpForwardingRulesParams) (*ListIpForwardingRulesResponse, error)
+}
+
 type CreateIpForwardingRuleParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CreateIpForwardingRuleParams) Copy() *CreateIpForwardingRuleParams {
+	c := &CreateIpForwardingRuleParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateIpForwardingRuleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -175,6 +198,15 @@ type DeleteIpForwardingRuleParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteIpForwardingRuleParams) Copy() *DeleteIpForwardingRuleParams {
+	c := &DeleteIpForwardingRuleParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteIpForwardingRuleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -243,6 +275,15 @@ type DisableStaticNatParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DisableStaticNatParams) Copy() *DisableStaticNatParams {
+	c := &DisableStaticNatParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DisableStaticNatParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -311,6 +352,15 @@ type EnableStaticNatParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *EnableStaticNatParams) Copy() *EnableStaticNatParams {
+	c := &EnableStaticNatParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *EnableStaticNatParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -422,6 +472,15 @@ type ListIpForwardingRulesParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListIpForwardingRulesParams) Copy() *ListIpForwardingRulesParams {
+	c := &ListIpForwardingRulesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListIpForwardingRulesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -27,10 +27,44 @@ import (
 	"strings"
 )
 
+type NetworkACLServiceIface interface {
+	NewCreateNetworkACLParams(protocol string) *CreateNetworkACLParams
+	CreateNetworkACL(p *CreateNetworkACLParams) (*CreateNetworkACLResponse, error)
+	NewCreateNetworkACLListParams(name string, vpcid string) *CreateNetworkACLListParams
+	CreateNetworkACLList(p *CreateNetworkACLListParams) (*CreateNetworkACLListResponse, error)
+	NewDeleteNetworkACLParams(id string) *DeleteNetworkACLParams
+	DeleteNetworkACL(p *DeleteNetworkACLParams) (*DeleteNetworkACLResponse, error)
+	NewDeleteNetworkACLListParams(id string) *DeleteNetworkACLListParams
+	DeleteNetworkACLList(p *DeleteNetworkACLListParams) (*DeleteNetworkACLListResponse, error)
+	NewListNetworkACLListsParams() *ListNetworkACLListsParams
+	GetNetworkACLListID(name string, opts ...OptionFunc) (string, int, error)
+	GetNetworkACLListByName(name string, opts ...OptionFunc) (*NetworkACLList, int, error)
+	GetNetworkACLListByID(id string, opts ...OptionFunc) (*NetworkACLList, int, error)
+	ListNetworkACLLists(p *ListNetworkACLListsParams) (*ListNetworkACLListsResponse, error)
+	NewListNetworkACLsParams() *ListNetworkACLsParams
+	GetNetworkACLByID(id string, opts ...OptionFunc) (*NetworkACL, int, error)
+	ListNetworkACLs(p *ListNetworkACLsParams) (*ListNetworkACLsResponse, error)
+	NewReplaceNetworkACLListParams(aclid string) *ReplaceNetworkACLListParams
+	ReplaceNetworkACLList(p *ReplaceNetworkACLListParams) (*ReplaceNetworkACLListResponse, error)
+	NewUpdateNetworkACLItemParams(id string) *UpdateNetworkACLItemParams
+	UpdateNetworkACLItem(p *UpdateNetworkACLItemParams) (*UpdateNetworkACLItemResponse, error)
+	NewUpdateNetworkACLListParams(id string) *UpdateNetworkACLListParams
+	UpdateNetworkACLList(p *UpdateNetworkACLListParams) (*UpdateNetworkACLListResponse, error)
+}
+
 type CreateNetworkACLParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CreateNetworkACLParams) Copy() *CreateNetworkACLParams {
+	c := &CreateNetworkACLParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateNetworkACLParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -244,6 +278,15 @@ type CreateNetworkACLListParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CreateNetworkACLListParams) Copy() *CreateNetworkACLListParams {
+	c := &CreateNetworkACLListParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateNetworkACLListParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -352,6 +395,15 @@ type DeleteNetworkACLParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteNetworkACLParams) Copy() *DeleteNetworkACLParams {
+	c := &DeleteNetworkACLParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteNetworkACLParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -420,6 +472,15 @@ type DeleteNetworkACLListParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteNetworkACLListParams) Copy() *DeleteNetworkACLListParams {
+	c := &DeleteNetworkACLListParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteNetworkACLListParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -488,6 +549,15 @@ type ListNetworkACLListsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListNetworkACLListsParams) Copy() *ListNetworkACLListsParams {
+	c := &ListNetworkACLListsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListNetworkACLListsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -756,6 +826,15 @@ type ListNetworkACLsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListNetworkACLsParams) Copy() *ListNetworkACLsParams {
+	c := &ListNetworkACLsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListNetworkACLsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1018,6 +1097,15 @@ type ReplaceNetworkACLListParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ReplaceNetworkACLListParams) Copy() *ReplaceNetworkACLListParams {
+	c := &ReplaceNetworkACLListParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ReplaceNetworkACLListParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1106,6 +1194,15 @@ type UpdateNetworkACLItemParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdateNetworkACLItemParams) Copy() *UpdateNetworkACLItemParams {
+	c := &UpdateNetworkACLItemParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateNetworkACLItemParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1330,6 +1427,15 @@ type UpdateNetworkACLListParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdateNetworkACLListParams) Copy() *UpdateNetworkACLListParams {
+	c := &UpdateNetworkACLListParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateNetworkACLListParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -26,10 +26,28 @@ import (
 	"strconv"
 )
 
+type NetworkDeviceServiceIface interface {
+	NewAddNetworkDeviceParams() *AddNetworkDeviceParams
+	AddNetworkDevice(p *AddNetworkDeviceParams) (*AddNetworkDeviceResponse, error)
+	NewDeleteNetworkDeviceParams(id string) *DeleteNetworkDeviceParams
+	DeleteNetworkDevice(p *DeleteNetworkDeviceParams) (*DeleteNetworkDeviceResponse, error)
+	NewListNetworkDeviceParams() *ListNetworkDeviceParams
+	ListNetworkDevice(p *ListNetworkDeviceParams) (*ListNetworkDeviceResponse, error)
+}
+
 type AddNetworkDeviceParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *AddNetworkDeviceParams) Copy() *AddNetworkDeviceParams {
+	c := &AddNetworkDeviceParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddNetworkDeviceParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -95,6 +113,15 @@ type DeleteNetworkDeviceParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteNetworkDeviceParams) Copy() *DeleteNetworkDeviceParams {
+	c := &DeleteNetworkDeviceParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteNetworkDeviceParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -175,6 +202,15 @@ type ListNetworkDeviceParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListNetworkDeviceParams) Copy() *ListNetworkDeviceParams {
+	c := &ListNetworkDeviceParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListNetworkDeviceParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -27,10 +27,33 @@ import (
 	"strings"
 )
 
+type NetworkOfferingServiceIface interface {
+	NewCreateNetworkOfferingParams(displaytext string, guestiptype string, name string, supportedservices []string, traffictype string) *CreateNetworkOfferingParams
+	CreateNetworkOffering(p *CreateNetworkOfferingParams) (*CreateNetworkOfferingResponse, error)
+	NewDeleteNetworkOfferingParams(id string) *DeleteNetworkOfferingParams
+	DeleteNetworkOffering(p *DeleteNetworkOfferingParams) (*DeleteNetworkOfferingResponse, error)
+	NewListNetworkOfferingsParams() *ListNetworkOfferingsParams
+	GetNetworkOfferingID(name string, opts ...OptionFunc) (string, int, error)
+	GetNetworkOfferingByName(name string, opts ...OptionFunc) (*NetworkOffering, int, error)
+	GetNetworkOfferingByID(id string, opts ...OptionFunc) (*NetworkOffering, int, error)
+	ListNetworkOfferings(p *ListNetworkOfferingsParams) (*ListNetworkOfferingsResponse, error)
+	NewUpdateNetworkOfferingParams() *UpdateNetworkOfferingParams
+	UpdateNetworkOffering(p *UpdateNetworkOfferingParams) (*UpdateNetworkOfferingResponse, error)
+}
+
 type CreateNetworkOfferingParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CreateNetworkOfferingParams) Copy() *CreateNetworkOfferingParams {
+	c := &CreateNetworkOfferingParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateNetworkOfferingParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -83,10 +106,17 @@ func (p *CreateNetworkOfferingParams) toURLValues() url.Values {
 		u.Set("networkrate", vv)
 	}
 	if v, found := p.p["servicecapabilitylist"]; found {
-		m := v.(map[string]string)
-		for i, k := range getSortedKeysFromMap(m) {
-			u.Set(fmt.Sprintf("servicecapabilitylist[%d].key", i), k)
-			u.Set(fmt.Sprintf("servicecapabilitylist[%d].value", i), m[k])
+		l := v.([]ServiceCapability)
+		for i, e := range l {
+			if e.Service != "" {
+				u.Set(fmt.Sprintf("servicecapabilitylist[%d].service", i), e.Service)
+			}
+			if e.CapabilityType != "" {
+				u.Set(fmt.Sprintf("servicecapabilitylist[%d].capabilitytype", i), e.CapabilityType)
+			}
+			if e.CapabilityValue != "" {
+				u.Set(fmt.Sprintf("servicecapabilitylist[%d].capabilityvalue", i), e.CapabilityValue)
+			}
 		}
 	}
 	if v, found := p.p["serviceofferingid"]; found {
@@ -204,7 +234,7 @@ func (p *CreateNetworkOfferingParams) SetNetworkrate(v int) {
 	p.p["networkrate"] = v
 }
 
-func (p *CreateNetworkOfferingParams) SetServicecapabilitylist(v map[string]string) {
+func (p *CreateNetworkOfferingParams) SetServicecapabilitylist(v []ServiceCapability) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
@@ -346,6 +376,15 @@ type DeleteNetworkOfferingParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteNetworkOfferingParams) Copy() *DeleteNetworkOfferingParams {
+	c := &DeleteNetworkOfferingParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteNetworkOfferingParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -426,6 +465,15 @@ type ListNetworkOfferingsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListNetworkOfferingsParams) Copy() *ListNetworkOfferingsParams {
+	c := &ListNetworkOfferingsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListNetworkOfferingsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -808,6 +856,15 @@ type UpdateNetworkOfferingParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdateNetworkOfferingParams) Copy() *UpdateNetworkOfferingParams {
+	c := &UpdateNetworkOfferingParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateNetworkOfferingParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -27,10 +27,90 @@ import (
 	"strings"
 )
 
+type NetworkServiceIface interface {
+	NewAddNetworkServiceProviderParams(name string, physicalnetworkid string) *AddNetworkServiceProviderParams
+	AddNetworkServiceProvider(p *AddNetworkServiceProviderParams) (*AddNetworkServiceProviderResponse, error)
+	NewAddOpenDaylightControllerParams(password string, physicalnetworkid string, url string, username string) *AddOpenDaylightControllerParams
+	AddOpenDaylightController(p *AddOpenDaylightControllerParams) (*AddOpenDaylightControllerResponse, error)
+	NewCreateNetworkParams(displaytext string, name string, networkofferingid string, zoneid string) *CreateNetworkParams
+	CreateNetwork(p *CreateNetworkParams) (*CreateNetworkResponse, error)
+	NewCreatePhysicalNetworkParams(name string, zoneid string) *CreatePhysicalNetworkParams
+	CreatePhysicalNetwork(p *CreatePhysicalNetworkParams) (*CreatePhysicalNetworkResponse, error)
+	NewCreateServiceInstanceParams(leftnetworkid string, name string, rightnetworkid string, serviceofferingid string, templateid string, zoneid string) *CreateServiceInstanceParams
+	CreateServiceInstance(p *CreateServiceInstanceParams) (*CreateServiceInstanceResponse, error)
+	NewCreateStorageNetworkIpRangeParams(gateway string, netmask string, podid string, startip string) *CreateStorageNetworkIpRangeParams
+	CreateStorageNetworkIpRange(p *CreateStorageNetworkIpRangeParams) (*CreateStorageNetworkIpRangeResponse, error)
+	NewDedicatePublicIpRangeParams(domainid string, id string) *DedicatePublicIpRangeParams
+	DedicatePublicIpRange(p *DedicatePublicIpRangeParams) (*DedicatePublicIpRangeResponse, error)
+	NewDeleteNetworkParams(id string) *DeleteNetworkParams
+	DeleteNetwork(p *DeleteNetworkParams) (*DeleteNetworkResponse, error)
+	NewDeleteNetworkServiceProviderParams(id string) *DeleteNetworkServiceProviderParams
+	DeleteNetworkServiceProvider(p *DeleteNetworkServiceProviderParams) (*DeleteNetworkServiceProviderResponse, error)
+	NewDeleteOpenDaylightControllerParams(id string) *DeleteOpenDaylightControllerParams
+	DeleteOpenDaylightController(p *DeleteOpenDaylightControllerParams) (*DeleteOpenDaylightControllerResponse, error)
+	NewDeletePhysicalNetworkParams(id string) *DeletePhysicalNetworkParams
+	DeletePhysicalNetwork(p *DeletePhysicalNetworkParams) (*DeletePhysicalNetworkResponse, error)
+	NewDeleteStorageNetworkIpRangeParams(id string) *DeleteStorageNetworkIpRangeParams
+	DeleteStorageNetworkIpRange(p *DeleteStorageNetworkIpRangeParams) (*DeleteStorageNetworkIpRangeResponse, error)
+	NewListNetscalerLoadBalancerNetworksParams(lbdeviceid string) *ListNetscalerLoadBalancerNetworksParams
+	GetNetscalerLoadBalancerNetworkID(keyword string, lbdeviceid string, opts ...OptionFunc) (string, int, error)
+	ListNetscalerLoadBalancerNetworks(p *ListNetscalerLoadBalancerNetworksParams) (*ListNetscalerLoadBalancerNetworksResponse, error)
+	NewListNetworkIsolationMethodsParams() *ListNetworkIsolationMethodsParams
+	ListNetworkIsolationMethods(p *ListNetworkIsolationMethodsParams) (*ListNetworkIsolationMethodsResponse, error)
+	NewListNetworkServiceProvidersParams() *ListNetworkServiceProvidersParams
+	GetNetworkServiceProviderID(name string, opts ...OptionFunc) (string, int, error)
+	ListNetworkServiceProviders(p *ListNetworkServiceProvidersParams) (*ListNetworkServiceProvidersResponse, error)
+	NewListNetworksParams() *ListNetworksParams
+	GetNetworkID(keyword string, opts ...OptionFunc) (string, int, error)
+	GetNetworkByName(name string, opts ...OptionFunc) (*Network, int, error)
+	GetNetworkByID(id string, opts ...OptionFunc) (*Network, int, error)
+	ListNetworks(p *ListNetworksParams) (*ListNetworksResponse, error)
+	NewListNiciraNvpDeviceNetworksParams(nvpdeviceid string) *ListNiciraNvpDeviceNetworksParams
+	GetNiciraNvpDeviceNetworkID(keyword string, nvpdeviceid string, opts ...OptionFunc) (string, int, error)
+	ListNiciraNvpDeviceNetworks(p *ListNiciraNvpDeviceNetworksParams) (*ListNiciraNvpDeviceNetworksResponse, error)
+	NewListOpenDaylightControllersParams() *ListOpenDaylightControllersParams
+	GetOpenDaylightControllerByID(id string, opts ...OptionFunc) (*OpenDaylightController, int, error)
+	ListOpenDaylightControllers(p *ListOpenDaylightControllersParams) (*ListOpenDaylightControllersResponse, error)
+	NewListPaloAltoFirewallNetworksParams(lbdeviceid string) *ListPaloAltoFirewallNetworksParams
+	GetPaloAltoFirewallNetworkID(keyword string, lbdeviceid string, opts ...OptionFunc) (string, int, error)
+	ListPaloAltoFirewallNetworks(p *ListPaloAltoFirewallNetworksParams) (*ListPaloAltoFirewallNetworksResponse, error)
+	NewListPhysicalNetworksParams() *ListPhysicalNetworksParams
+	GetPhysicalNetworkID(name string, opts ...OptionFunc) (string, int, error)
+	GetPhysicalNetworkByName(name string, opts ...OptionFunc) (*PhysicalNetwork, int, error)
+	GetPhysicalNetworkByID(id string, opts ...OptionFunc) (*PhysicalNetwork, int, error)
+	ListPhysicalNetworks(p *ListPhysicalNetworksParams) (*ListPhysicalNetworksResponse, error)
+	NewListStorageNetworkIpRangeParams() *ListStorageNetworkIpRangeParams
+	GetStorageNetworkIpRangeByID(id string, opts ...OptionFunc) (*StorageNetworkIpRange, int, error)
+	ListStorageNetworkIpRange(p *ListStorageNetworkIpRangeParams) (*ListStorageNetworkIpRangeResponse, error)
+	NewListSupportedNetworkServicesParams() *ListSupportedNetworkServicesParams
+	ListSupportedNetworkServices(p *ListSupportedNetworkServicesParams) (*ListSupportedNetworkServicesResponse, error)
+	NewReleasePublicIpRangeParams(id string) *ReleasePublicIpRangeParams
+	ReleasePublicIpRange(p *ReleasePublicIpRangeParams) (*ReleasePublicIpRangeResponse, error)
+	NewRestartNetworkParams(id string) *RestartNetworkParams
+	RestartNetwork(p *RestartNetworkParams) (*RestartNetworkResponse, error)
+	NewUpdateNetworkParams(id string) *UpdateNetworkParams
+	UpdateNetwork(p *UpdateNetworkParams) (*UpdateNetworkResponse, error)
+	NewUpdateNetworkServiceProviderParams(id string) *UpdateNetworkServiceProviderParams
+	UpdateNetworkServiceProvider(p *UpdateNetworkServiceProviderParams) (*UpdateNetworkServiceProviderResponse, error)
+	NewUpdatePhysicalNetworkParams(id string) *UpdatePhysicalNetworkParams
+	UpdatePhysicalNetwork(p *UpdatePhysicalNetworkParams) (*UpdatePhysicalNetworkResponse, error)
+	NewUpdateStorageNetworkIpRangeParams(id string) *UpdateStorageNetworkIpRangeParams
+	UpdateStorageNetworkIpRange(p *UpdateStorageNetworkIpRangeParams) (*UpdateStorageNetworkIpRangeResponse, error)
+}
+
 type AddNetworkServiceProviderParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *AddNetworkServiceProviderParams) Copy() *AddNetworkServiceProviderParams {
+	c := &AddNetworkServiceProviderParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddNetworkServiceProviderParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -141,6 +221,15 @@ type AddOpenDaylightControllerParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *AddOpenDaylightControllerParams) Copy() *AddOpenDaylightControllerParams {
+	c := &AddOpenDaylightControllerParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddOpenDaylightControllerParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -250,6 +339,15 @@ type CreateNetworkParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CreateNetworkParams) Copy() *CreateNetworkParams {
+	c := &CreateNetworkParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateNetworkParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -633,6 +731,15 @@ type CreatePhysicalNetworkParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CreatePhysicalNetworkParams) Copy() *CreatePhysicalNetworkParams {
+	c := &CreatePhysicalNetworkParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreatePhysicalNetworkParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -787,6 +894,15 @@ type CreateServiceInstanceParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CreateServiceInstanceParams) Copy() *CreateServiceInstanceParams {
+	c := &CreateServiceInstanceParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateServiceInstanceParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -951,6 +1067,15 @@ type CreateStorageNetworkIpRangeParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CreateStorageNetworkIpRangeParams) Copy() *CreateStorageNetworkIpRangeParams {
+	c := &CreateStorageNetworkIpRangeParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateStorageNetworkIpRangeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1085,6 +1210,15 @@ type DedicatePublicIpRangeParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DedicatePublicIpRangeParams) Copy() *DedicatePublicIpRangeParams {
+	c := &DedicatePublicIpRangeParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DedicatePublicIpRangeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1190,6 +1324,15 @@ type DeleteNetworkParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteNetworkParams) Copy() *DeleteNetworkParams {
+	c := &DeleteNetworkParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteNetworkParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1269,6 +1412,15 @@ type DeleteNetworkServiceProviderParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteNetworkServiceProviderParams) Copy() *DeleteNetworkServiceProviderParams {
+	c := &DeleteNetworkServiceProviderParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteNetworkServiceProviderParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1337,6 +1489,15 @@ type DeleteOpenDaylightControllerParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteOpenDaylightControllerParams) Copy() *DeleteOpenDaylightControllerParams {
+	c := &DeleteOpenDaylightControllerParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteOpenDaylightControllerParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1413,6 +1574,15 @@ type DeletePhysicalNetworkParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeletePhysicalNetworkParams) Copy() *DeletePhysicalNetworkParams {
+	c := &DeletePhysicalNetworkParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeletePhysicalNetworkParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1481,6 +1651,15 @@ type DeleteStorageNetworkIpRangeParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteStorageNetworkIpRangeParams) Copy() *DeleteStorageNetworkIpRangeParams {
+	c := &DeleteStorageNetworkIpRangeParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteStorageNetworkIpRangeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1549,6 +1728,15 @@ type ListNetscalerLoadBalancerNetworksParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListNetscalerLoadBalancerNetworksParams) Copy() *ListNetscalerLoadBalancerNetworksParams {
+	c := &ListNetscalerLoadBalancerNetworksParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListNetscalerLoadBalancerNetworksParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1746,6 +1934,15 @@ type ListNetworkIsolationMethodsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListNetworkIsolationMethodsParams) Copy() *ListNetworkIsolationMethodsParams {
+	c := &ListNetworkIsolationMethodsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListNetworkIsolationMethodsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1824,6 +2021,15 @@ type ListNetworkServiceProvidersParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListNetworkServiceProvidersParams) Copy() *ListNetworkServiceProvidersParams {
+	c := &ListNetworkServiceProvidersParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListNetworkServiceProvidersParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1974,6 +2180,15 @@ type ListNetworksParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListNetworksParams) Copy() *ListNetworksParams {
+	c := &ListNetworksParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListNetworksParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2419,6 +2634,15 @@ type ListNiciraNvpDeviceNetworksParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListNiciraNvpDeviceNetworksParams) Copy() *ListNiciraNvpDeviceNetworksParams {
+	c := &ListNiciraNvpDeviceNetworksParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListNiciraNvpDeviceNetworksParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2616,6 +2840,15 @@ type ListOpenDaylightControllersParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListOpenDaylightControllersParams) Copy() *ListOpenDaylightControllersParams {
+	c := &ListOpenDaylightControllersParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListOpenDaylightControllersParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2719,6 +2952,15 @@ type ListPaloAltoFirewallNetworksParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListPaloAltoFirewallNetworksParams) Copy() *ListPaloAltoFirewallNetworksParams {
+	c := &ListPaloAltoFirewallNetworksParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListPaloAltoFirewallNetworksParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2916,6 +3158,15 @@ type ListPhysicalNetworksParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListPhysicalNetworksParams) Copy() *ListPhysicalNetworksParams {
+	c := &ListPhysicalNetworksParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListPhysicalNetworksParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -3116,6 +3367,15 @@ type ListStorageNetworkIpRangeParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListStorageNetworkIpRangeParams) Copy() *ListStorageNetworkIpRangeParams {
+	c := &ListStorageNetworkIpRangeParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListStorageNetworkIpRangeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -3265,6 +3525,15 @@ type ListSupportedNetworkServicesParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListSupportedNetworkServicesParams) Copy() *ListSupportedNetworkServicesParams {
+	c := &ListSupportedNetworkServicesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListSupportedNetworkServicesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -3381,6 +3650,15 @@ type ReleasePublicIpRangeParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ReleasePublicIpRangeParams) Copy() *ReleasePublicIpRangeParams {
+	c := &ReleasePublicIpRangeParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ReleasePublicIpRangeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -3461,6 +3739,15 @@ type RestartNetworkParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *RestartNetworkParams) Copy() *RestartNetworkParams {
+	c := &RestartNetworkParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *RestartNetworkParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -3584,6 +3871,15 @@ type UpdateNetworkParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdateNetworkParams) Copy() *UpdateNetworkParams {
+	c := &UpdateNetworkParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateNetworkParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -3831,6 +4127,15 @@ type UpdateNetworkServiceProviderParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdateNetworkServiceProviderParams) Copy() *UpdateNetworkServiceProviderParams {
+	c := &UpdateNetworkServiceProviderParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateNetworkServiceProviderParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -3930,6 +4235,15 @@ type UpdatePhysicalNetworkParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdatePhysicalNetworkParams) Copy() *UpdatePhysicalNetworkParams {
+	c := &UpdatePhysicalNetworkParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdatePhysicalNetworkParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -4052,6 +4366,15 @@ type UpdateStorageNetworkIpRangeParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdateStorageNetworkIpRangeParams) Copy() *UpdateStorageNetworkIpRangeParams {
+	c := &UpdateStorageNetworkIpRangeParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateStorageNetworkIpRangeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -25,10 +25,30 @@ import (
 	"strconv"
 )
 
+type NicServiceIface interface {
+	NewAddIpToNicParams(nicid string) *AddIpToNicParams
+	AddIpToNic(p *AddIpToNicParams) (*AddIpToNicResponse, error)
+	NewListNicsParams(virtualmachineid string) *ListNicsParams
+	ListNics(p *ListNicsParams) (*ListNicsResponse, error)
+	NewRemoveIpFromNicParams(id string) *RemoveIpFromNicParams
+	RemoveIpFromNic(p *RemoveIpFromNicParams) (*RemoveIpFromNicResponse, error)
+	NewUpdateVmNicIpParams(nicid string) *UpdateVmNicIpParams
+	UpdateVmNicIp(p *UpdateVmNicIpParams) (*UpdateVmNicIpResponse, error)
+}
+
 type AddIpToNicParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *AddIpToNicParams) Copy() *AddIpToNicParams {
+	c := &AddIpToNicParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddIpToNicParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -119,6 +139,15 @@ type ListNicsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListNicsParams) Copy() *ListNicsParams {
+	c := &ListNicsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListNicsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -262,6 +291,15 @@ type RemoveIpFromNicParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *RemoveIpFromNicParams) Copy() *RemoveIpFromNicParams {
+	c := &RemoveIpFromNicParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *RemoveIpFromNicParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -330,6 +368,15 @@ type UpdateVmNicIpParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdateVmNicIpParams) Copy() *UpdateVmNicIpParams {
+	c := &UpdateVmNicIpParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateVmNicIpParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
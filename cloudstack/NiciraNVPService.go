@@ -25,10 +25,28 @@ import (
 	"strconv"
 )
 
+type NiciraNVPServiceIface interface {
+	NewAddNiciraNvpDeviceParams(hostname string, password string, physicalnetworkid string, transportzoneuuid string, username string) *AddNiciraNvpDeviceParams
+	AddNiciraNvpDevice(p *AddNiciraNvpDeviceParams) (*AddNiciraNvpDeviceResponse, error)
+	NewDeleteNiciraNvpDeviceParams(nvpdeviceid string) *DeleteNiciraNvpDeviceParams
+	DeleteNiciraNvpDevice(p *DeleteNiciraNvpDeviceParams) (*DeleteNiciraNvpDeviceResponse, error)
+	NewListNiciraNvpDevicesParams() *ListNiciraNvpDevicesParams
+	ListNiciraNvpDevices(p *ListNiciraNvpDevicesParams) (*ListNiciraNvpDevicesResponse, error)
+}
+
 type AddNiciraNvpDeviceParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *AddNiciraNvpDeviceParams) Copy() *AddNiciraNvpDeviceParams {
+	c := &AddNiciraNvpDeviceParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddNiciraNvpDeviceParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -172,6 +190,15 @@ type DeleteNiciraNvpDeviceParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteNiciraNvpDeviceParams) Copy() *DeleteNiciraNvpDeviceParams {
+	c := &DeleteNiciraNvpDeviceParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteNiciraNvpDeviceParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -199,7 +226,7 @@ func (s *NiciraNVPService) NewDeleteNiciraNvpDeviceParams(nvpdeviceid string) *D
 	return p
 }
 
-//  delete a nicira nvp device
+// delete a nicira nvp device
 func (s *NiciraNVPService) DeleteNiciraNvpDevice(p *DeleteNiciraNvpDeviceParams) (*DeleteNiciraNvpDeviceResponse, error) {
 	resp, err := s.cs.newRequest("deleteNiciraNvpDevice", p.toURLValues())
 	if err != nil {
@@ -240,6 +267,15 @@ type ListNiciraNvpDevicesParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListNiciraNvpDevicesParams) Copy() *ListNiciraNvpDevicesParams {
+	c := &ListNiciraNvpDevicesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListNiciraNvpDevicesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -25,10 +25,30 @@ import (
 	"strconv"
 )
 
+type NuageVSPServiceIface interface {
+	NewAddNuageVspDeviceParams(hostname string, password string, physicalnetworkid string, port int, username string) *AddNuageVspDeviceParams
+	AddNuageVspDevice(p *AddNuageVspDeviceParams) (*AddNuageVspDeviceResponse, error)
+	NewDeleteNuageVspDeviceParams(vspdeviceid string) *DeleteNuageVspDeviceParams
+	DeleteNuageVspDevice(p *DeleteNuageVspDeviceParams) (*DeleteNuageVspDeviceResponse, error)
+	NewListNuageVspDevicesParams() *ListNuageVspDevicesParams
+	ListNuageVspDevices(p *ListNuageVspDevicesParams) (*ListNuageVspDevicesResponse, error)
+	NewUpdateNuageVspDeviceParams(physicalnetworkid string) *UpdateNuageVspDeviceParams
+	UpdateNuageVspDevice(p *UpdateNuageVspDeviceParams) (*UpdateNuageVspDeviceResponse, error)
+}
+
 type AddNuageVspDeviceParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *AddNuageVspDeviceParams) Copy() *AddNuageVspDeviceParams {
+	c := &AddNuageVspDeviceParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddNuageVspDeviceParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -187,6 +207,15 @@ type DeleteNuageVspDeviceParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteNuageVspDeviceParams) Copy() *DeleteNuageVspDeviceParams {
+	c := &DeleteNuageVspDeviceParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteNuageVspDeviceParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -255,6 +284,15 @@ type ListNuageVspDevicesParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListNuageVspDevicesParams) Copy() *ListNuageVspDevicesParams {
+	c := &ListNuageVspDevicesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListNuageVspDevicesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -362,6 +400,15 @@ type UpdateNuageVspDeviceParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdateNuageVspDeviceParams) Copy() *UpdateNuageVspDeviceParams {
+	c := &UpdateNuageVspDeviceParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateNuageVspDeviceParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
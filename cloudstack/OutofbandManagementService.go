@@ -25,10 +25,28 @@ import (
 	"strconv"
 )
 
+type OutofbandManagementServiceIface interface {
+	NewChangeOutOfBandManagementPasswordParams(hostid string) *ChangeOutOfBandManagementPasswordParams
+	ChangeOutOfBandManagementPassword(p *ChangeOutOfBandManagementPasswordParams) (*ChangeOutOfBandManagementPasswordResponse, error)
+	NewConfigureOutOfBandManagementParams(address string, driver string, hostid string, password string, port string, username string) *ConfigureOutOfBandManagementParams
+	ConfigureOutOfBandManagement(p *ConfigureOutOfBandManagementParams) (*OutOfBandManagementResponse, error)
+	NewIssueOutOfBandManagementPowerActionParams(action string, hostid string) *IssueOutOfBandManagementPowerActionParams
+	IssueOutOfBandManagementPowerAction(p *IssueOutOfBandManagementPowerActionParams) (*IssueOutOfBandManagementPowerActionResponse, error)
+}
+
 type ChangeOutOfBandManagementPasswordParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ChangeOutOfBandManagementPasswordParams) Copy() *ChangeOutOfBandManagementPasswordParams {
+	c := &ChangeOutOfBandManagementPasswordParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ChangeOutOfBandManagementPasswordParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -121,6 +139,15 @@ type ConfigureOutOfBandManagementParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ConfigureOutOfBandManagementParams) Copy() *ConfigureOutOfBandManagementParams {
+	c := &ConfigureOutOfBandManagementParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ConfigureOutOfBandManagementParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -238,6 +265,15 @@ type IssueOutOfBandManagementPowerActionParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *IssueOutOfBandManagementPowerActionParams) Copy() *IssueOutOfBandManagementPowerActionParams {
+	c := &IssueOutOfBandManagementPowerActionParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *IssueOutOfBandManagementPowerActionParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
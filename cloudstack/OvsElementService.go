@@ -27,10 +27,27 @@ import (
 	"strings"
 )
 
+type OvsElementServiceIface interface {
+	NewConfigureOvsElementParams(enabled bool, id string) *ConfigureOvsElementParams
+	ConfigureOvsElement(p *ConfigureOvsElementParams) (*OvsElementResponse, error)
+	NewListOvsElementsParams() *ListOvsElementsParams
+	GetOvsElementByID(id string, opts ...OptionFunc) (*OvsElement, int, error)
+	ListOvsElements(p *ListOvsElementsParams) (*ListOvsElementsResponse, error)
+}
+
 type ConfigureOvsElementParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ConfigureOvsElementParams) Copy() *ConfigureOvsElementParams {
+	c := &ConfigureOvsElementParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ConfigureOvsElementParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -122,6 +139,15 @@ type ListOvsElementsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListOvsElementsParams) Copy() *ListOvsElementsParams {
+	c := &ListOvsElementsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListOvsElementsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -27,10 +27,39 @@ import (
 	"strings"
 )
 
+type PodServiceIface interface {
+	NewCreatePodParams(gateway string, name string, netmask string, startip string, zoneid string) *CreatePodParams
+	CreatePod(p *CreatePodParams) (*CreatePodResponse, error)
+	NewDedicatePodParams(domainid string, podid string) *DedicatePodParams
+	DedicatePod(p *DedicatePodParams) (*DedicatePodResponse, error)
+	NewDeletePodParams(id string) *DeletePodParams
+	DeletePod(p *DeletePodParams) (*DeletePodResponse, error)
+	NewListDedicatedPodsParams() *ListDedicatedPodsParams
+	ListDedicatedPods(p *ListDedicatedPodsParams) (*ListDedicatedPodsResponse, error)
+	NewListPodsParams() *ListPodsParams
+	GetPodID(name string, opts ...OptionFunc) (string, int, error)
+	GetPodByName(name string, opts ...OptionFunc) (*Pod, int, error)
+	GetPodByID(id string, opts ...OptionFunc) (*Pod, int, error)
+	ListPods(p *ListPodsParams) (*ListPodsResponse, error)
+	NewReleaseDedicatedPodParams(podid string) *ReleaseDedicatedPodParams
+	ReleaseDedicatedPod(p *ReleaseDedicatedPodParams) (*ReleaseDedicatedPodResponse, error)
+	NewUpdatePodParams(id string) *UpdatePodParams
+	UpdatePod(p *UpdatePodParams) (*UpdatePodResponse, error)
+}
+
 type CreatePodParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CreatePodParams) Copy() *CreatePodParams {
+	c := &CreatePodParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreatePodParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -173,6 +202,15 @@ type DedicatePodParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DedicatePodParams) Copy() *DedicatePodParams {
+	c := &DedicatePodParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DedicatePodParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -271,6 +309,15 @@ type DeletePodParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeletePodParams) Copy() *DeletePodParams {
+	c := &DeletePodParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeletePodParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -351,6 +398,15 @@ type ListDedicatedPodsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListDedicatedPodsParams) Copy() *ListDedicatedPodsParams {
+	c := &ListDedicatedPodsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListDedicatedPodsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -474,6 +530,15 @@ type ListPodsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListPodsParams) Copy() *ListPodsParams {
+	c := &ListPodsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListPodsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -712,6 +777,15 @@ type ReleaseDedicatedPodParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ReleaseDedicatedPodParams) Copy() *ReleaseDedicatedPodParams {
+	c := &ReleaseDedicatedPodParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ReleaseDedicatedPodParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -780,6 +854,15 @@ type UpdatePodParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdatePodParams) Copy() *UpdatePodParams {
+	c := &UpdatePodParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdatePodParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -27,10 +27,35 @@ import (
 	"strings"
 )
 
+type PoolServiceIface interface {
+	NewCreateStoragePoolParams(name string, url string, zoneid string) *CreateStoragePoolParams
+	CreateStoragePool(p *CreateStoragePoolParams) (*CreateStoragePoolResponse, error)
+	NewDeleteStoragePoolParams(id string) *DeleteStoragePoolParams
+	DeleteStoragePool(p *DeleteStoragePoolParams) (*DeleteStoragePoolResponse, error)
+	NewFindStoragePoolsForMigrationParams(id string) *FindStoragePoolsForMigrationParams
+	FindStoragePoolsForMigration(p *FindStoragePoolsForMigrationParams) (*FindStoragePoolsForMigrationResponse, error)
+	NewListStoragePoolsParams() *ListStoragePoolsParams
+	GetStoragePoolID(name string, opts ...OptionFunc) (string, int, error)
+	GetStoragePoolByName(name string, opts ...OptionFunc) (*StoragePool, int, error)
+	GetStoragePoolByID(id string, opts ...OptionFunc) (*StoragePool, int, error)
+	ListStoragePools(p *ListStoragePoolsParams) (*ListStoragePoolsResponse, error)
+	NewUpdateStoragePoolParams(id string) *UpdateStoragePoolParams
+	UpdateStoragePool(p *UpdateStoragePoolParams) (*UpdateStoragePoolResponse, error)
+}
+
 type CreateStoragePoolParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CreateStoragePoolParams) Copy() *CreateStoragePoolParams {
+	c := &CreateStoragePoolParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateStoragePoolParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -235,6 +260,15 @@ type DeleteStoragePoolParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteStoragePoolParams) Copy() *DeleteStoragePoolParams {
+	c := &DeleteStoragePoolParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteStoragePoolParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -326,6 +360,15 @@ type FindStoragePoolsForMigrationParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *FindStoragePoolsForMigrationParams) Copy() *FindStoragePoolsForMigrationParams {
+	c := &FindStoragePoolsForMigrationParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *FindStoragePoolsForMigrationParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -434,6 +477,15 @@ type ListStoragePoolsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListStoragePoolsParams) Copy() *ListStoragePoolsParams {
+	c := &ListStoragePoolsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListStoragePoolsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -699,6 +751,15 @@ type UpdateStoragePoolParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdateStoragePoolParams) Copy() *UpdateStoragePoolParams {
+	c := &UpdateStoragePoolParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateStoragePoolParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -27,10 +27,29 @@ import (
 	"strings"
 )
 
+type PortableIPServiceIface interface {
+	NewCreatePortableIpRangeParams(endip string, gateway string, netmask string, regionid int, startip string) *CreatePortableIpRangeParams
+	CreatePortableIpRange(p *CreatePortableIpRangeParams) (*CreatePortableIpRangeResponse, error)
+	NewDeletePortableIpRangeParams(id string) *DeletePortableIpRangeParams
+	DeletePortableIpRange(p *DeletePortableIpRangeParams) (*DeletePortableIpRangeResponse, error)
+	NewListPortableIpRangesParams() *ListPortableIpRangesParams
+	GetPortableIpRangeByID(id string, opts ...OptionFunc) (*PortableIpRange, int, error)
+	ListPortableIpRanges(p *ListPortableIpRangesParams) (*ListPortableIpRangesResponse, error)
+}
+
 type CreatePortableIpRangeParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CreatePortableIpRangeParams) Copy() *CreatePortableIpRangeParams {
+	c := &CreatePortableIpRangeParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreatePortableIpRangeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -178,6 +197,15 @@ type DeletePortableIpRangeParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeletePortableIpRangeParams) Copy() *DeletePortableIpRangeParams {
+	c := &DeletePortableIpRangeParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeletePortableIpRangeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -246,6 +274,15 @@ type ListPortableIpRangesParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListPortableIpRangesParams) Copy() *ListPortableIpRangesParams {
+	c := &ListPortableIpRangesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListPortableIpRangesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -27,10 +27,44 @@ import (
 	"strings"
 )
 
+type ProjectServiceIface interface {
+	NewActivateProjectParams(id string) *ActivateProjectParams
+	ActivateProject(p *ActivateProjectParams) (*ActivateProjectResponse, error)
+	NewCreateProjectParams(displaytext string, name string) *CreateProjectParams
+	CreateProject(p *CreateProjectParams) (*CreateProjectResponse, error)
+	NewDeleteProjectParams(id string) *DeleteProjectParams
+	DeleteProject(p *DeleteProjectParams) (*DeleteProjectResponse, error)
+	NewDeleteProjectInvitationParams(id string) *DeleteProjectInvitationParams
+	DeleteProjectInvitation(p *DeleteProjectInvitationParams) (*DeleteProjectInvitationResponse, error)
+	NewListProjectInvitationsParams() *ListProjectInvitationsParams
+	GetProjectInvitationByID(id string, opts ...OptionFunc) (*ProjectInvitation, int, error)
+	ListProjectInvitations(p *ListProjectInvitationsParams) (*ListProjectInvitationsResponse, error)
+	NewListProjectsParams() *ListProjectsParams
+	GetProjectID(name string, opts ...OptionFunc) (string, int, error)
+	GetProjectByName(name string, opts ...OptionFunc) (*Project, int, error)
+	GetProjectByID(id string, opts ...OptionFunc) (*Project, int, error)
+	ListProjects(p *ListProjectsParams) (*ListProjectsResponse, error)
+	NewSuspendProjectParams(id string) *SuspendProjectParams
+	SuspendProject(p *SuspendProjectParams) (*SuspendProjectResponse, error)
+	NewUpdateProjectParams(id string) *UpdateProjectParams
+	UpdateProject(p *UpdateProjectParams) (*UpdateProjectResponse, error)
+	NewUpdateProjectInvitationParams(projectid string) *UpdateProjectInvitationParams
+	UpdateProjectInvitation(p *UpdateProjectInvitationParams) (*UpdateProjectInvitationResponse, error)
+}
+
 type ActivateProjectParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ActivateProjectParams) Copy() *ActivateProjectParams {
+	c := &ActivateProjectParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ActivateProjectParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -146,6 +180,15 @@ type CreateProjectParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CreateProjectParams) Copy() *CreateProjectParams {
+	c := &CreateProjectParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateProjectParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -292,6 +335,15 @@ type DeleteProjectParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteProjectParams) Copy() *DeleteProjectParams {
+	c := &DeleteProjectParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteProjectParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -360,6 +412,15 @@ type DeleteProjectInvitationParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteProjectInvitationParams) Copy() *DeleteProjectInvitationParams {
+	c := &DeleteProjectInvitationParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteProjectInvitationParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -428,6 +489,15 @@ type ListProjectInvitationsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListProjectInvitationsParams) Copy() *ListProjectInvitationsParams {
+	c := &ListProjectInvitationsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListProjectInvitationsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -629,6 +699,15 @@ type ListProjectsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListProjectsParams) Copy() *ListProjectsParams {
+	c := &ListProjectsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListProjectsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -929,6 +1008,15 @@ type SuspendProjectParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *SuspendProjectParams) Copy() *SuspendProjectParams {
+	c := &SuspendProjectParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *SuspendProjectParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1044,6 +1132,15 @@ type UpdateProjectParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdateProjectParams) Copy() *UpdateProjectParams {
+	c := &UpdateProjectParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateProjectParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1179,6 +1276,15 @@ type UpdateProjectInvitationParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdateProjectInvitationParams) Copy() *UpdateProjectInvitationParams {
+	c := &UpdateProjectInvitationParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateProjectInvitationParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
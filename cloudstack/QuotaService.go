@@ -24,10 +24,24 @@ import (
 	"net/url"
 )
 
+type QuotaServiceIface interface {
+	NewQuotaIsEnabledParams() *QuotaIsEnabledParams
+	QuotaIsEnabled(p *QuotaIsEnabledParams) (*QuotaIsEnabledResponse, error)
+}
+
 type QuotaIsEnabledParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *QuotaIsEnabledParams) Copy() *QuotaIsEnabledParams {
+	c := &QuotaIsEnabledParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *QuotaIsEnabledParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -25,10 +25,30 @@ import (
 	"strconv"
 )
 
+type RegionServiceIface interface {
+	NewAddRegionParams(endpoint string, id int, name string) *AddRegionParams
+	AddRegion(p *AddRegionParams) (*AddRegionResponse, error)
+	NewListRegionsParams() *ListRegionsParams
+	ListRegions(p *ListRegionsParams) (*ListRegionsResponse, error)
+	NewRemoveRegionParams(id int) *RemoveRegionParams
+	RemoveRegion(p *RemoveRegionParams) (*RemoveRegionResponse, error)
+	NewUpdateRegionParams(id int) *UpdateRegionParams
+	UpdateRegion(p *UpdateRegionParams) (*UpdateRegionResponse, error)
+}
+
 type AddRegionParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *AddRegionParams) Copy() *AddRegionParams {
+	c := &AddRegionParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddRegionParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -108,6 +128,15 @@ type ListRegionsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListRegionsParams) Copy() *ListRegionsParams {
+	c := &ListRegionsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListRegionsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -211,6 +240,15 @@ type RemoveRegionParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *RemoveRegionParams) Copy() *RemoveRegionParams {
+	c := &RemoveRegionParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *RemoveRegionParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -292,6 +330,15 @@ type UpdateRegionParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdateRegionParams) Copy() *UpdateRegionParams {
+	c := &UpdateRegionParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateRegionParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
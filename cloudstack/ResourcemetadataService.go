@@ -26,10 +26,30 @@ import (
 	"strconv"
 )
 
+type ResourcemetadataServiceIface interface {
+	NewAddResourceDetailParams(details map[string]string, resourceid string, resourcetype string) *AddResourceDetailParams
+	AddResourceDetail(p *AddResourceDetailParams) (*AddResourceDetailResponse, error)
+	NewGetVolumeSnapshotDetailsParams(snapshotid string) *GetVolumeSnapshotDetailsParams
+	GetVolumeSnapshotDetails(p *GetVolumeSnapshotDetailsParams) (*GetVolumeSnapshotDetailsResponse, error)
+	NewListResourceDetailsParams(resourcetype string) *ListResourceDetailsParams
+	ListResourceDetails(p *ListResourceDetailsParams) (*ListResourceDetailsResponse, error)
+	NewRemoveResourceDetailParams(resourceid string, resourcetype string) *RemoveResourceDetailParams
+	RemoveResourceDetail(p *RemoveResourceDetailParams) (*RemoveResourceDetailResponse, error)
+}
+
 type AddResourceDetailParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *AddResourceDetailParams) Copy() *AddResourceDetailParams {
+	c := &AddResourceDetailParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddResourceDetailParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -135,6 +155,15 @@ type GetVolumeSnapshotDetailsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *GetVolumeSnapshotDetailsParams) Copy() *GetVolumeSnapshotDetailsParams {
+	c := &GetVolumeSnapshotDetailsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *GetVolumeSnapshotDetailsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -187,6 +216,15 @@ type ListResourceDetailsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListResourceDetailsParams) Copy() *ListResourceDetailsParams {
+	c := &ListResourceDetailsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListResourceDetailsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -378,6 +416,15 @@ type RemoveResourceDetailParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *RemoveResourceDetailParams) Copy() *RemoveResourceDetailParams {
+	c := &RemoveResourceDetailParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *RemoveResourceDetailParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
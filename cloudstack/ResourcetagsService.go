@@ -27,10 +27,31 @@ import (
 	"strings"
 )
 
+type ResourcetagsServiceIface interface {
+	NewCreateTagsParams(resourceids []string, resourcetype string, tags map[string]string) *CreateTagsParams
+	CreateTags(p *CreateTagsParams) (*CreateTagsResponse, error)
+	NewDeleteTagsParams(resourceids []string, resourcetype string) *DeleteTagsParams
+	DeleteTags(p *DeleteTagsParams) (*DeleteTagsResponse, error)
+	NewListStorageTagsParams() *ListStorageTagsParams
+	GetStorageTagID(keyword string, opts ...OptionFunc) (string, int, error)
+	ListStorageTags(p *ListStorageTagsParams) (*ListStorageTagsResponse, error)
+	NewListTagsParams() *ListTagsParams
+	ListTags(p *ListTagsParams) (*ListTagsResponse, error)
+}
+
 type CreateTagsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CreateTagsParams) Copy() *CreateTagsParams {
+	c := &CreateTagsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateTagsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -136,6 +157,15 @@ type DeleteTagsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteTagsParams) Copy() *DeleteTagsParams {
+	c := &DeleteTagsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteTagsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -230,6 +260,15 @@ type ListStorageTagsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListStorageTagsParams) Copy() *ListStorageTagsParams {
+	c := &ListStorageTagsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListStorageTagsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -346,6 +385,15 @@ type ListTagsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListTagsParams) Copy() *ListTagsParams {
+	c := &ListTagsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListTagsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -27,10 +27,41 @@ import (
 	"strings"
 )
 
+type RoleServiceIface interface {
+	NewCreateRoleParams(name string, roleType string) *CreateRoleParams
+	CreateRole(p *CreateRoleParams) (*CreateRoleResponse, error)
+	NewCreateRolePermissionParams(permission string, roleid string, rule string) *CreateRolePermissionParams
+	CreateRolePermission(p *CreateRolePermissionParams) (*CreateRolePermissionResponse, error)
+	NewDeleteRoleParams(id string) *DeleteRoleParams
+	DeleteRole(p *DeleteRoleParams) (*DeleteRoleResponse, error)
+	NewDeleteRolePermissionParams(id string) *DeleteRolePermissionParams
+	DeleteRolePermission(p *DeleteRolePermissionParams) (*DeleteRolePermissionResponse, error)
+	NewListRolePermissionsParams() *ListRolePermissionsParams
+	ListRolePermissions(p *ListRolePermissionsParams) (*ListRolePermissionsResponse, error)
+	NewListRolesParams() *ListRolesParams
+	GetRoleID(name string, opts ...OptionFunc) (string, int, error)
+	GetRoleByName(name string, opts ...OptionFunc) (*Role, int, error)
+	GetRoleByID(id string, opts ...OptionFunc) (*Role, int, error)
+	ListRoles(p *ListRolesParams) (*ListRolesResponse, error)
+	NewUpdateRoleParams(id string) *UpdateRoleParams
+	UpdateRole(p *UpdateRoleParams) (*UpdateRoleResponse, error)
+	NewUpdateRolePermissionParams(roleid string) *UpdateRolePermissionParams
+	UpdateRolePermission(p *UpdateRolePermissionParams) (*UpdateRolePermissionResponse, error)
+}
+
 type CreateRoleParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CreateRoleParams) Copy() *CreateRoleParams {
+	c := &CreateRoleParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateRoleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -107,6 +138,15 @@ type CreateRolePermissionParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CreateRolePermissionParams) Copy() *CreateRolePermissionParams {
+	c := &CreateRolePermissionParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateRolePermissionParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -196,6 +236,15 @@ type DeleteRoleParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteRoleParams) Copy() *DeleteRoleParams {
+	c := &DeleteRoleParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteRoleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -276,6 +325,15 @@ type DeleteRolePermissionParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteRolePermissionParams) Copy() *DeleteRolePermissionParams {
+	c := &DeleteRolePermissionParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteRolePermissionParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -356,6 +414,15 @@ type ListRolePermissionsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListRolePermissionsParams) Copy() *ListRolePermissionsParams {
+	c := &ListRolePermissionsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListRolePermissionsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -417,6 +484,15 @@ type ListRolesParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListRolesParams) Copy() *ListRolesParams {
+	c := &ListRolesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListRolesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -579,6 +655,15 @@ type UpdateRoleParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdateRoleParams) Copy() *UpdateRoleParams {
+	c := &UpdateRoleParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateRoleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -664,6 +749,15 @@ type UpdateRolePermissionParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdateRolePermissionParams) Copy() *UpdateRolePermissionParams {
+	c := &UpdateRolePermissionParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateRolePermissionParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -27,10 +27,44 @@ import (
 	"strings"
 )
 
+type RouterServiceIface interface {
+	NewChangeServiceForRouterParams(id string, serviceofferingid string) *ChangeServiceForRouterParams
+	ChangeServiceForRouter(p *ChangeServiceForRouterParams) (*ChangeServiceForRouterResponse, error)
+	NewConfigureVirtualRouterElementParams(enabled bool, id string) *ConfigureVirtualRouterElementParams
+	ConfigureVirtualRouterElement(p *ConfigureVirtualRouterElementParams) (*VirtualRouterElementResponse, error)
+	NewCreateVirtualRouterElementParams(nspid string) *CreateVirtualRouterElementParams
+	CreateVirtualRouterElement(p *CreateVirtualRouterElementParams) (*CreateVirtualRouterElementResponse, error)
+	NewDestroyRouterParams(id string) *DestroyRouterParams
+	DestroyRouter(p *DestroyRouterParams) (*DestroyRouterResponse, error)
+	NewListRoutersParams() *ListRoutersParams
+	GetRouterID(name string, opts ...OptionFunc) (string, int, error)
+	GetRouterByName(name string, opts ...OptionFunc) (*Router, int, error)
+	GetRouterByID(id string, opts ...OptionFunc) (*Router, int, error)
+	ListRouters(p *ListRoutersParams) (*ListRoutersResponse, error)
+	NewListVirtualRouterElementsParams() *ListVirtualRouterElementsParams
+	GetVirtualRouterElementByID(id string, opts ...OptionFunc) (*VirtualRouterElement, int, error)
+	ListVirtualRouterElements(p *ListVirtualRouterElementsParams) (*ListVirtualRouterElementsResponse, error)
+	NewRebootRouterParams(id string) *RebootRouterParams
+	RebootRouter(p *RebootRouterParams) (*RebootRouterResponse, error)
+	NewStartRouterParams(id string) *StartRouterParams
+	StartRouter(p *StartRouterParams) (*StartRouterResponse, error)
+	NewStopRouterParams(id string) *StopRouterParams
+	StopRouter(p *StopRouterParams) (*StopRouterResponse, error)
+}
+
 type ChangeServiceForRouterParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ChangeServiceForRouterParams) Copy() *ChangeServiceForRouterParams {
+	c := &ChangeServiceForRouterParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ChangeServiceForRouterParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -139,6 +173,15 @@ type ConfigureVirtualRouterElementParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ConfigureVirtualRouterElementParams) Copy() *ConfigureVirtualRouterElementParams {
+	c := &ConfigureVirtualRouterElementParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ConfigureVirtualRouterElementParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -230,6 +273,15 @@ type CreateVirtualRouterElementParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CreateVirtualRouterElementParams) Copy() *CreateVirtualRouterElementParams {
+	c := &CreateVirtualRouterElementParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateVirtualRouterElementParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -319,6 +371,15 @@ type DestroyRouterParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DestroyRouterParams) Copy() *DestroyRouterParams {
+	c := &DestroyRouterParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DestroyRouterParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -436,6 +497,15 @@ type ListRoutersParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListRoutersParams) Copy() *ListRoutersParams {
+	c := &ListRoutersParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListRoutersParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -805,6 +875,15 @@ type ListVirtualRouterElementsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListVirtualRouterElementsParams) Copy() *ListVirtualRouterElementsParams {
+	c := &ListVirtualRouterElementsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListVirtualRouterElementsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -954,6 +1033,15 @@ type RebootRouterParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *RebootRouterParams) Copy() *RebootRouterParams {
+	c := &RebootRouterParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *RebootRouterParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1071,6 +1159,15 @@ type StartRouterParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *StartRouterParams) Copy() *StartRouterParams {
+	c := &StartRouterParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *StartRouterParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1188,6 +1285,15 @@ type StopRouterParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *StopRouterParams) Copy() *StopRouterParams {
+	c := &StopRouterParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *StopRouterParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -25,10 +25,32 @@ import (
 	"strconv"
 )
 
+type SSHServiceIface interface {
+	NewCreateSSHKeyPairParams(name string) *CreateSSHKeyPairParams
+	CreateSSHKeyPair(p *CreateSSHKeyPairParams) (*CreateSSHKeyPairResponse, error)
+	NewDeleteSSHKeyPairParams(name string) *DeleteSSHKeyPairParams
+	DeleteSSHKeyPair(p *DeleteSSHKeyPairParams) (*DeleteSSHKeyPairResponse, error)
+	NewListSSHKeyPairsParams() *ListSSHKeyPairsParams
+	ListSSHKeyPairs(p *ListSSHKeyPairsParams) (*ListSSHKeyPairsResponse, error)
+	NewRegisterSSHKeyPairParams(name string, publickey string) *RegisterSSHKeyPairParams
+	RegisterSSHKeyPair(p *RegisterSSHKeyPairParams) (*RegisterSSHKeyPairResponse, error)
+	NewResetSSHKeyForVirtualMachineParams(id string, keypair string) *ResetSSHKeyForVirtualMachineParams
+	ResetSSHKeyForVirtualMachine(p *ResetSSHKeyForVirtualMachineParams) (*ResetSSHKeyForVirtualMachineResponse, error)
+}
+
 type CreateSSHKeyPairParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CreateSSHKeyPairParams) Copy() *CreateSSHKeyPairParams {
+	c := &CreateSSHKeyPairParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateSSHKeyPairParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -120,6 +142,15 @@ type DeleteSSHKeyPairParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteSSHKeyPairParams) Copy() *DeleteSSHKeyPairParams {
+	c := &DeleteSSHKeyPairParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteSSHKeyPairParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -230,6 +261,15 @@ type ListSSHKeyPairsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListSSHKeyPairsParams) Copy() *ListSSHKeyPairsParams {
+	c := &ListSSHKeyPairsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListSSHKeyPairsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -384,6 +424,15 @@ type RegisterSSHKeyPairParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *RegisterSSHKeyPairParams) Copy() *RegisterSSHKeyPairParams {
+	c := &RegisterSSHKeyPairParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *RegisterSSHKeyPairParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -485,6 +534,15 @@ type ResetSSHKeyForVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ResetSSHKeyForVirtualMachineParams) Copy() *ResetSSHKeyForVirtualMachineParams {
+	c := &ResetSSHKeyForVirtualMachineParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ResetSSHKeyForVirtualMachineParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -27,6 +27,26 @@ import (
 	"strings"
 )
 
+type SecurityGroupServiceIface interface {
+	NewAuthorizeSecurityGroupEgressParams() *AuthorizeSecurityGroupEgressParams
+	AuthorizeSecurityGroupEgress(p *AuthorizeSecurityGroupEgressParams) (*AuthorizeSecurityGroupEgressResponse, error)
+	NewAuthorizeSecurityGroupIngressParams() *AuthorizeSecurityGroupIngressParams
+	AuthorizeSecurityGroupIngress(p *AuthorizeSecurityGroupIngressParams) (*AuthorizeSecurityGroupIngressResponse, error)
+	NewCreateSecurityGroupParams(name string) *CreateSecurityGroupParams
+	CreateSecurityGroup(p *CreateSecurityGroupParams) (*CreateSecurityGroupResponse, error)
+	NewDeleteSecurityGroupParams() *DeleteSecurityGroupParams
+	DeleteSecurityGroup(p *DeleteSecurityGroupParams) (*DeleteSecurityGroupResponse, error)
+	NewListSecurityGroupsParams() *ListSecurityGroupsParams
+	GetSecurityGroupID(keyword string, opts ...OptionFunc) (string, int, error)
+	GetSecurityGroupByName(name string, opts ...OptionFunc) (*SecurityGroup, int, error)
+	GetSecurityGroupByID(id string, opts ...OptionFunc) (*SecurityGroup, int, error)
+	ListSecurityGroups(p *ListSecurityGroupsParams) (*ListSecurityGroupsResponse, error)
+	NewRevokeSecurityGroupEgressParams(id string) *RevokeSecurityGroupEgressParams
+	RevokeSecurityGroupEgress(p *RevokeSecurityGroupEgressParams) (*RevokeSecurityGroupEgressResponse, error)
+	NewRevokeSecurityGroupIngressParams(id string) *RevokeSecurityGroupIngressParams
+	RevokeSecurityGroupIngress(p *RevokeSecurityGroupIngressParams) (*RevokeSecurityGroupIngressResponse, error)
+}
+
 // Helper function for maintaining backwards compatibility
 func convertAuthorizeSecurityGroupIngressResponse(b []byte) ([]byte, error) {
 	var raw struct {
@@ -63,6 +83,15 @@ type AuthorizeSecurityGroupEgressParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *AuthorizeSecurityGroupEgressParams) Copy() *AuthorizeSecurityGroupEgressParams {
+	c := &AuthorizeSecurityGroupEgressParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AuthorizeSecurityGroupEgressParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -267,6 +296,15 @@ type AuthorizeSecurityGroupIngressParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *AuthorizeSecurityGroupIngressParams) Copy() *AuthorizeSecurityGroupIngressParams {
+	c := &AuthorizeSecurityGroupIngressParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AuthorizeSecurityGroupIngressParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -471,6 +509,15 @@ type CreateSecurityGroupParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CreateSecurityGroupParams) Copy() *CreateSecurityGroupParams {
+	c := &CreateSecurityGroupParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateSecurityGroupParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -592,6 +639,15 @@ type DeleteSecurityGroupParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteSecurityGroupParams) Copy() *DeleteSecurityGroupParams {
+	c := &DeleteSecurityGroupParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteSecurityGroupParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -711,6 +767,15 @@ type ListSecurityGroupsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListSecurityGroupsParams) Copy() *ListSecurityGroupsParams {
+	c := &ListSecurityGroupsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListSecurityGroupsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -993,6 +1058,15 @@ type RevokeSecurityGroupEgressParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *RevokeSecurityGroupEgressParams) Copy() *RevokeSecurityGroupEgressParams {
+	c := &RevokeSecurityGroupEgressParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *RevokeSecurityGroupEgressParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1061,6 +1135,15 @@ type RevokeSecurityGroupIngressParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *RevokeSecurityGroupIngressParams) Copy() *RevokeSecurityGroupIngressParams {
+	c := &RevokeSecurityGroupIngressParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *RevokeSecurityGroupIngressParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -27,10 +27,33 @@ import (
 	"strings"
 )
 
+type ServiceOfferingServiceIface interface {
+	NewCreateServiceOfferingParams(displaytext string, name string) *CreateServiceOfferingParams
+	CreateServiceOffering(p *CreateServiceOfferingParams) (*CreateServiceOfferingResponse, error)
+	NewDeleteServiceOfferingParams(id string) *DeleteServiceOfferingParams
+	DeleteServiceOffering(p *DeleteServiceOfferingParams) (*DeleteServiceOfferingResponse, error)
+	NewListServiceOfferingsParams() *ListServiceOfferingsParams
+	GetServiceOfferingID(name string, opts ...OptionFunc) (string, int, error)
+	GetServiceOfferingByName(name string, opts ...OptionFunc) (*ServiceOffering, int, error)
+	GetServiceOfferingByID(id string, opts ...OptionFunc) (*ServiceOffering, int, error)
+	ListServiceOfferings(p *ListServiceOfferingsParams) (*ListServiceOfferingsResponse, error)
+	NewUpdateServiceOfferingParams(id string) *UpdateServiceOfferingParams
+	UpdateServiceOffering(p *UpdateServiceOfferingParams) (*UpdateServiceOfferingResponse, error)
+}
+
 type CreateServiceOfferingParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CreateServiceOfferingParams) Copy() *CreateServiceOfferingParams {
+	c := &CreateServiceOfferingParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateServiceOfferingParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -484,6 +507,15 @@ type DeleteServiceOfferingParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteServiceOfferingParams) Copy() *DeleteServiceOfferingParams {
+	c := &DeleteServiceOfferingParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteServiceOfferingParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -564,6 +596,15 @@ type ListServiceOfferingsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListServiceOfferingsParams) Copy() *ListServiceOfferingsParams {
+	c := &ListServiceOfferingsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListServiceOfferingsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -846,6 +887,15 @@ type UpdateServiceOfferingParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdateServiceOfferingParams) Copy() *UpdateServiceOfferingParams {
+	c := &UpdateServiceOfferingParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateServiceOfferingParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
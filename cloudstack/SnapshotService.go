@@ -27,10 +27,51 @@ import (
 	"strings"
 )
 
+type SnapshotServiceIface interface {
+	NewCreateSnapshotParams(volumeid string) *CreateSnapshotParams
+	CreateSnapshot(p *CreateSnapshotParams) (*CreateSnapshotResponse, error)
+	NewCreateSnapshotPolicyParams(intervaltype string, maxsnaps int, schedule string, timezone string, volumeid string) *CreateSnapshotPolicyParams
+	CreateSnapshotPolicy(p *CreateSnapshotPolicyParams) (*CreateSnapshotPolicyResponse, error)
+	NewCreateVMSnapshotParams(virtualmachineid string) *CreateVMSnapshotParams
+	CreateVMSnapshot(p *CreateVMSnapshotParams) (*CreateVMSnapshotResponse, error)
+	NewDeleteSnapshotParams(id string) *DeleteSnapshotParams
+	DeleteSnapshot(p *DeleteSnapshotParams) (*DeleteSnapshotResponse, error)
+	NewDeleteSnapshotPoliciesParams() *DeleteSnapshotPoliciesParams
+	DeleteSnapshotPolicies(p *DeleteSnapshotPoliciesParams) (*DeleteSnapshotPoliciesResponse, error)
+	NewDeleteVMSnapshotParams(vmsnapshotid string) *DeleteVMSnapshotParams
+	DeleteVMSnapshot(p *DeleteVMSnapshotParams) (*DeleteVMSnapshotResponse, error)
+	NewListSnapshotPoliciesParams() *ListSnapshotPoliciesParams
+	GetSnapshotPolicyByID(id string, opts ...OptionFunc) (*SnapshotPolicy, int, error)
+	ListSnapshotPolicies(p *ListSnapshotPoliciesParams) (*ListSnapshotPoliciesResponse, error)
+	NewListSnapshotsParams() *ListSnapshotsParams
+	GetSnapshotID(name string, opts ...OptionFunc) (string, int, error)
+	GetSnapshotByName(name string, opts ...OptionFunc) (*Snapshot, int, error)
+	GetSnapshotByID(id string, opts ...OptionFunc) (*Snapshot, int, error)
+	ListSnapshots(p *ListSnapshotsParams) (*ListSnapshotsResponse, error)
+	NewListVMSnapshotParams() *ListVMSnapshotParams
+	GetVMSnapshotID(name string, opts ...OptionFunc) (string, int, error)
+	ListVMSnapshot(p *ListVMSnapshotParams) (*ListVMSnapshotResponse, error)
+	NewRevertSnapshotParams(id string) *RevertSnapshotParams
+	RevertSnapshot(p *RevertSnapshotParams) (*RevertSnapshotResponse, error)
+	NewRevertToVMSnapshotParams(vmsnapshotid string) *RevertToVMSnapshotParams
+	RevertToVMSnapshot(p *RevertToVMSnapshotParams) (*RevertToVMSnapshotResponse, error)
+	NewUpdateSnapshotPolicyParams() *UpdateSnapshotPolicyParams
+	UpdateSnapshotPolicy(p *UpdateSnapshotPolicyParams) (*UpdateSnapshotPolicyResponse, error)
+}
+
 type CreateSnapshotParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CreateSnapshotParams) Copy() *CreateSnapshotParams {
+	c := &CreateSnapshotParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateSnapshotParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -223,6 +264,15 @@ type CreateSnapshotPolicyParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CreateSnapshotPolicyParams) Copy() *CreateSnapshotPolicyParams {
+	c := &CreateSnapshotPolicyParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateSnapshotPolicyParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -337,6 +387,15 @@ type CreateVMSnapshotParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CreateVMSnapshotParams) Copy() *CreateVMSnapshotParams {
+	c := &CreateVMSnapshotParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateVMSnapshotParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -467,6 +526,15 @@ type DeleteSnapshotParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteSnapshotParams) Copy() *DeleteSnapshotParams {
+	c := &DeleteSnapshotParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteSnapshotParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -535,6 +603,15 @@ type DeleteSnapshotPoliciesParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteSnapshotPoliciesParams) Copy() *DeleteSnapshotPoliciesParams {
+	c := &DeleteSnapshotPoliciesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteSnapshotPoliciesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -625,6 +702,15 @@ type DeleteVMSnapshotParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteVMSnapshotParams) Copy() *DeleteVMSnapshotParams {
+	c := &DeleteVMSnapshotParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteVMSnapshotParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -693,6 +779,15 @@ type ListSnapshotPoliciesParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListSnapshotPoliciesParams) Copy() *ListSnapshotPoliciesParams {
+	c := &ListSnapshotPoliciesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListSnapshotPoliciesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -841,6 +936,15 @@ type ListSnapshotsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListSnapshotsParams) Copy() *ListSnapshotsParams {
+	c := &ListSnapshotsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListSnapshotsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1187,6 +1291,15 @@ type ListVMSnapshotParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListVMSnapshotParams) Copy() *ListVMSnapshotParams {
+	c := &ListVMSnapshotParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListVMSnapshotParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1434,6 +1547,15 @@ type RevertSnapshotParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *RevertSnapshotParams) Copy() *RevertSnapshotParams {
+	c := &RevertSnapshotParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *RevertSnapshotParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1554,6 +1676,15 @@ type RevertToVMSnapshotParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *RevertToVMSnapshotParams) Copy() *RevertToVMSnapshotParams {
+	c := &RevertToVMSnapshotParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *RevertToVMSnapshotParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1757,6 +1888,15 @@ type UpdateSnapshotPolicyParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdateSnapshotPolicyParams) Copy() *UpdateSnapshotPolicyParams {
+	c := &UpdateSnapshotPolicyParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateSnapshotPolicyParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -25,10 +25,28 @@ import (
 	"strconv"
 )
 
+type StoragePoolServiceIface interface {
+	NewCancelStorageMaintenanceParams(id string) *CancelStorageMaintenanceParams
+	CancelStorageMaintenance(p *CancelStorageMaintenanceParams) (*CancelStorageMaintenanceResponse, error)
+	NewEnableStorageMaintenanceParams(id string) *EnableStorageMaintenanceParams
+	EnableStorageMaintenance(p *EnableStorageMaintenanceParams) (*EnableStorageMaintenanceResponse, error)
+	NewListStorageProvidersParams(storagePoolType string) *ListStorageProvidersParams
+	ListStorageProviders(p *ListStorageProvidersParams) (*ListStorageProvidersResponse, error)
+}
+
 type CancelStorageMaintenanceParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CancelStorageMaintenanceParams) Copy() *CancelStorageMaintenanceParams {
+	c := &CancelStorageMaintenanceParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CancelStorageMaintenanceParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -125,6 +143,15 @@ type EnableStorageMaintenanceParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *EnableStorageMaintenanceParams) Copy() *EnableStorageMaintenanceParams {
+	c := &EnableStorageMaintenanceParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *EnableStorageMaintenanceParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -221,6 +248,15 @@ type ListStorageProvidersParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListStorageProvidersParams) Copy() *ListStorageProvidersParams {
+	c := &ListStorageProvidersParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListStorageProvidersParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
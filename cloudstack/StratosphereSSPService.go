@@ -25,10 +25,26 @@ import (
 	"strconv"
 )
 
+type StratosphereSSPServiceIface interface {
+	NewAddStratosphereSspParams(name string, url string, zoneid string) *AddStratosphereSspParams
+	AddStratosphereSsp(p *AddStratosphereSspParams) (*AddStratosphereSspResponse, error)
+	NewDeleteStratosphereSspParams(hostid string) *DeleteStratosphereSspParams
+	DeleteStratosphereSsp(p *DeleteStratosphereSspParams) (*DeleteStratosphereSspResponse, error)
+}
+
 type AddStratosphereSspParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *AddStratosphereSspParams) Copy() *AddStratosphereSspParams {
+	c := &AddStratosphereSspParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddStratosphereSspParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -136,6 +152,15 @@ type DeleteStratosphereSspParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteStratosphereSspParams) Copy() *DeleteStratosphereSspParams {
+	c := &DeleteStratosphereSspParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteStratosphereSspParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
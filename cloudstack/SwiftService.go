@@ -26,10 +26,27 @@ import (
 	"strconv"
 )
 
+type SwiftServiceIface interface {
+	NewAddSwiftParams(url string) *AddSwiftParams
+	AddSwift(p *AddSwiftParams) (*AddSwiftResponse, error)
+	NewListSwiftsParams() *ListSwiftsParams
+	GetSwiftID(keyword string, opts ...OptionFunc) (string, int, error)
+	ListSwifts(p *ListSwiftsParams) (*ListSwiftsResponse, error)
+}
+
 type AddSwiftParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *AddSwiftParams) Copy() *AddSwiftParams {
+	c := &AddSwiftParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddSwiftParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -119,6 +136,15 @@ type ListSwiftsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListSwiftsParams) Copy() *ListSwiftsParams {
+	c := &ListSwiftsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListSwiftsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
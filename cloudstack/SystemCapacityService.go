@@ -25,10 +25,24 @@ import (
 	"strconv"
 )
 
+type SystemCapacityServiceIface interface {
+	NewListCapacityParams() *ListCapacityParams
+	ListCapacity(p *ListCapacityParams) (*ListCapacityResponse, error)
+}
+
 type ListCapacityParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListCapacityParams) Copy() *ListCapacityParams {
+	c := &ListCapacityParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListCapacityParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
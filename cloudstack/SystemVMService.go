@@ -27,10 +27,41 @@ import (
 	"strings"
 )
 
+type SystemVMServiceIface interface {
+	NewChangeServiceForSystemVmParams(id string, serviceofferingid string) *ChangeServiceForSystemVmParams
+	ChangeServiceForSystemVm(p *ChangeServiceForSystemVmParams) (*ChangeServiceForSystemVmResponse, error)
+	NewDestroySystemVmParams(id string) *DestroySystemVmParams
+	DestroySystemVm(p *DestroySystemVmParams) (*DestroySystemVmResponse, error)
+	NewListSystemVmsParams() *ListSystemVmsParams
+	GetSystemVmID(name string, opts ...OptionFunc) (string, int, error)
+	GetSystemVmByName(name string, opts ...OptionFunc) (*SystemVm, int, error)
+	GetSystemVmByID(id string, opts ...OptionFunc) (*SystemVm, int, error)
+	ListSystemVms(p *ListSystemVmsParams) (*ListSystemVmsResponse, error)
+	NewMigrateSystemVmParams(hostid string, virtualmachineid string) *MigrateSystemVmParams
+	MigrateSystemVm(p *MigrateSystemVmParams) (*MigrateSystemVmResponse, error)
+	NewRebootSystemVmParams(id string) *RebootSystemVmParams
+	RebootSystemVm(p *RebootSystemVmParams) (*RebootSystemVmResponse, error)
+	NewScaleSystemVmParams(id string, serviceofferingid string) *ScaleSystemVmParams
+	ScaleSystemVm(p *ScaleSystemVmParams) (*ScaleSystemVmResponse, error)
+	NewStartSystemVmParams(id string) *StartSystemVmParams
+	StartSystemVm(p *StartSystemVmParams) (*StartSystemVmResponse, error)
+	NewStopSystemVmParams(id string) *StopSystemVmParams
+	StopSystemVm(p *StopSystemVmParams) (*StopSystemVmResponse, error)
+}
+
 type ChangeServiceForSystemVmParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ChangeServiceForSystemVmParams) Copy() *ChangeServiceForSystemVmParams {
+	c := &ChangeServiceForSystemVmParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ChangeServiceForSystemVmParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -134,6 +165,15 @@ type DestroySystemVmParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DestroySystemVmParams) Copy() *DestroySystemVmParams {
+	c := &DestroySystemVmParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DestroySystemVmParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -233,6 +273,15 @@ type ListSystemVmsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListSystemVmsParams) Copy() *ListSystemVmsParams {
+	c := &ListSystemVmsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListSystemVmsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -501,6 +550,15 @@ type MigrateSystemVmParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *MigrateSystemVmParams) Copy() *MigrateSystemVmParams {
+	c := &MigrateSystemVmParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *MigrateSystemVmParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -611,6 +669,15 @@ type RebootSystemVmParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *RebootSystemVmParams) Copy() *RebootSystemVmParams {
+	c := &RebootSystemVmParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *RebootSystemVmParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -710,6 +777,15 @@ type ScaleSystemVmParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ScaleSystemVmParams) Copy() *ScaleSystemVmParams {
+	c := &ScaleSystemVmParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ScaleSystemVmParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -833,6 +909,15 @@ type StartSystemVmParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *StartSystemVmParams) Copy() *StartSystemVmParams {
+	c := &StartSystemVmParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *StartSystemVmParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -932,6 +1017,15 @@ type StopSystemVmParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *StopSystemVmParams) Copy() *StopSystemVmParams {
+	c := &StopSystemVmParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *StopSystemVmParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
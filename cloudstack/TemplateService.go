@@ -27,10 +27,50 @@ import (
 	"strings"
 )
 
+type TemplateServiceIface interface {
+	NewCopyTemplateParams(id string) *CopyTemplateParams
+	CopyTemplate(p *CopyTemplateParams) (*CopyTemplateResponse, error)
+	NewCreateTemplateParams(displaytext string, name string, ostypeid string) *CreateTemplateParams
+	CreateTemplate(p *CreateTemplateParams) (*CreateTemplateResponse, error)
+	NewDeleteTemplateParams(id string) *DeleteTemplateParams
+	DeleteTemplate(p *DeleteTemplateParams) (*DeleteTemplateResponse, error)
+	NewExtractTemplateParams(id string, mode string) *ExtractTemplateParams
+	ExtractTemplate(p *ExtractTemplateParams) (*ExtractTemplateResponse, error)
+	NewGetUploadParamsForTemplateParams(displaytext string, format string, hypervisor string, name string, ostypeid string, zoneid string) *GetUploadParamsForTemplateParams
+	GetUploadParamsForTemplate(p *GetUploadParamsForTemplateParams) (*GetUploadParamsForTemplateResponse, error)
+	NewListTemplatePermissionsParams(id string) *ListTemplatePermissionsParams
+	GetTemplatePermissionByID(id string, opts ...OptionFunc) (*TemplatePermission, int, error)
+	ListTemplatePermissions(p *ListTemplatePermissionsParams) (*ListTemplatePermissionsResponse, error)
+	NewListTemplatesParams(templatefilter string) *ListTemplatesParams
+	GetTemplateID(name string, templatefilter string, zoneid string, opts ...OptionFunc) (string, int, error)
+	GetTemplateByName(name string, templatefilter string, zoneid string, opts ...OptionFunc) (*Template, int, error)
+	GetTemplateByID(id string, templatefilter string, opts ...OptionFunc) (*Template, int, error)
+	ListTemplates(p *ListTemplatesParams) (*ListTemplatesResponse, error)
+	NewPrepareTemplateParams(templateid string, zoneid string) *PrepareTemplateParams
+	PrepareTemplate(p *PrepareTemplateParams) (*PrepareTemplateResponse, error)
+	NewRegisterTemplateParams(displaytext string, format string, hypervisor string, name string, ostypeid string, url string) *RegisterTemplateParams
+	RegisterTemplate(p *RegisterTemplateParams) (*RegisterTemplateResponse, error)
+	NewUpdateTemplateParams(id string) *UpdateTemplateParams
+	UpdateTemplate(p *UpdateTemplateParams) (*UpdateTemplateResponse, error)
+	NewUpdateTemplatePermissionsParams(id string) *UpdateTemplatePermissionsParams
+	UpdateTemplatePermissions(p *UpdateTemplatePermissionsParams) (*UpdateTemplatePermissionsResponse, error)
+	NewUpgradeRouterTemplateParams() *UpgradeRouterTemplateParams
+	UpgradeRouterTemplate(p *UpgradeRouterTemplateParams) (*UpgradeRouterTemplateResponse, error)
+}
+
 type CopyTemplateParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CopyTemplateParams) Copy() *CopyTemplateParams {
+	c := &CopyTemplateParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CopyTemplateParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -202,6 +242,15 @@ type CreateTemplateParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CreateTemplateParams) Copy() *CreateTemplateParams {
+	c := &CreateTemplateParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateTemplateParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -514,6 +563,15 @@ type DeleteTemplateParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteTemplateParams) Copy() *DeleteTemplateParams {
+	c := &DeleteTemplateParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteTemplateParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -603,6 +661,15 @@ type ExtractTemplateParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ExtractTemplateParams) Copy() *ExtractTemplateParams {
+	c := &ExtractTemplateParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ExtractTemplateParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -719,6 +786,15 @@ type GetUploadParamsForTemplateParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *GetUploadParamsForTemplateParams) Copy() *GetUploadParamsForTemplateParams {
+	c := &GetUploadParamsForTemplateParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *GetUploadParamsForTemplateParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -970,6 +1046,10 @@ func (s *TemplateService) GetUploadParamsForTemplate(p *GetUploadParamsForTempla
 		return nil, err
 	}
 
+	if resp, err = getRawValue(resp); err != nil {
+		return nil, err
+	}
+
 	var r GetUploadParamsForTemplateResponse
 	if err := json.Unmarshal(resp, &r); err != nil {
 		return nil, err
@@ -992,6 +1072,15 @@ type ListTemplatePermissionsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListTemplatePermissionsParams) Copy() *ListTemplatePermissionsParams {
+	c := &ListTemplatePermissionsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListTemplatePermissionsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1086,6 +1175,15 @@ type ListTemplatesParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListTemplatesParams) Copy() *ListTemplatesParams {
+	c := &ListTemplatesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListTemplatesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1467,6 +1565,15 @@ type PrepareTemplateParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *PrepareTemplateParams) Copy() *PrepareTemplateParams {
+	c := &PrepareTemplateParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *PrepareTemplateParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1608,6 +1715,15 @@ type RegisterTemplateParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *RegisterTemplateParams) Copy() *RegisterTemplateParams {
+	c := &RegisterTemplateParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *RegisterTemplateParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1982,6 +2098,15 @@ type UpdateTemplateParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdateTemplateParams) Copy() *UpdateTemplateParams {
+	c := &UpdateTemplateParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateTemplateParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2243,6 +2368,15 @@ type UpdateTemplatePermissionsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdateTemplatePermissionsParams) Copy() *UpdateTemplatePermissionsParams {
+	c := &UpdateTemplatePermissionsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateTemplatePermissionsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2388,6 +2522,15 @@ type UpgradeRouterTemplateParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpgradeRouterTemplateParams) Copy() *UpgradeRouterTemplateParams {
+	c := &UpgradeRouterTemplateParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpgradeRouterTemplateParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
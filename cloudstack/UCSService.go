@@ -27,10 +27,37 @@ import (
 	"strings"
 )
 
+type UCSServiceIface interface {
+	NewAddUcsManagerParams(password string, url string, username string, zoneid string) *AddUcsManagerParams
+	AddUcsManager(p *AddUcsManagerParams) (*AddUcsManagerResponse, error)
+	NewAssociateUcsProfileToBladeParams(bladeid string, profiledn string, ucsmanagerid string) *AssociateUcsProfileToBladeParams
+	AssociateUcsProfileToBlade(p *AssociateUcsProfileToBladeParams) (*AssociateUcsProfileToBladeResponse, error)
+	NewDeleteUcsManagerParams(ucsmanagerid string) *DeleteUcsManagerParams
+	DeleteUcsManager(p *DeleteUcsManagerParams) (*DeleteUcsManagerResponse, error)
+	NewListUcsBladesParams(ucsmanagerid string) *ListUcsBladesParams
+	ListUcsBlades(p *ListUcsBladesParams) (*ListUcsBladesResponse, error)
+	NewListUcsManagersParams() *ListUcsManagersParams
+	GetUcsManagerID(keyword string, opts ...OptionFunc) (string, int, error)
+	GetUcsManagerByName(name string, opts ...OptionFunc) (*UcsManager, int, error)
+	GetUcsManagerByID(id string, opts ...OptionFunc) (*UcsManager, int, error)
+	ListUcsManagers(p *ListUcsManagersParams) (*ListUcsManagersResponse, error)
+	NewListUcsProfilesParams(ucsmanagerid string) *ListUcsProfilesParams
+	ListUcsProfiles(p *ListUcsProfilesParams) (*ListUcsProfilesResponse, error)
+}
+
 type AddUcsManagerParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *AddUcsManagerParams) Copy() *AddUcsManagerParams {
+	c := &AddUcsManagerParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddUcsManagerParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -129,6 +156,15 @@ type AssociateUcsProfileToBladeParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *AssociateUcsProfileToBladeParams) Copy() *AssociateUcsProfileToBladeParams {
+	c := &AssociateUcsProfileToBladeParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AssociateUcsProfileToBladeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -227,6 +263,15 @@ type DeleteUcsManagerParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteUcsManagerParams) Copy() *DeleteUcsManagerParams {
+	c := &DeleteUcsManagerParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteUcsManagerParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -307,6 +352,15 @@ type ListUcsBladesParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListUcsBladesParams) Copy() *ListUcsBladesParams {
+	c := &ListUcsBladesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListUcsBladesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -400,6 +454,15 @@ type ListUcsManagersParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListUcsManagersParams) Copy() *ListUcsManagersParams {
+	c := &ListUcsManagersParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListUcsManagersParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -584,6 +647,15 @@ type ListUcsProfilesParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListUcsProfilesParams) Copy() *ListUcsProfilesParams {
+	c := &ListUcsProfilesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListUcsProfilesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
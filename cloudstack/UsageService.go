@@ -26,10 +26,47 @@ import (
 	"strconv"
 )
 
+type UsageServiceIface interface {
+	NewAddTrafficMonitorParams(url string, zoneid string) *AddTrafficMonitorParams
+	AddTrafficMonitor(p *AddTrafficMonitorParams) (*AddTrafficMonitorResponse, error)
+	NewAddTrafficTypeParams(physicalnetworkid string, traffictype string) *AddTrafficTypeParams
+	AddTrafficType(p *AddTrafficTypeParams) (*AddTrafficTypeResponse, error)
+	NewDeleteTrafficMonitorParams(id string) *DeleteTrafficMonitorParams
+	DeleteTrafficMonitor(p *DeleteTrafficMonitorParams) (*DeleteTrafficMonitorResponse, error)
+	NewDeleteTrafficTypeParams(id string) *DeleteTrafficTypeParams
+	DeleteTrafficType(p *DeleteTrafficTypeParams) (*DeleteTrafficTypeResponse, error)
+	NewGenerateUsageRecordsParams(enddate string, startdate string) *GenerateUsageRecordsParams
+	GenerateUsageRecords(p *GenerateUsageRecordsParams) (*GenerateUsageRecordsResponse, error)
+	NewListTrafficMonitorsParams(zoneid string) *ListTrafficMonitorsParams
+	ListTrafficMonitors(p *ListTrafficMonitorsParams) (*ListTrafficMonitorsResponse, error)
+	NewListTrafficTypeImplementorsParams() *ListTrafficTypeImplementorsParams
+	ListTrafficTypeImplementors(p *ListTrafficTypeImplementorsParams) (*ListTrafficTypeImplementorsResponse, error)
+	NewListTrafficTypesParams(physicalnetworkid string) *ListTrafficTypesParams
+	GetTrafficTypeID(keyword string, physicalnetworkid string, opts ...OptionFunc) (string, int, error)
+	ListTrafficTypes(p *ListTrafficTypesParams) (*ListTrafficTypesResponse, error)
+	NewListUsageRecordsParams(enddate string, startdate string) *ListUsageRecordsParams
+	ListUsageRecords(p *ListUsageRecordsParams) (*ListUsageRecordsResponse, error)
+	NewListUsageTypesParams() *ListUsageTypesParams
+	ListUsageTypes(p *ListUsageTypesParams) (*ListUsageTypesResponse, error)
+	NewRemoveRawUsageRecordsParams(interval int) *RemoveRawUsageRecordsParams
+	RemoveRawUsageRecords(p *RemoveRawUsageRecordsParams) (*RemoveRawUsageRecordsResponse, error)
+	NewUpdateTrafficTypeParams(id string) *UpdateTrafficTypeParams
+	UpdateTrafficType(p *UpdateTrafficTypeParams) (*UpdateTrafficTypeResponse, error)
+}
+
 type AddTrafficMonitorParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *AddTrafficMonitorParams) Copy() *AddTrafficMonitorParams {
+	c := &AddTrafficMonitorParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddTrafficMonitorParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -117,6 +154,15 @@ type AddTrafficTypeParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *AddTrafficTypeParams) Copy() *AddTrafficTypeParams {
+	c := &AddTrafficTypeParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddTrafficTypeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -277,6 +323,15 @@ type DeleteTrafficMonitorParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteTrafficMonitorParams) Copy() *DeleteTrafficMonitorParams {
+	c := &DeleteTrafficMonitorParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteTrafficMonitorParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -357,6 +412,15 @@ type DeleteTrafficTypeParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteTrafficTypeParams) Copy() *DeleteTrafficTypeParams {
+	c := &DeleteTrafficTypeParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteTrafficTypeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -425,6 +489,15 @@ type GenerateUsageRecordsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *GenerateUsageRecordsParams) Copy() *GenerateUsageRecordsParams {
+	c := &GenerateUsageRecordsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *GenerateUsageRecordsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -526,6 +599,15 @@ type ListTrafficMonitorsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListTrafficMonitorsParams) Copy() *ListTrafficMonitorsParams {
+	c := &ListTrafficMonitorsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListTrafficMonitorsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -619,6 +701,15 @@ type ListTrafficTypeImplementorsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListTrafficTypeImplementorsParams) Copy() *ListTrafficTypeImplementorsParams {
+	c := &ListTrafficTypeImplementorsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListTrafficTypeImplementorsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -708,6 +799,15 @@ type ListTrafficTypesParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListTrafficTypesParams) Copy() *ListTrafficTypesParams {
+	c := &ListTrafficTypesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListTrafficTypesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -840,6 +940,15 @@ type ListUsageRecordsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListUsageRecordsParams) Copy() *ListUsageRecordsParams {
+	c := &ListUsageRecordsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListUsageRecordsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1040,6 +1149,15 @@ type ListUsageTypesParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListUsageTypesParams) Copy() *ListUsageTypesParams {
+	c := &ListUsageTypesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListUsageTypesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1087,6 +1205,15 @@ type RemoveRawUsageRecordsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *RemoveRawUsageRecordsParams) Copy() *RemoveRawUsageRecordsParams {
+	c := &RemoveRawUsageRecordsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *RemoveRawUsageRecordsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1168,6 +1295,15 @@ type UpdateTrafficTypeParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdateTrafficTypeParams) Copy() *UpdateTrafficTypeParams {
+	c := &UpdateTrafficTypeParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateTrafficTypeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
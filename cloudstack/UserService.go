@@ -27,10 +27,43 @@ import (
 	"strings"
 )
 
+type UserServiceIface interface {
+	NewCreateUserParams(account string, email string, firstname string, lastname string, password string, username string) *CreateUserParams
+	CreateUser(p *CreateUserParams) (*CreateUserResponse, error)
+	NewDeleteUserParams(id string) *DeleteUserParams
+	DeleteUser(p *DeleteUserParams) (*DeleteUserResponse, error)
+	NewDisableUserParams(id string) *DisableUserParams
+	DisableUser(p *DisableUserParams) (*DisableUserResponse, error)
+	NewEnableUserParams(id string) *EnableUserParams
+	EnableUser(p *EnableUserParams) (*EnableUserResponse, error)
+	NewGetUserParams(userapikey string) *GetUserParams
+	GetUser(p *GetUserParams) (*GetUserResponse, error)
+	NewGetVirtualMachineUserDataParams(virtualmachineid string) *GetVirtualMachineUserDataParams
+	GetVirtualMachineUserData(p *GetVirtualMachineUserDataParams) (*GetVirtualMachineUserDataResponse, error)
+	NewListUsersParams() *ListUsersParams
+	GetUserByID(id string, opts ...OptionFunc) (*User, int, error)
+	ListUsers(p *ListUsersParams) (*ListUsersResponse, error)
+	NewLockUserParams(id string) *LockUserParams
+	LockUser(p *LockUserParams) (*LockUserResponse, error)
+	NewRegisterUserKeysParams(id string) *RegisterUserKeysParams
+	RegisterUserKeys(p *RegisterUserKeysParams) (*RegisterUserKeysResponse, error)
+	NewUpdateUserParams(id string) *UpdateUserParams
+	UpdateUser(p *UpdateUserParams) (*UpdateUserResponse, error)
+}
+
 type CreateUserParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CreateUserParams) Copy() *CreateUserParams {
+	c := &CreateUserParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateUserParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -192,6 +225,15 @@ type DeleteUserParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteUserParams) Copy() *DeleteUserParams {
+	c := &DeleteUserParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteUserParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -272,6 +314,15 @@ type DisableUserParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DisableUserParams) Copy() *DisableUserParams {
+	c := &DisableUserParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DisableUserParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -364,6 +415,15 @@ type EnableUserParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *EnableUserParams) Copy() *EnableUserParams {
+	c := &EnableUserParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *EnableUserParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -436,6 +496,15 @@ type GetUserParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *GetUserParams) Copy() *GetUserParams {
+	c := &GetUserParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *GetUserParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -508,6 +577,15 @@ type GetVirtualMachineUserDataParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *GetVirtualMachineUserDataParams) Copy() *GetVirtualMachineUserDataParams {
+	c := &GetVirtualMachineUserDataParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *GetVirtualMachineUserDataParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -565,6 +643,15 @@ type ListUsersParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListUsersParams) Copy() *ListUsersParams {
+	c := &ListUsersParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListUsersParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -779,6 +866,15 @@ type LockUserParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *LockUserParams) Copy() *LockUserParams {
+	c := &LockUserParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *LockUserParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -851,6 +947,15 @@ type RegisterUserKeysParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *RegisterUserKeysParams) Copy() *RegisterUserKeysParams {
+	c := &RegisterUserKeysParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *RegisterUserKeysParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -908,6 +1013,15 @@ type UpdateUserParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdateUserParams) Copy() *UpdateUserParams {
+	c := &UpdateUserParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateUserParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -27,10 +27,36 @@ import (
 	"strings"
 )
 
+type VLANServiceIface interface {
+	NewCreateVlanIpRangeParams() *CreateVlanIpRangeParams
+	CreateVlanIpRange(p *CreateVlanIpRangeParams) (*CreateVlanIpRangeResponse, error)
+	NewDedicateGuestVlanRangeParams(physicalnetworkid string, vlanrange string) *DedicateGuestVlanRangeParams
+	DedicateGuestVlanRange(p *DedicateGuestVlanRangeParams) (*DedicateGuestVlanRangeResponse, error)
+	NewDeleteVlanIpRangeParams(id string) *DeleteVlanIpRangeParams
+	DeleteVlanIpRange(p *DeleteVlanIpRangeParams) (*DeleteVlanIpRangeResponse, error)
+	NewListDedicatedGuestVlanRangesParams() *ListDedicatedGuestVlanRangesParams
+	GetDedicatedGuestVlanRangeByID(id string, opts ...OptionFunc) (*DedicatedGuestVlanRange, int, error)
+	ListDedicatedGuestVlanRanges(p *ListDedicatedGuestVlanRangesParams) (*ListDedicatedGuestVlanRangesResponse, error)
+	NewListVlanIpRangesParams() *ListVlanIpRangesParams
+	GetVlanIpRangeByID(id string, opts ...OptionFunc) (*VlanIpRange, int, error)
+	ListVlanIpRanges(p *ListVlanIpRangesParams) (*ListVlanIpRangesResponse, error)
+	NewReleaseDedicatedGuestVlanRangeParams(id string) *ReleaseDedicatedGuestVlanRangeParams
+	ReleaseDedicatedGuestVlanRange(p *ReleaseDedicatedGuestVlanRangeParams) (*ReleaseDedicatedGuestVlanRangeResponse, error)
+}
+
 type CreateVlanIpRangeParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CreateVlanIpRangeParams) Copy() *CreateVlanIpRangeParams {
+	c := &CreateVlanIpRangeParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateVlanIpRangeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -276,6 +302,15 @@ type DedicateGuestVlanRangeParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DedicateGuestVlanRangeParams) Copy() *DedicateGuestVlanRangeParams {
+	c := &DedicateGuestVlanRangeParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DedicateGuestVlanRangeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -377,6 +412,15 @@ type DeleteVlanIpRangeParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteVlanIpRangeParams) Copy() *DeleteVlanIpRangeParams {
+	c := &DeleteVlanIpRangeParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteVlanIpRangeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -457,6 +501,15 @@ type ListDedicatedGuestVlanRangesParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListDedicatedGuestVlanRangesParams) Copy() *ListDedicatedGuestVlanRangesParams {
+	c := &ListDedicatedGuestVlanRangesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListDedicatedGuestVlanRangesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -646,6 +699,15 @@ type ListVlanIpRangesParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListVlanIpRangesParams) Copy() *ListVlanIpRangesParams {
+	c := &ListVlanIpRangesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListVlanIpRangesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -880,6 +942,15 @@ type ReleaseDedicatedGuestVlanRangeParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ReleaseDedicatedGuestVlanRangeParams) Copy() *ReleaseDedicatedGuestVlanRangeParams {
+	c := &ReleaseDedicatedGuestVlanRangeParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ReleaseDedicatedGuestVlanRangeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
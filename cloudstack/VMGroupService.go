@@ -27,10 +27,33 @@ import (
 	"strings"
 )
 
+type VMGroupServiceIface interface {
+	NewCreateInstanceGroupParams(name string) *CreateInstanceGroupParams
+	CreateInstanceGroup(p *CreateInstanceGroupParams) (*CreateInstanceGroupResponse, error)
+	NewDeleteInstanceGroupParams(id string) *DeleteInstanceGroupParams
+	DeleteInstanceGroup(p *DeleteInstanceGroupParams) (*DeleteInstanceGroupResponse, error)
+	NewListInstanceGroupsParams() *ListInstanceGroupsParams
+	GetInstanceGroupID(name string, opts ...OptionFunc) (string, int, error)
+	GetInstanceGroupByName(name string, opts ...OptionFunc) (*InstanceGroup, int, error)
+	GetInstanceGroupByID(id string, opts ...OptionFunc) (*InstanceGroup, int, error)
+	ListInstanceGroups(p *ListInstanceGroupsParams) (*ListInstanceGroupsResponse, error)
+	NewUpdateInstanceGroupParams(id string) *UpdateInstanceGroupParams
+	UpdateInstanceGroup(p *UpdateInstanceGroupParams) (*UpdateInstanceGroupResponse, error)
+}
+
 type CreateInstanceGroupParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CreateInstanceGroupParams) Copy() *CreateInstanceGroupParams {
+	c := &CreateInstanceGroupParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateInstanceGroupParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -120,6 +143,15 @@ type DeleteInstanceGroupParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteInstanceGroupParams) Copy() *DeleteInstanceGroupParams {
+	c := &DeleteInstanceGroupParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteInstanceGroupParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -200,6 +232,15 @@ type ListInstanceGroupsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListInstanceGroupsParams) Copy() *ListInstanceGroupsParams {
+	c := &ListInstanceGroupsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListInstanceGroupsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -440,6 +481,15 @@ type UpdateInstanceGroupParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdateInstanceGroupParams) Copy() *UpdateInstanceGroupParams {
+	c := &UpdateInstanceGroupParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateInstanceGroupParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
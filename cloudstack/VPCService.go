@@ -27,10 +27,60 @@ import (
 	"strings"
 )
 
+type VPCServiceIface interface {
+	NewCreatePrivateGatewayParams(gateway string, ipaddress string, netmask string, vlan string, vpcid string) *CreatePrivateGatewayParams
+	CreatePrivateGateway(p *CreatePrivateGatewayParams) (*CreatePrivateGatewayResponse, error)
+	NewCreateStaticRouteParams(cidr string, gatewayid string) *CreateStaticRouteParams
+	CreateStaticRoute(p *CreateStaticRouteParams) (*CreateStaticRouteResponse, error)
+	NewCreateVPCParams(cidr string, displaytext string, name string, vpcofferingid string, zoneid string) *CreateVPCParams
+	CreateVPC(p *CreateVPCParams) (*CreateVPCResponse, error)
+	NewCreateVPCOfferingParams(displaytext string, name string, supportedservices []string) *CreateVPCOfferingParams
+	CreateVPCOffering(p *CreateVPCOfferingParams) (*CreateVPCOfferingResponse, error)
+	NewDeletePrivateGatewayParams(id string) *DeletePrivateGatewayParams
+	DeletePrivateGateway(p *DeletePrivateGatewayParams) (*DeletePrivateGatewayResponse, error)
+	NewDeleteStaticRouteParams(id string) *DeleteStaticRouteParams
+	DeleteStaticRoute(p *DeleteStaticRouteParams) (*DeleteStaticRouteResponse, error)
+	NewDeleteVPCParams(id string) *DeleteVPCParams
+	DeleteVPC(p *DeleteVPCParams) (*DeleteVPCResponse, error)
+	NewDeleteVPCOfferingParams(id string) *DeleteVPCOfferingParams
+	DeleteVPCOffering(p *DeleteVPCOfferingParams) (*DeleteVPCOfferingResponse, error)
+	NewListPrivateGatewaysParams() *ListPrivateGatewaysParams
+	GetPrivateGatewayByID(id string, opts ...OptionFunc) (*PrivateGateway, int, error)
+	ListPrivateGateways(p *ListPrivateGatewaysParams) (*ListPrivateGatewaysResponse, error)
+	NewListStaticRoutesParams() *ListStaticRoutesParams
+	GetStaticRouteByID(id string, opts ...OptionFunc) (*StaticRoute, int, error)
+	ListStaticRoutes(p *ListStaticRoutesParams) (*ListStaticRoutesResponse, error)
+	NewListVPCOfferingsParams() *ListVPCOfferingsParams
+	GetVPCOfferingID(name string, opts ...OptionFunc) (string, int, error)
+	GetVPCOfferingByName(name string, opts ...OptionFunc) (*VPCOffering, int, error)
+	GetVPCOfferingByID(id string, opts ...OptionFunc) (*VPCOffering, int, error)
+	ListVPCOfferings(p *ListVPCOfferingsParams) (*ListVPCOfferingsResponse, error)
+	NewListVPCsParams() *ListVPCsParams
+	GetVPCID(name string, opts ...OptionFunc) (string, int, error)
+	GetVPCByName(name string, opts ...OptionFunc) (*VPC, int, error)
+	GetVPCByID(id string, opts ...OptionFunc) (*VPC, int, error)
+	ListVPCs(p *ListVPCsParams) (*ListVPCsResponse, error)
+	NewRestartVPCParams(id string) *RestartVPCParams
+	RestartVPC(p *RestartVPCParams) (*RestartVPCResponse, error)
+	NewUpdateVPCParams(id string) *UpdateVPCParams
+	UpdateVPC(p *UpdateVPCParams) (*UpdateVPCResponse, error)
+	NewUpdateVPCOfferingParams(id string) *UpdateVPCOfferingParams
+	UpdateVPCOffering(p *UpdateVPCOfferingParams) (*UpdateVPCOfferingResponse, error)
+}
+
 type CreatePrivateGatewayParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CreatePrivateGatewayParams) Copy() *CreatePrivateGatewayParams {
+	c := &CreatePrivateGatewayParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreatePrivateGatewayParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -204,6 +254,15 @@ type CreateStaticRouteParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CreateStaticRouteParams) Copy() *CreateStaticRouteParams {
+	c := &CreateStaticRouteParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateStaticRouteParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -297,6 +356,15 @@ type CreateVPCParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CreateVPCParams) Copy() *CreateVPCParams {
+	c := &CreateVPCParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateVPCParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -594,6 +662,15 @@ type CreateVPCOfferingParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CreateVPCOfferingParams) Copy() *CreateVPCOfferingParams {
+	c := &CreateVPCOfferingParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateVPCOfferingParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -606,10 +683,17 @@ func (p *CreateVPCOfferingParams) toURLValues() url.Values {
 		u.Set("name", v.(string))
 	}
 	if v, found := p.p["servicecapabilitylist"]; found {
-		m := v.(map[string]string)
-		for i, k := range getSortedKeysFromMap(m) {
-			u.Set(fmt.Sprintf("servicecapabilitylist[%d].key", i), k)
-			u.Set(fmt.Sprintf("servicecapabilitylist[%d].value", i), m[k])
+		l := v.([]ServiceCapability)
+		for i, e := range l {
+			if e.Service != "" {
+				u.Set(fmt.Sprintf("servicecapabilitylist[%d].service", i), e.Service)
+			}
+			if e.CapabilityType != "" {
+				u.Set(fmt.Sprintf("servicecapabilitylist[%d].capabilitytype", i), e.CapabilityType)
+			}
+			if e.CapabilityValue != "" {
+				u.Set(fmt.Sprintf("servicecapabilitylist[%d].capabilityvalue", i), e.CapabilityValue)
+			}
 		}
 	}
 	if v, found := p.p["serviceofferingid"]; found {
@@ -643,7 +727,7 @@ func (p *CreateVPCOfferingParams) SetName(v string) {
 	p.p["name"] = v
 }
 
-func (p *CreateVPCOfferingParams) SetServicecapabilitylist(v map[string]string) {
+func (p *CreateVPCOfferingParams) SetServicecapabilitylist(v []ServiceCapability) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
@@ -757,6 +841,15 @@ type DeletePrivateGatewayParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeletePrivateGatewayParams) Copy() *DeletePrivateGatewayParams {
+	c := &DeletePrivateGatewayParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeletePrivateGatewayParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -825,6 +918,15 @@ type DeleteStaticRouteParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteStaticRouteParams) Copy() *DeleteStaticRouteParams {
+	c := &DeleteStaticRouteParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteStaticRouteParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -893,6 +995,15 @@ type DeleteVPCParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteVPCParams) Copy() *DeleteVPCParams {
+	c := &DeleteVPCParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteVPCParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -961,6 +1072,15 @@ type DeleteVPCOfferingParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteVPCOfferingParams) Copy() *DeleteVPCOfferingParams {
+	c := &DeleteVPCOfferingParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteVPCOfferingParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1029,6 +1149,15 @@ type ListPrivateGatewaysParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListPrivateGatewaysParams) Copy() *ListPrivateGatewaysParams {
+	c := &ListPrivateGatewaysParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListPrivateGatewaysParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1258,6 +1387,15 @@ type ListStaticRoutesParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListStaticRoutesParams) Copy() *ListStaticRoutesParams {
+	c := &ListStaticRoutesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListStaticRoutesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1475,6 +1613,15 @@ type ListVPCOfferingsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListVPCOfferingsParams) Copy() *ListVPCOfferingsParams {
+	c := &ListVPCOfferingsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListVPCOfferingsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1728,6 +1875,15 @@ type ListVPCsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListVPCsParams) Copy() *ListVPCsParams {
+	c := &ListVPCsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListVPCsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2177,6 +2333,15 @@ type RestartVPCParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *RestartVPCParams) Copy() *RestartVPCParams {
+	c := &RestartVPCParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *RestartVPCParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2390,6 +2555,15 @@ type UpdateVPCParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdateVPCParams) Copy() *UpdateVPCParams {
+	c := &UpdateVPCParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateVPCParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2622,6 +2796,15 @@ type UpdateVPCOfferingParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdateVPCOfferingParams) Copy() *UpdateVPCOfferingParams {
+	c := &UpdateVPCOfferingParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateVPCOfferingParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
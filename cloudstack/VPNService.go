@@ -27,10 +27,69 @@ import (
 	"strings"
 )
 
+type VPNServiceIface interface {
+	NewAddVpnUserParams(password string, username string) *AddVpnUserParams
+	AddVpnUser(p *AddVpnUserParams) (*AddVpnUserResponse, error)
+	NewCreateRemoteAccessVpnParams(publicipid string) *CreateRemoteAccessVpnParams
+	CreateRemoteAccessVpn(p *CreateRemoteAccessVpnParams) (*CreateRemoteAccessVpnResponse, error)
+	NewCreateVpnConnectionParams(s2scustomergatewayid string, s2svpngatewayid string) *CreateVpnConnectionParams
+	CreateVpnConnection(p *CreateVpnConnectionParams) (*CreateVpnConnectionResponse, error)
+	NewCreateVpnCustomerGatewayParams(cidrlist string, esppolicy string, gateway string, ikepolicy string, ipsecpsk string) *CreateVpnCustomerGatewayParams
+	CreateVpnCustomerGateway(p *CreateVpnCustomerGatewayParams) (*CreateVpnCustomerGatewayResponse, error)
+	NewCreateVpnGatewayParams(vpcid string) *CreateVpnGatewayParams
+	CreateVpnGateway(p *CreateVpnGatewayParams) (*CreateVpnGatewayResponse, error)
+	NewDeleteRemoteAccessVpnParams(publicipid string) *DeleteRemoteAccessVpnParams
+	DeleteRemoteAccessVpn(p *DeleteRemoteAccessVpnParams) (*DeleteRemoteAccessVpnResponse, error)
+	NewDeleteVpnConnectionParams(id string) *DeleteVpnConnectionParams
+	DeleteVpnConnection(p *DeleteVpnConnectionParams) (*DeleteVpnConnectionResponse, error)
+	NewDeleteVpnCustomerGatewayParams(id string) *DeleteVpnCustomerGatewayParams
+	DeleteVpnCustomerGateway(p *DeleteVpnCustomerGatewayParams) (*DeleteVpnCustomerGatewayResponse, error)
+	NewDeleteVpnGatewayParams(id string) *DeleteVpnGatewayParams
+	DeleteVpnGateway(p *DeleteVpnGatewayParams) (*DeleteVpnGatewayResponse, error)
+	NewListRemoteAccessVpnsParams() *ListRemoteAccessVpnsParams
+	GetRemoteAccessVpnByID(id string, opts ...OptionFunc) (*RemoteAccessVpn, int, error)
+	ListRemoteAccessVpns(p *ListRemoteAccessVpnsParams) (*ListRemoteAccessVpnsResponse, error)
+	NewListVpnConnectionsParams() *ListVpnConnectionsParams
+	GetVpnConnectionByID(id string, opts ...OptionFunc) (*VpnConnection, int, error)
+	ListVpnConnections(p *ListVpnConnectionsParams) (*ListVpnConnectionsResponse, error)
+	NewListVpnCustomerGatewaysParams() *ListVpnCustomerGatewaysParams
+	GetVpnCustomerGatewayID(keyword string, opts ...OptionFunc) (string, int, error)
+	GetVpnCustomerGatewayByName(name string, opts ...OptionFunc) (*VpnCustomerGateway, int, error)
+	GetVpnCustomerGatewayByID(id string, opts ...OptionFunc) (*VpnCustomerGateway, int, error)
+	ListVpnCustomerGateways(p *ListVpnCustomerGatewaysParams) (*ListVpnCustomerGatewaysResponse, error)
+	NewListVpnGatewaysParams() *ListVpnGatewaysParams
+	GetVpnGatewayByID(id string, opts ...OptionFunc) (*VpnGateway, int, error)
+	ListVpnGateways(p *ListVpnGatewaysParams) (*ListVpnGatewaysResponse, error)
+	NewListVpnUsersParams() *ListVpnUsersParams
+	GetVpnUserByID(id string, opts ...OptionFunc) (*VpnUser, int, error)
+	ListVpnUsers(p *ListVpnUsersParams) (*ListVpnUsersResponse, error)
+	NewRemoveVpnUserParams(username string) *RemoveVpnUserParams
+	RemoveVpnUser(p *RemoveVpnUserParams) (*RemoveVpnUserResponse, error)
+	NewResetVpnConnectionParams(id string) *ResetVpnConnectionParams
+	ResetVpnConnection(p *ResetVpnConnectionParams) (*ResetVpnConnectionResponse, error)
+	NewUpdateRemoteAccessVpnParams(id string) *UpdateRemoteAccessVpnParams
+	UpdateRemoteAccessVpn(p *UpdateRemoteAccessVpnParams) (*UpdateRemoteAccessVpnResponse, error)
+	NewUpdateVpnConnectionParams(id string) *UpdateVpnConnectionParams
+	UpdateVpnConnection(p *UpdateVpnConnectionParams) (*UpdateVpnConnectionResponse, error)
+	NewUpdateVpnCustomerGatewayParams(cidrlist string, esppolicy string, gateway string, id string, ikepolicy string, ipsecpsk string) *UpdateVpnCustomerGatewayParams
+	UpdateVpnCustomerGateway(p *UpdateVpnCustomerGatewayParams) (*UpdateVpnCustomerGatewayResponse, error)
+	NewUpdateVpnGatewayParams(id string) *UpdateVpnGatewayParams
+	UpdateVpnGateway(p *UpdateVpnGatewayParams) (*UpdateVpnGatewayResponse, error)
+}
+
 type AddVpnUserParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *AddVpnUserParams) Copy() *AddVpnUserParams {
+	c := &AddVpnUserParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddVpnUserParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -151,6 +210,15 @@ type CreateRemoteAccessVpnParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CreateRemoteAccessVpnParams) Copy() *CreateRemoteAccessVpnParams {
+	c := &CreateRemoteAccessVpnParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateRemoteAccessVpnParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -286,6 +354,15 @@ type CreateVpnConnectionParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CreateVpnConnectionParams) Copy() *CreateVpnConnectionParams {
+	c := &CreateVpnConnectionParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateVpnConnectionParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -413,6 +490,15 @@ type CreateVpnCustomerGatewayParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CreateVpnCustomerGatewayParams) Copy() *CreateVpnCustomerGatewayParams {
+	c := &CreateVpnCustomerGatewayParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateVpnCustomerGatewayParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -630,6 +716,15 @@ type CreateVpnGatewayParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CreateVpnGatewayParams) Copy() *CreateVpnGatewayParams {
+	c := &CreateVpnGatewayParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateVpnGatewayParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -722,6 +817,15 @@ type DeleteRemoteAccessVpnParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteRemoteAccessVpnParams) Copy() *DeleteRemoteAccessVpnParams {
+	c := &DeleteRemoteAccessVpnParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteRemoteAccessVpnParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -790,6 +894,15 @@ type DeleteVpnConnectionParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteVpnConnectionParams) Copy() *DeleteVpnConnectionParams {
+	c := &DeleteVpnConnectionParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteVpnConnectionParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -858,6 +971,15 @@ type DeleteVpnCustomerGatewayParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteVpnCustomerGatewayParams) Copy() *DeleteVpnCustomerGatewayParams {
+	c := &DeleteVpnCustomerGatewayParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteVpnCustomerGatewayParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -926,6 +1048,15 @@ type DeleteVpnGatewayParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteVpnGatewayParams) Copy() *DeleteVpnGatewayParams {
+	c := &DeleteVpnGatewayParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteVpnGatewayParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -994,6 +1125,15 @@ type ListRemoteAccessVpnsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListRemoteAccessVpnsParams) Copy() *ListRemoteAccessVpnsParams {
+	c := &ListRemoteAccessVpnsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListRemoteAccessVpnsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1209,6 +1349,15 @@ type ListVpnConnectionsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListVpnConnectionsParams) Copy() *ListVpnConnectionsParams {
+	c := &ListVpnConnectionsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListVpnConnectionsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1425,6 +1574,15 @@ type ListVpnCustomerGatewaysParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListVpnCustomerGatewaysParams) Copy() *ListVpnCustomerGatewaysParams {
+	c := &ListVpnCustomerGatewaysParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListVpnCustomerGatewaysParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1665,6 +1823,15 @@ type ListVpnGatewaysParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListVpnGatewaysParams) Copy() *ListVpnGatewaysParams {
+	c := &ListVpnGatewaysParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListVpnGatewaysParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1868,6 +2035,15 @@ type ListVpnUsersParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListVpnUsersParams) Copy() *ListVpnUsersParams {
+	c := &ListVpnUsersParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListVpnUsersParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2058,6 +2234,15 @@ type RemoveVpnUserParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *RemoveVpnUserParams) Copy() *RemoveVpnUserParams {
+	c := &RemoveVpnUserParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *RemoveVpnUserParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2156,6 +2341,15 @@ type ResetVpnConnectionParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ResetVpnConnectionParams) Copy() *ResetVpnConnectionParams {
+	c := &ResetVpnConnectionParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ResetVpnConnectionParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2270,6 +2464,15 @@ type UpdateRemoteAccessVpnParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdateRemoteAccessVpnParams) Copy() *UpdateRemoteAccessVpnParams {
+	c := &UpdateRemoteAccessVpnParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateRemoteAccessVpnParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2374,6 +2577,15 @@ type UpdateVpnConnectionParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdateVpnConnectionParams) Copy() *UpdateVpnConnectionParams {
+	c := &UpdateVpnConnectionParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateVpnConnectionParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2489,6 +2701,15 @@ type UpdateVpnCustomerGatewayParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdateVpnCustomerGatewayParams) Copy() *UpdateVpnCustomerGatewayParams {
+	c := &UpdateVpnCustomerGatewayParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateVpnCustomerGatewayParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2707,6 +2928,15 @@ type UpdateVpnGatewayParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdateVpnGatewayParams) Copy() *UpdateVpnGatewayParams {
+	c := &UpdateVpnGatewayParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateVpnGatewayParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
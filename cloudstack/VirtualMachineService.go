@@ -27,10 +27,72 @@ import (
 	"strings"
 )
 
+type VirtualMachineServiceIface interface {
+	NewAddNicToVirtualMachineParams(networkid string, virtualmachineid string) *AddNicToVirtualMachineParams
+	AddNicToVirtualMachine(p *AddNicToVirtualMachineParams) (*AddNicToVirtualMachineResponse, error)
+	NewAssignVirtualMachineParams(virtualmachineid string) *AssignVirtualMachineParams
+	AssignVirtualMachine(p *AssignVirtualMachineParams) (*AssignVirtualMachineResponse, error)
+	NewChangeServiceForVirtualMachineParams(id string, serviceofferingid string) *ChangeServiceForVirtualMachineParams
+	ChangeServiceForVirtualMachine(p *ChangeServiceForVirtualMachineParams) (*ChangeServiceForVirtualMachineResponse, error)
+	NewCleanVMReservationsParams() *CleanVMReservationsParams
+	CleanVMReservations(p *CleanVMReservationsParams) (*CleanVMReservationsResponse, error)
+	NewDeployVirtualMachineParams(serviceofferingid string, templateid string, zoneid string) *DeployVirtualMachineParams
+	DeployVirtualMachine(p *DeployVirtualMachineParams) (*DeployVirtualMachineResponse, error)
+	NewDestroyVirtualMachineParams(id string) *DestroyVirtualMachineParams
+	DestroyVirtualMachine(p *DestroyVirtualMachineParams) (*DestroyVirtualMachineResponse, error)
+	NewExpungeVirtualMachineParams(id string) *ExpungeVirtualMachineParams
+	ExpungeVirtualMachine(p *ExpungeVirtualMachineParams) (*ExpungeVirtualMachineResponse, error)
+	NewGetVMPasswordParams(id string) *GetVMPasswordParams
+	GetVMPassword(p *GetVMPasswordParams) (*GetVMPasswordResponse, error)
+	NewListVirtualMachinesParams() *ListVirtualMachinesParams
+	GetVirtualMachineID(name string, opts ...OptionFunc) (string, int, error)
+	GetVirtualMachineByName(name string, opts ...OptionFunc) (*VirtualMachine, int, error)
+	GetVirtualMachineByID(id string, opts ...OptionFunc) (*VirtualMachine, int, error)
+	ListVirtualMachines(p *ListVirtualMachinesParams) (*ListVirtualMachinesResponse, error)
+	NewListVirtualMachinesMetricsParams() *ListVirtualMachinesMetricsParams
+	GetVirtualMachinesMetricID(name string, opts ...OptionFunc) (string, int, error)
+	GetVirtualMachinesMetricByName(name string, opts ...OptionFunc) (*VirtualMachinesMetric, int, error)
+	GetVirtualMachinesMetricByID(id string, opts ...OptionFunc) (*VirtualMachinesMetric, int, error)
+	ListVirtualMachinesMetrics(p *ListVirtualMachinesMetricsParams) (*ListVirtualMachinesMetricsResponse, error)
+	NewMigrateVirtualMachineParams(virtualmachineid string) *MigrateVirtualMachineParams
+	MigrateVirtualMachine(p *MigrateVirtualMachineParams) (*MigrateVirtualMachineResponse, error)
+	NewMigrateVirtualMachineWithVolumeParams(hostid string, virtualmachineid string) *MigrateVirtualMachineWithVolumeParams
+	MigrateVirtualMachineWithVolume(p *MigrateVirtualMachineWithVolumeParams) (*MigrateVirtualMachineWithVolumeResponse, error)
+	NewRebootVirtualMachineParams(id string) *RebootVirtualMachineParams
+	RebootVirtualMachine(p *RebootVirtualMachineParams) (*RebootVirtualMachineResponse, error)
+	NewRecoverVirtualMachineParams(id string) *RecoverVirtualMachineParams
+	RecoverVirtualMachine(p *RecoverVirtualMachineParams) (*RecoverVirtualMachineResponse, error)
+	NewRemoveNicFromVirtualMachineParams(nicid string, virtualmachineid string) *RemoveNicFromVirtualMachineParams
+	RemoveNicFromVirtualMachine(p *RemoveNicFromVirtualMachineParams) (*RemoveNicFromVirtualMachineResponse, error)
+	NewResetPasswordForVirtualMachineParams(id string) *ResetPasswordForVirtualMachineParams
+	ResetPasswordForVirtualMachine(p *ResetPasswordForVirtualMachineParams) (*ResetPasswordForVirtualMachineResponse, error)
+	NewRestoreVirtualMachineParams(virtualmachineid string) *RestoreVirtualMachineParams
+	RestoreVirtualMachine(p *RestoreVirtualMachineParams) (*RestoreVirtualMachineResponse, error)
+	NewScaleVirtualMachineParams(id string, serviceofferingid string) *ScaleVirtualMachineParams
+	ScaleVirtualMachine(p *ScaleVirtualMachineParams) (*ScaleVirtualMachineResponse, error)
+	NewStartVirtualMachineParams(id string) *StartVirtualMachineParams
+	StartVirtualMachine(p *StartVirtualMachineParams) (*StartVirtualMachineResponse, error)
+	NewStopVirtualMachineParams(id string) *StopVirtualMachineParams
+	StopVirtualMachine(p *StopVirtualMachineParams) (*StopVirtualMachineResponse, error)
+	NewUpdateDefaultNicForVirtualMachineParams(nicid string, virtualmachineid string) *UpdateDefaultNicForVirtualMachineParams
+	UpdateDefaultNicForVirtualMachine(p *UpdateDefaultNicForVirtualMachineParams) (*UpdateDefaultNicForVirtualMachineResponse, error)
+	NewUpdateVirtualMachineParams(id string) *UpdateVirtualMachineParams
+	UpdateVirtualMachine(p *UpdateVirtualMachineParams) (*UpdateVirtualMachineResponse, error)
+}
+
 type AddNicToVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *AddNicToVirtualMachineParams) Copy() *AddNicToVirtualMachineParams {
+	c := &AddNicToVirtualMachineParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AddNicToVirtualMachineParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -279,6 +341,15 @@ type AssignVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *AssignVirtualMachineParams) Copy() *AssignVirtualMachineParams {
+	c := &AssignVirtualMachineParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AssignVirtualMachineParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -514,6 +585,15 @@ type ChangeServiceForVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ChangeServiceForVirtualMachineParams) Copy() *ChangeServiceForVirtualMachineParams {
+	c := &ChangeServiceForVirtualMachineParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ChangeServiceForVirtualMachineParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -721,6 +801,15 @@ type CleanVMReservationsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CleanVMReservationsParams) Copy() *CleanVMReservationsParams {
+	c := &CleanVMReservationsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CleanVMReservationsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -778,6 +867,15 @@ type DeployVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeployVirtualMachineParams) Copy() *DeployVirtualMachineParams {
+	c := &DeployVirtualMachineParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeployVirtualMachineParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -798,10 +896,14 @@ func (p *DeployVirtualMachineParams) toURLValues() url.Values {
 		u.Set("customid", v.(string))
 	}
 	if v, found := p.p["datadiskofferinglist"]; found {
-		m := v.(map[string]string)
-		for i, k := range getSortedKeysFromMap(m) {
-			u.Set(fmt.Sprintf("datadiskofferinglist[%d].key", i), k)
-			u.Set(fmt.Sprintf("datadiskofferinglist[%d].value", i), m[k])
+		l := v.([]DataDiskOffering)
+		for i, e := range l {
+			if e.DataDiskTemplateID != "" {
+				u.Set(fmt.Sprintf("datadiskofferinglist[%d].datadisktemplateid", i), e.DataDiskTemplateID)
+			}
+			if e.DiskOfferingID != "" {
+				u.Set(fmt.Sprintf("datadiskofferinglist[%d].diskofferingid", i), e.DiskOfferingID)
+			}
 		}
 	}
 	if v, found := p.p["deploymentplanner"]; found {
@@ -814,10 +916,14 @@ func (p *DeployVirtualMachineParams) toURLValues() url.Values {
 		}
 	}
 	if v, found := p.p["dhcpoptionsnetworklist"]; found {
-		m := v.(map[string]string)
-		for i, k := range getSortedKeysFromMap(m) {
-			u.Set(fmt.Sprintf("dhcpoptionsnetworklist[%d].key", i), k)
-			u.Set(fmt.Sprintf("dhcpoptionsnetworklist[%d].value", i), m[k])
+		l := v.([]DHCPOptionsNetwork)
+		for i, e := range l {
+			if e.NetworkID != "" {
+				u.Set(fmt.Sprintf("dhcpoptionsnetworklist[%d].networkid", i), e.NetworkID)
+			}
+			for _, k := range getSortedKeysFromMap(e.Options) {
+				u.Set(fmt.Sprintf("dhcpoptionsnetworklist[%d].%s", i, k), e.Options[k])
+			}
 		}
 	}
 	if v, found := p.p["diskofferingid"]; found {
@@ -852,10 +958,20 @@ func (p *DeployVirtualMachineParams) toURLValues() url.Values {
 		u.Set("ipaddress", v.(string))
 	}
 	if v, found := p.p["iptonetworklist"]; found {
-		m := v.(map[string]string)
-		for i, k := range getSortedKeysFromMap(m) {
-			u.Set(fmt.Sprintf("iptonetworklist[%d].key", i), k)
-			u.Set(fmt.Sprintf("iptonetworklist[%d].value", i), m[k])
+		l := v.([]IPToNetwork)
+		for i, e := range l {
+			if e.NetworkID != "" {
+				u.Set(fmt.Sprintf("iptonetworklist[%d].networkid", i), e.NetworkID)
+			}
+			if e.IP != "" {
+				u.Set(fmt.Sprintf("iptonetworklist[%d].ip", i), e.IP)
+			}
+			if e.IPv6 != "" {
+				u.Set(fmt.Sprintf("iptonetworklist[%d].ipv6", i), e.IPv6)
+			}
+			if e.MAC != "" {
+				u.Set(fmt.Sprintf("iptonetworklist[%d].mac", i), e.MAC)
+			}
 		}
 	}
 	if v, found := p.p["keyboard"]; found {
@@ -940,7 +1056,7 @@ func (p *DeployVirtualMachineParams) SetCustomid(v string) {
 	p.p["customid"] = v
 }
 
-func (p *DeployVirtualMachineParams) SetDatadiskofferinglist(v map[string]string) {
+func (p *DeployVirtualMachineParams) SetDatadiskofferinglist(v []DataDiskOffering) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
@@ -961,7 +1077,7 @@ func (p *DeployVirtualMachineParams) SetDetails(v map[string]string) {
 	p.p["details"] = v
 }
 
-func (p *DeployVirtualMachineParams) SetDhcpoptionsnetworklist(v map[string]string) {
+func (p *DeployVirtualMachineParams) SetDhcpoptionsnetworklist(v []DHCPOptionsNetwork) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
@@ -1038,7 +1154,7 @@ func (p *DeployVirtualMachineParams) SetIpaddress(v string) {
 	p.p["ipaddress"] = v
 }
 
-func (p *DeployVirtualMachineParams) SetIptonetworklist(v map[string]string) {
+func (p *DeployVirtualMachineParams) SetIptonetworklist(v []IPToNetwork) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
@@ -1337,6 +1453,15 @@ type DestroyVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DestroyVirtualMachineParams) Copy() *DestroyVirtualMachineParams {
+	c := &DestroyVirtualMachineParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DestroyVirtualMachineParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1562,6 +1687,15 @@ type ExpungeVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ExpungeVirtualMachineParams) Copy() *ExpungeVirtualMachineParams {
+	c := &ExpungeVirtualMachineParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ExpungeVirtualMachineParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1630,6 +1764,15 @@ type GetVMPasswordParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *GetVMPasswordParams) Copy() *GetVMPasswordParams {
+	c := &GetVMPasswordParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *GetVMPasswordParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1682,6 +1825,15 @@ type ListVirtualMachinesParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListVirtualMachinesParams) Copy() *ListVirtualMachinesParams {
+	c := &ListVirtualMachinesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListVirtualMachinesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2254,6 +2406,15 @@ type ListVirtualMachinesMetricsParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListVirtualMachinesMetricsParams) Copy() *ListVirtualMachinesMetricsParams {
+	c := &ListVirtualMachinesMetricsParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListVirtualMachinesMetricsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2843,6 +3004,15 @@ type MigrateVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *MigrateVirtualMachineParams) Copy() *MigrateVirtualMachineParams {
+	c := &MigrateVirtualMachineParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *MigrateVirtualMachineParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -3066,6 +3236,15 @@ type MigrateVirtualMachineWithVolumeParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *MigrateVirtualMachineWithVolumeParams) Copy() *MigrateVirtualMachineWithVolumeParams {
+	c := &MigrateVirtualMachineWithVolumeParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *MigrateVirtualMachineWithVolumeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -3294,6 +3473,15 @@ type RebootVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *RebootVirtualMachineParams) Copy() *RebootVirtualMachineParams {
+	c := &RebootVirtualMachineParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *RebootVirtualMachineParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -3497,6 +3685,15 @@ type RecoverVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *RecoverVirtualMachineParams) Copy() *RecoverVirtualMachineParams {
+	c := &RecoverVirtualMachineParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *RecoverVirtualMachineParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -3680,6 +3877,15 @@ type RemoveNicFromVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *RemoveNicFromVirtualMachineParams) Copy() *RemoveNicFromVirtualMachineParams {
+	c := &RemoveNicFromVirtualMachineParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *RemoveNicFromVirtualMachineParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -3894,6 +4100,15 @@ type ResetPasswordForVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ResetPasswordForVirtualMachineParams) Copy() *ResetPasswordForVirtualMachineParams {
+	c := &ResetPasswordForVirtualMachineParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ResetPasswordForVirtualMachineParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -4097,6 +4312,15 @@ type RestoreVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *RestoreVirtualMachineParams) Copy() *RestoreVirtualMachineParams {
+	c := &RestoreVirtualMachineParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *RestoreVirtualMachineParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -4310,6 +4534,15 @@ type ScaleVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ScaleVirtualMachineParams) Copy() *ScaleVirtualMachineParams {
+	c := &ScaleVirtualMachineParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ScaleVirtualMachineParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -4402,6 +4635,15 @@ type StartVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *StartVirtualMachineParams) Copy() *StartVirtualMachineParams {
+	c := &StartVirtualMachineParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *StartVirtualMachineParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -4625,6 +4867,15 @@ type StopVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *StopVirtualMachineParams) Copy() *StopVirtualMachineParams {
+	c := &StopVirtualMachineParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *StopVirtualMachineParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -4839,6 +5090,15 @@ type UpdateDefaultNicForVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdateDefaultNicForVirtualMachineParams) Copy() *UpdateDefaultNicForVirtualMachineParams {
+	c := &UpdateDefaultNicForVirtualMachineParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateDefaultNicForVirtualMachineParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -5053,6 +5313,15 @@ type UpdateVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdateVirtualMachineParams) Copy() *UpdateVirtualMachineParams {
+	c := &UpdateVirtualMachineParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateVirtualMachineParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -5072,10 +5341,14 @@ func (p *UpdateVirtualMachineParams) toURLValues() url.Values {
 		}
 	}
 	if v, found := p.p["dhcpoptionsnetworklist"]; found {
-		m := v.(map[string]string)
-		for i, k := range getSortedKeysFromMap(m) {
-			u.Set(fmt.Sprintf("dhcpoptionsnetworklist[%d].key", i), k)
-			u.Set(fmt.Sprintf("dhcpoptionsnetworklist[%d].value", i), m[k])
+		l := v.([]DHCPOptionsNetwork)
+		for i, e := range l {
+			if e.NetworkID != "" {
+				u.Set(fmt.Sprintf("dhcpoptionsnetworklist[%d].networkid", i), e.NetworkID)
+			}
+			for _, k := range getSortedKeysFromMap(e.Options) {
+				u.Set(fmt.Sprintf("dhcpoptionsnetworklist[%d].%s", i, k), e.Options[k])
+			}
 		}
 	}
 	if v, found := p.p["displayname"]; found {
@@ -5146,7 +5419,7 @@ func (p *UpdateVirtualMachineParams) SetDetails(v map[string]string) {
 	p.p["details"] = v
 }
 
-func (p *UpdateVirtualMachineParams) SetDhcpoptionsnetworklist(v map[string]string) {
+func (p *UpdateVirtualMachineParams) SetDhcpoptionsnetworklist(v []DHCPOptionsNetwork) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
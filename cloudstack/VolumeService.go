@@ -27,10 +27,53 @@ import (
 	"strings"
 )
 
+type VolumeServiceIface interface {
+	NewAttachVolumeParams(id string, virtualmachineid string) *AttachVolumeParams
+	AttachVolume(p *AttachVolumeParams) (*AttachVolumeResponse, error)
+	NewCreateVolumeParams() *CreateVolumeParams
+	CreateVolume(p *CreateVolumeParams) (*CreateVolumeResponse, error)
+	NewDeleteVolumeParams(id string) *DeleteVolumeParams
+	DeleteVolume(p *DeleteVolumeParams) (*DeleteVolumeResponse, error)
+	NewDetachVolumeParams() *DetachVolumeParams
+	DetachVolume(p *DetachVolumeParams) (*DetachVolumeResponse, error)
+	NewExtractVolumeParams(id string, mode string, zoneid string) *ExtractVolumeParams
+	ExtractVolume(p *ExtractVolumeParams) (*ExtractVolumeResponse, error)
+	NewGetPathForVolumeParams(volumeid string) *GetPathForVolumeParams
+	GetPathForVolume(p *GetPathForVolumeParams) (*GetPathForVolumeResponse, error)
+	NewGetSolidFireVolumeSizeParams(volumeid string) *GetSolidFireVolumeSizeParams
+	GetSolidFireVolumeSize(p *GetSolidFireVolumeSizeParams) (*GetSolidFireVolumeSizeResponse, error)
+	NewGetUploadParamsForVolumeParams(format string, name string, zoneid string) *GetUploadParamsForVolumeParams
+	GetUploadParamsForVolume(p *GetUploadParamsForVolumeParams) (*GetUploadParamsForVolumeResponse, error)
+	NewGetVolumeiScsiNameParams(volumeid string) *GetVolumeiScsiNameParams
+	GetVolumeiScsiName(p *GetVolumeiScsiNameParams) (*GetVolumeiScsiNameResponse, error)
+	NewListVolumesParams() *ListVolumesParams
+	GetVolumeID(name string, opts ...OptionFunc) (string, int, error)
+	GetVolumeByName(name string, opts ...OptionFunc) (*Volume, int, error)
+	GetVolumeByID(id string, opts ...OptionFunc) (*Volume, int, error)
+	ListVolumes(p *ListVolumesParams) (*ListVolumesResponse, error)
+	NewMigrateVolumeParams(storageid string, volumeid string) *MigrateVolumeParams
+	MigrateVolume(p *MigrateVolumeParams) (*MigrateVolumeResponse, error)
+	NewResizeVolumeParams(id string) *ResizeVolumeParams
+	ResizeVolume(p *ResizeVolumeParams) (*ResizeVolumeResponse, error)
+	NewUpdateVolumeParams() *UpdateVolumeParams
+	UpdateVolume(p *UpdateVolumeParams) (*UpdateVolumeResponse, error)
+	NewUploadVolumeParams(format string, name string, url string, zoneid string) *UploadVolumeParams
+	UploadVolume(p *UploadVolumeParams) (*UploadVolumeResponse, error)
+}
+
 type AttachVolumeParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *AttachVolumeParams) Copy() *AttachVolumeParams {
+	c := &AttachVolumeParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *AttachVolumeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -182,6 +225,15 @@ type CreateVolumeParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CreateVolumeParams) Copy() *CreateVolumeParams {
+	c := &CreateVolumeParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateVolumeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -434,6 +486,15 @@ type DeleteVolumeParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteVolumeParams) Copy() *DeleteVolumeParams {
+	c := &DeleteVolumeParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteVolumeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -514,6 +575,15 @@ type DetachVolumeParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DetachVolumeParams) Copy() *DetachVolumeParams {
+	c := &DetachVolumeParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DetachVolumeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -663,6 +733,15 @@ type ExtractVolumeParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ExtractVolumeParams) Copy() *ExtractVolumeParams {
+	c := &ExtractVolumeParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ExtractVolumeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -780,6 +859,15 @@ type GetPathForVolumeParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *GetPathForVolumeParams) Copy() *GetPathForVolumeParams {
+	c := &GetPathForVolumeParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *GetPathForVolumeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -832,6 +920,15 @@ type GetSolidFireVolumeSizeParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *GetSolidFireVolumeSizeParams) Copy() *GetSolidFireVolumeSizeParams {
+	c := &GetSolidFireVolumeSizeParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *GetSolidFireVolumeSizeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -884,6 +981,15 @@ type GetUploadParamsForVolumeParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *GetUploadParamsForVolumeParams) Copy() *GetUploadParamsForVolumeParams {
+	c := &GetUploadParamsForVolumeParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *GetUploadParamsForVolumeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1000,6 +1106,10 @@ func (s *VolumeService) GetUploadParamsForVolume(p *GetUploadParamsForVolumePara
 		return nil, err
 	}
 
+	if resp, err = getRawValue(resp); err != nil {
+		return nil, err
+	}
+
 	var r GetUploadParamsForVolumeResponse
 	if err := json.Unmarshal(resp, &r); err != nil {
 		return nil, err
@@ -1022,6 +1132,15 @@ type GetVolumeiScsiNameParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *GetVolumeiScsiNameParams) Copy() *GetVolumeiScsiNameParams {
+	c := &GetVolumeiScsiNameParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *GetVolumeiScsiNameParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1074,6 +1193,15 @@ type ListVolumesParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListVolumesParams) Copy() *ListVolumesParams {
+	c := &ListVolumesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListVolumesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1480,6 +1608,15 @@ type MigrateVolumeParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *MigrateVolumeParams) Copy() *MigrateVolumeParams {
+	c := &MigrateVolumeParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *MigrateVolumeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1641,6 +1778,15 @@ type ResizeVolumeParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ResizeVolumeParams) Copy() *ResizeVolumeParams {
+	c := &ResizeVolumeParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ResizeVolumeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1824,6 +1970,15 @@ type UpdateVolumeParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdateVolumeParams) Copy() *UpdateVolumeParams {
+	c := &UpdateVolumeParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateVolumeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2013,6 +2168,15 @@ type UploadVolumeParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UploadVolumeParams) Copy() *UploadVolumeParams {
+	c := &UploadVolumeParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UploadVolumeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -27,10 +27,43 @@ import (
 	"strings"
 )
 
+type ZoneServiceIface interface {
+	NewCreateZoneParams(dns1 string, internaldns1 string, name string, networktype string) *CreateZoneParams
+	CreateZone(p *CreateZoneParams) (*CreateZoneResponse, error)
+	NewDedicateZoneParams(domainid string, zoneid string) *DedicateZoneParams
+	DedicateZone(p *DedicateZoneParams) (*DedicateZoneResponse, error)
+	NewDeleteZoneParams(id string) *DeleteZoneParams
+	DeleteZone(p *DeleteZoneParams) (*DeleteZoneResponse, error)
+	NewDisableOutOfBandManagementForZoneParams(zoneid string) *DisableOutOfBandManagementForZoneParams
+	DisableOutOfBandManagementForZone(p *DisableOutOfBandManagementForZoneParams) (*DisableOutOfBandManagementForZoneResponse, error)
+	NewEnableOutOfBandManagementForZoneParams(zoneid string) *EnableOutOfBandManagementForZoneParams
+	EnableOutOfBandManagementForZone(p *EnableOutOfBandManagementForZoneParams) (*EnableOutOfBandManagementForZoneResponse, error)
+	NewListDedicatedZonesParams() *ListDedicatedZonesParams
+	ListDedicatedZones(p *ListDedicatedZonesParams) (*ListDedicatedZonesResponse, error)
+	NewListZonesParams() *ListZonesParams
+	GetZoneID(name string, opts ...OptionFunc) (string, int, error)
+	GetZoneByName(name string, opts ...OptionFunc) (*Zone, int, error)
+	GetZoneByID(id string, opts ...OptionFunc) (*Zone, int, error)
+	ListZones(p *ListZonesParams) (*ListZonesResponse, error)
+	NewReleaseDedicatedZoneParams(zoneid string) *ReleaseDedicatedZoneParams
+	ReleaseDedicatedZone(p *ReleaseDedicatedZoneParams) (*ReleaseDedicatedZoneResponse, error)
+	NewUpdateZoneParams(id string) *UpdateZoneParams
+	UpdateZone(p *UpdateZoneParams) (*UpdateZoneResponse, error)
+}
+
 type CreateZoneParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *CreateZoneParams) Copy() *CreateZoneParams {
+	c := &CreateZoneParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *CreateZoneParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -255,6 +288,15 @@ type DedicateZoneParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DedicateZoneParams) Copy() *DedicateZoneParams {
+	c := &DedicateZoneParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DedicateZoneParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -353,6 +395,15 @@ type DeleteZoneParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DeleteZoneParams) Copy() *DeleteZoneParams {
+	c := &DeleteZoneParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DeleteZoneParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -433,6 +484,15 @@ type DisableOutOfBandManagementForZoneParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *DisableOutOfBandManagementForZoneParams) Copy() *DisableOutOfBandManagementForZoneParams {
+	c := &DisableOutOfBandManagementForZoneParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *DisableOutOfBandManagementForZoneParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -515,6 +575,15 @@ type EnableOutOfBandManagementForZoneParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *EnableOutOfBandManagementForZoneParams) Copy() *EnableOutOfBandManagementForZoneParams {
+	c := &EnableOutOfBandManagementForZoneParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *EnableOutOfBandManagementForZoneParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -597,6 +666,15 @@ type ListDedicatedZonesParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListDedicatedZonesParams) Copy() *ListDedicatedZonesParams {
+	c := &ListDedicatedZonesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListDedicatedZonesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -720,6 +798,15 @@ type ListZonesParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ListZonesParams) Copy() *ListZonesParams {
+	c := &ListZonesParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ListZonesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -994,6 +1081,15 @@ type ReleaseDedicatedZoneParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *ReleaseDedicatedZoneParams) Copy() *ReleaseDedicatedZoneParams {
+	c := &ReleaseDedicatedZoneParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *ReleaseDedicatedZoneParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1062,6 +1158,15 @@ type UpdateZoneParams struct {
 	p map[string]interface{}
 }
 
+// Copy returns a copy of the parameters, which can be changed without changing p
+func (p *UpdateZoneParams) Copy() *UpdateZoneParams {
+	c := &UpdateZoneParams{p: make(map[string]interface{}, len(p.p))}
+	for k, v := range p.p {
+		c.p[k] = v
+	}
+	return c
+}
+
 func (p *UpdateZoneParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
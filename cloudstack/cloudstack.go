@@ -32,10 +32,12 @@ import (
 	"net"
 	"net/http"
 	"net/http/cookiejar"
+	"net/http/httptrace"
 	"net/url"
 	"regexp"
 	"sort"
 	"strings"
+	"sync/atomic"
 	"time"
 )
 
@@ -68,83 +70,83 @@ func (e *CSError) Error() error {
 type CloudStackClient struct {
 	HTTPGETOnly bool // If `true` only use HTTP GET calls
 
-	client  *http.Client // The http client for communicating
-	baseURL string       // The base URL of the API
-	apiKey  string       // Api key
-	secret  string       // Secret key
-	async   bool         // Wait for async calls to finish
-	options []OptionFunc // A list of option functions to apply to all API calls
-	timeout int64        // Max waiting timeout in seconds for async jobs to finish; defaults to 300 seconds
-
-	APIDiscovery        *APIDiscoveryService
-	Account             *AccountService
-	Address             *AddressService
-	AffinityGroup       *AffinityGroupService
-	Alert               *AlertService
-	Asyncjob            *AsyncjobService
-	Authentication      *AuthenticationService
-	AutoScale           *AutoScaleService
-	Baremetal           *BaremetalService
-	BigSwitchBCF        *BigSwitchBCFService
-	BrocadeVCS          *BrocadeVCSService
-	Certificate         *CertificateService
-	CloudIdentifier     *CloudIdentifierService
-	Cluster             *ClusterService
-	Configuration       *ConfigurationService
-	Custom              *CustomService
-	DiskOffering        *DiskOfferingService
-	Domain              *DomainService
-	Event               *EventService
-	Firewall            *FirewallService
-	GuestOS             *GuestOSService
-	Host                *HostService
-	Hypervisor          *HypervisorService
-	ISO                 *ISOService
-	ImageStore          *ImageStoreService
-	InternalLB          *InternalLBService
-	LDAP                *LDAPService
-	Limit               *LimitService
-	LoadBalancer        *LoadBalancerService
-	NAT                 *NATService
-	NetworkACL          *NetworkACLService
-	NetworkDevice       *NetworkDeviceService
-	NetworkOffering     *NetworkOfferingService
-	Network             *NetworkService
-	Nic                 *NicService
-	NiciraNVP           *NiciraNVPService
-	NuageVSP            *NuageVSPService
-	OutofbandManagement *OutofbandManagementService
-	OvsElement          *OvsElementService
-	Pod                 *PodService
-	Pool                *PoolService
-	PortableIP          *PortableIPService
-	Project             *ProjectService
-	Quota               *QuotaService
-	Region              *RegionService
-	Resourcemetadata    *ResourcemetadataService
-	Resourcetags        *ResourcetagsService
-	Role                *RoleService
-	Router              *RouterService
-	SSH                 *SSHService
-	SecurityGroup       *SecurityGroupService
-	ServiceOffering     *ServiceOfferingService
-	Snapshot            *SnapshotService
-	StoragePool         *StoragePoolService
-	StratosphereSSP     *StratosphereSSPService
-	Swift               *SwiftService
-	SystemCapacity      *SystemCapacityService
-	SystemVM            *SystemVMService
-	Template            *TemplateService
-	UCS                 *UCSService
-	Usage               *UsageService
-	User                *UserService
-	VLAN                *VLANService
-	VMGroup             *VMGroupService
-	VPC                 *VPCService
-	VPN                 *VPNService
-	VirtualMachine      *VirtualMachineService
-	Volume              *VolumeService
-	Zone                *ZoneService
+	client    *http.Client  // The http client for communicating
+	endpoints *endpointPool // The base URLs of the API (one per management server)
+	apiKey    string        // Api key
+	secret    string        // Secret key
+	async     bool          // Wait for async calls to finish
+	options   []OptionFunc  // A list of option functions to apply to all API calls
+	timeout   int64         // Max waiting timeout in seconds for async jobs to finish; defaults to 300 seconds
+
+	APIDiscovery        APIDiscoveryServiceIface
+	Account             AccountServiceIface
+	Address             AddressServiceIface
+	AffinityGroup       AffinityGroupServiceIface
+	Alert               AlertServiceIface
+	Asyncjob            AsyncjobServiceIface
+	Authentication      AuthenticationServiceIface
+	AutoScale           AutoScaleServiceIface
+	Baremetal           BaremetalServiceIface
+	BigSwitchBCF        BigSwitchBCFServiceIface
+	BrocadeVCS          BrocadeVCSServiceIface
+	Certificate         CertificateServiceIface
+	CloudIdentifier     CloudIdentifierServiceIface
+	Cluster             ClusterServiceIface
+	Configuration       ConfigurationServiceIface
+	Custom              CustomServiceIface
+	DiskOffering        DiskOfferingServiceIface
+	Domain              DomainServiceIface
+	Event               EventServiceIface
+	Firewall            FirewallServiceIface
+	GuestOS             GuestOSServiceIface
+	Host                HostServiceIface
+	Hypervisor          HypervisorServiceIface
+	ISO                 ISOServiceIface
+	ImageStore          ImageStoreServiceIface
+	InternalLB          InternalLBServiceIface
+	LDAP                LDAPServiceIface
+	Limit               LimitServiceIface
+	LoadBalancer        LoadBalancerServiceIface
+	NAT                 NATServiceIface
+	NetworkACL          NetworkACLServiceIface
+	NetworkDevice       NetworkDeviceServiceIface
+	NetworkOffering     NetworkOfferingServiceIface
+	Network             NetworkServiceIface
+	Nic                 NicServiceIface
+	NiciraNVP           NiciraNVPServiceIface
+	NuageVSP            NuageVSPServiceIface
+	OutofbandManagement OutofbandManagementServiceIface
+	OvsElement          OvsElementServiceIface
+	Pod                 PodServiceIface
+	Pool                PoolServiceIface
+	PortableIP          PortableIPServiceIface
+	Project             ProjectServiceIface
+	Quota               QuotaServiceIface
+	Region              RegionServiceIface
+	Resourcemetadata    ResourcemetadataServiceIface
+	Resourcetags        ResourcetagsServiceIface
+	Role                RoleServiceIface
+	Router              RouterServiceIface
+	SSH                 SSHServiceIface
+	SecurityGroup       SecurityGroupServiceIface
+	ServiceOffering     ServiceOfferingServiceIface
+	Snapshot            SnapshotServiceIface
+	StoragePool         StoragePoolServiceIface
+	StratosphereSSP     StratosphereSSPServiceIface
+	Swift               SwiftServiceIface
+	SystemCapacity      SystemCapacityServiceIface
+	SystemVM            SystemVMServiceIface
+	Template            TemplateServiceIface
+	UCS                 UCSServiceIface
+	Usage               UsageServiceIface
+	User                UserServiceIface
+	VLAN                VLANServiceIface
+	VMGroup             VMGroupServiceIface
+	VPC                 VPCServiceIface
+	VPN                 VPNServiceIface
+	VirtualMachine      VirtualMachineServiceIface
+	Volume              VolumeServiceIface
+	Zone                ZoneServiceIface
 }
 
 // Creates a new client for communicating with CloudStack
@@ -168,12 +170,12 @@ func newClient(apiurl string, apikey string, secret string, async bool, verifyss
 			},
 			Timeout: time.Duration(60 * time.Second),
 		},
-		baseURL: apiurl,
-		apiKey:  apikey,
-		secret:  secret,
-		async:   async,
-		options: []OptionFunc{},
-		timeout: 300,
+		endpoints: newEndpointPool(apiurl),
+		apiKey:    apikey,
+		secret:    secret,
+		async:     async,
+		options:   []OptionFunc{},
+		timeout:   300,
 	}
 
 	for _, fn := range options {
@@ -351,21 +353,58 @@ func (cs *CloudStackClient) newRequest(api string, params url.Values) (json.RawM
 
 	var err error
 	var resp *http.Response
-	if !cs.HTTPGETOnly && (api == "deployVirtualMachine" || api == "login" || api == "updateVirtualMachine") {
-		// The deployVirtualMachine API should be called using a POST call
-		// so we don't have to worry about the userdata size
+	for _, ep := range cs.endpoints.candidates() {
+		var req *http.Request
+		if !cs.HTTPGETOnly && (api == "deployVirtualMachine" || api == "login" || api == "updateVirtualMachine") {
+			// The deployVirtualMachine API should be called using a POST call
+			// so we don't have to worry about the userdata size
 
-		// Add the unescaped signature to the POST params
-		params.Set("signature", signature)
+			// Add the unescaped signature to the POST params
+			params.Set("signature", signature)
 
-		// Make a POST call
-		resp, err = cs.client.PostForm(cs.baseURL, params)
-	} else {
-		// Create the final URL before we issue the request
-		url := cs.baseURL + "?" + s + "&signature=" + url.QueryEscape(signature)
+			// Make a POST call
+			req, err = http.NewRequest("POST", ep.url, strings.NewReader(params.Encode()))
+			if err != nil {
+				return nil, err
+			}
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		} else {
+			// Create the final URL before we issue the request
+			url := ep.url + "?" + s + "&signature=" + url.QueryEscape(signature)
 
-		// Make a GET call
-		resp, err = cs.client.Get(url)
+			// Make a GET call
+			req, err = http.NewRequest("GET", url, nil)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		var sent int32
+		resp, err = cs.client.Do(req.WithContext(httptrace.WithClientTrace(req.Context(), &httptrace.ClientTrace{
+			WroteRequest: func(info httptrace.WroteRequestInfo) {
+				if info.Err == nil {
+					atomic.StoreInt32(&sent, 1)
+				}
+			},
+		})))
+		if err == nil {
+			cs.endpoints.markUp(ep)
+			break
+		}
+
+		// Only fail over to the next endpoint if the request was never sent, which
+		// covers DNS, dial and TLS handshake failures and timeouts while connecting
+		if atomic.LoadInt32(&sent) == 0 {
+			cs.endpoints.markDown(ep)
+			continue
+		}
+
+		// The endpoint may have received the request, so it must not be sent again.
+		// But an endpoint that does not answer in time should not get new requests.
+		if isTimeout(err) {
+			cs.endpoints.markDown(ep)
+		}
+		break
 	}
 	if err != nil {
 		return nil, err
@@ -565,11 +604,38 @@ func WithZone(zone string) OptionFunc {
 	}
 }
 
+// DHCPOptionsNetwork is a single entry of the dhcpoptionsnetworklist parameter
+type DHCPOptionsNetwork struct {
+	NetworkID string
+	Options   map[string]string
+}
+
+// DataDiskOffering is a single entry of the datadiskofferinglist parameter
+type DataDiskOffering struct {
+	DataDiskTemplateID string
+	DiskOfferingID     string
+}
+
+// IPToNetwork is a single entry of the iptonetworklist parameter
+type IPToNetwork struct {
+	NetworkID string
+	IP        string
+	IPv6      string
+	MAC       string
+}
+
+// ServiceCapability is a single entry of the servicecapabilitylist parameter
+type ServiceCapability struct {
+	Service         string
+	CapabilityType  string
+	CapabilityValue string
+}
+
 type APIDiscoveryService struct {
 	cs *CloudStackClient
 }
 
-func NewAPIDiscoveryService(cs *CloudStackClient) *APIDiscoveryService {
+func NewAPIDiscoveryService(cs *CloudStackClient) APIDiscoveryServiceIface {
 	return &APIDiscoveryService{cs: cs}
 }
 
@@ -577,7 +643,7 @@ type AccountService struct {
 	cs *CloudStackClient
 }
 
-func NewAccountService(cs *CloudStackClient) *AccountService {
+func NewAccountService(cs *CloudStackClient) AccountServiceIface {
 	return &AccountService{cs: cs}
 }
 
@@ -585,7 +651,7 @@ type AddressService struct {
 	cs *CloudStackClient
 }
 
-func NewAddressService(cs *CloudStackClient) *AddressService {
+func NewAddressService(cs *CloudStackClient) AddressServiceIface {
 	return &AddressService{cs: cs}
 }
 
@@ -593,7 +659,7 @@ type AffinityGroupService struct {
 	cs *CloudStackClient
 }
 
-func NewAffinityGroupService(cs *CloudStackClient) *AffinityGroupService {
+func NewAffinityGroupService(cs *CloudStackClient) AffinityGroupServiceIface {
 	return &AffinityGroupService{cs: cs}
 }
 
@@ -601,7 +667,7 @@ type AlertService struct {
 	cs *CloudStackClient
 }
 
-func NewAlertService(cs *CloudStackClient) *AlertService {
+func NewAlertService(cs *CloudStackClient) AlertServiceIface {
 	return &AlertService{cs: cs}
 }
 
@@ -609,7 +675,7 @@ type AsyncjobService struct {
 	cs *CloudStackClient
 }
 
-func NewAsyncjobService(cs *CloudStackClient) *AsyncjobService {
+func NewAsyncjobService(cs *CloudStackClient) AsyncjobServiceIface {
 	return &AsyncjobService{cs: cs}
 }
 
@@ -617,7 +683,7 @@ type AuthenticationService struct {
 	cs *CloudStackClient
 }
 
-func NewAuthenticationService(cs *CloudStackClient) *AuthenticationService {
+func NewAuthenticationService(cs *CloudStackClient) AuthenticationServiceIface {
 	return &AuthenticationService{cs: cs}
 }
 
@@ -625,7 +691,7 @@ type AutoScaleService struct {
 	cs *CloudStackClient
 }
 
-func NewAutoScaleService(cs *CloudStackClient) *AutoScaleService {
+func NewAutoScaleService(cs *CloudStackClient) AutoScaleServiceIface {
 	return &AutoScaleService{cs: cs}
 }
 
@@ -633,7 +699,7 @@ type BaremetalService struct {
 	cs *CloudStackClient
 }
 
-func NewBaremetalService(cs *CloudStackClient) *BaremetalService {
+func NewBaremetalService(cs *CloudStackClient) BaremetalServiceIface {
 	return &BaremetalService{cs: cs}
 }
 
@@ -641,7 +707,7 @@ type BigSwitchBCFService struct {
 	cs *CloudStackClient
 }
 
-func NewBigSwitchBCFService(cs *CloudStackClient) *BigSwitchBCFService {
+func NewBigSwitchBCFService(cs *CloudStackClient) BigSwitchBCFServiceIface {
 	return &BigSwitchBCFService{cs: cs}
 }
 
@@ -649,7 +715,7 @@ type BrocadeVCSService struct {
 	cs *CloudStackClient
 }
 
-func NewBrocadeVCSService(cs *CloudStackClient) *BrocadeVCSService {
+func NewBrocadeVCSService(cs *CloudStackClient) BrocadeVCSServiceIface {
 	return &BrocadeVCSService{cs: cs}
 }
 
@@ -657,7 +723,7 @@ type CertificateService struct {
 	cs *CloudStackClient
 }
 
-func NewCertificateService(cs *CloudStackClient) *CertificateService {
+func NewCertificateService(cs *CloudStackClient) CertificateServiceIface {
 	return &CertificateService{cs: cs}
 }
 
@@ -665,7 +731,7 @@ type CloudIdentifierService struct {
 	cs *CloudStackClient
 }
 
-func NewCloudIdentifierService(cs *CloudStackClient) *CloudIdentifierService {
+func NewCloudIdentifierService(cs *CloudStackClient) CloudIdentifierServiceIface {
 	return &CloudIdentifierService{cs: cs}
 }
 
@@ -673,7 +739,7 @@ type ClusterService struct {
 	cs *CloudStackClient
 }
 
-func NewClusterService(cs *CloudStackClient) *ClusterService {
+func NewClusterService(cs *CloudStackClient) ClusterServiceIface {
 	return &ClusterService{cs: cs}
 }
 
@@ -681,7 +747,7 @@ type ConfigurationService struct {
 	cs *CloudStackClient
 }
 
-func NewConfigurationService(cs *CloudStackClient) *ConfigurationService {
+func NewConfigurationService(cs *CloudStackClient) ConfigurationServiceIface {
 	return &ConfigurationService{cs: cs}
 }
 
@@ -689,7 +755,7 @@ type CustomService struct {
 	cs *CloudStackClient
 }
 
-func NewCustomService(cs *CloudStackClient) *CustomService {
+func NewCustomService(cs *CloudStackClient) CustomServiceIface {
 	return &CustomService{cs: cs}
 }
 
@@ -697,7 +763,7 @@ type DiskOfferingService struct {
 	cs *CloudStackClient
 }
 
-func NewDiskOfferingService(cs *CloudStackClient) *DiskOfferingService {
+func NewDiskOfferingService(cs *CloudStackClient) DiskOfferingServiceIface {
 	return &DiskOfferingService{cs: cs}
 }
 
@@ -705,7 +771,7 @@ type DomainService struct {
 	cs *CloudStackClient
 }
 
-func NewDomainService(cs *CloudStackClient) *DomainService {
+func NewDomainService(cs *CloudStackClient) DomainServiceIface {
 	return &DomainService{cs: cs}
 }
 
@@ -713,7 +779,7 @@ type EventService struct {
 	cs *CloudStackClient
 }
 
-func NewEventService(cs *CloudStackClient) *EventService {
+func NewEventService(cs *CloudStackClient) EventServiceIface {
 	return &EventService{cs: cs}
 }
 
@@ -721,7 +787,7 @@ type FirewallService struct {
 	cs *CloudStackClient
 }
 
-func NewFirewallService(cs *CloudStackClient) *FirewallService {
+func NewFirewallService(cs *CloudStackClient) FirewallServiceIface {
 	return &FirewallService{cs: cs}
 }
 
@@ -729,7 +795,7 @@ type GuestOSService struct {
 	cs *CloudStackClient
 }
 
-func NewGuestOSService(cs *CloudStackClient) *GuestOSService {
+func NewGuestOSService(cs *CloudStackClient) GuestOSServiceIface {
 	return &GuestOSService{cs: cs}
 }
 
@@ -737,7 +803,7 @@ type HostService struct {
 	cs *CloudStackClient
 }
 
-func NewHostService(cs *CloudStackClient) *HostService {
+func NewHostService(cs *CloudStackClient) HostServiceIface {
 	return &HostService{cs: cs}
 }
 
@@ -745,7 +811,7 @@ type HypervisorService struct {
 	cs *CloudStackClient
 }
 
-func NewHypervisorService(cs *CloudStackClient) *HypervisorService {
+func NewHypervisorService(cs *CloudStackClient) HypervisorServiceIface {
 	return &HypervisorService{cs: cs}
 }
 
@@ -753,7 +819,7 @@ type ISOService struct {
 	cs *CloudStackClient
 }
 
-func NewISOService(cs *CloudStackClient) *ISOService {
+func NewISOService(cs *CloudStackClient) ISOServiceIface {
 	return &ISOService{cs: cs}
 }
 
@@ -761,7 +827,7 @@ type ImageStoreService struct {
 	cs *CloudStackClient
 }
 
-func NewImageStoreService(cs *CloudStackClient) *ImageStoreService {
+func NewImageStoreService(cs *CloudStackClient) ImageStoreServiceIface {
 	return &ImageStoreService{cs: cs}
 }
 
@@ -769,7 +835,7 @@ type InternalLBService struct {
 	cs *CloudStackClient
 }
 
-func NewInternalLBService(cs *CloudStackClient) *InternalLBService {
+func NewInternalLBService(cs *CloudStackClient) InternalLBServiceIface {
 	return &InternalLBService{cs: cs}
 }
 
@@ -777,7 +843,7 @@ type LDAPService struct {
 	cs *CloudStackClient
 }
 
-func NewLDAPService(cs *CloudStackClient) *LDAPService {
+func NewLDAPService(cs *CloudStackClient) LDAPServiceIface {
 	return &LDAPService{cs: cs}
 }
 
@@ -785,7 +851,7 @@ type LimitService struct {
 	cs *CloudStackClient
 }
 
-func NewLimitService(cs *CloudStackClient) *LimitService {
+func NewLimitService(cs *CloudStackClient) LimitServiceIface {
 	return &LimitService{cs: cs}
 }
 
@@ -793,7 +859,7 @@ type LoadBalancerService struct {
 	cs *CloudStackClient
 }
 
-func NewLoadBalancerService(cs *CloudStackClient) *LoadBalancerService {
+func NewLoadBalancerService(cs *CloudStackClient) LoadBalancerServiceIface {
 	return &LoadBalancerService{cs: cs}
 }
 
@@ -801,7 +867,7 @@ type NATService struct {
 	cs *CloudStackClient
 }
 
-func NewNATService(cs *CloudStackClient) *NATService {
+func NewNATService(cs *CloudStackClient) NATServiceIface {
 	return &NATService{cs: cs}
 }
 
@@ -809,7 +875,7 @@ type NetworkACLService struct {
 	cs *CloudStackClient
 }
 
-func NewNetworkACLService(cs *CloudStackClient) *NetworkACLService {
+func NewNetworkACLService(cs *CloudStackClient) NetworkACLServiceIface {
 	return &NetworkACLService{cs: cs}
 }
 
@@ -817,7 +883,7 @@ type NetworkDeviceService struct {
 	cs *CloudStackClient
 }
 
-func NewNetworkDeviceService(cs *CloudStackClient) *NetworkDeviceService {
+func NewNetworkDeviceService(cs *CloudStackClient) NetworkDeviceServiceIface {
 	return &NetworkDeviceService{cs: cs}
 }
 
@@ -825,7 +891,7 @@ type NetworkOfferingService struct {
 	cs *CloudStackClient
 }
 
-func NewNetworkOfferingService(cs *CloudStackClient) *NetworkOfferingService {
+func NewNetworkOfferingService(cs *CloudStackClient) NetworkOfferingServiceIface {
 	return &NetworkOfferingService{cs: cs}
 }
 
@@ -833,7 +899,7 @@ type NetworkService struct {
 	cs *CloudStackClient
 }
 
-func NewNetworkService(cs *CloudStackClient) *NetworkService {
+func NewNetworkService(cs *CloudStackClient) NetworkServiceIface {
 	return &NetworkService{cs: cs}
 }
 
@@ -841,7 +907,7 @@ type NicService struct {
 	cs *CloudStackClient
 }
 
-func NewNicService(cs *CloudStackClient) *NicService {
+func NewNicService(cs *CloudStackClient) NicServiceIface {
 	return &NicService{cs: cs}
 }
 
@@ -849,7 +915,7 @@ type NiciraNVPService struct {
 	cs *CloudStackClient
 }
 
-func NewNiciraNVPService(cs *CloudStackClient) *NiciraNVPService {
+func NewNiciraNVPService(cs *CloudStackClient) NiciraNVPServiceIface {
 	return &NiciraNVPService{cs: cs}
 }
 
@@ -857,7 +923,7 @@ type NuageVSPService struct {
 	cs *CloudStackClient
 }
 
-func NewNuageVSPService(cs *CloudStackClient) *NuageVSPService {
+func NewNuageVSPService(cs *CloudStackClient) NuageVSPServiceIface {
 	return &NuageVSPService{cs: cs}
 }
 
@@ -865,7 +931,7 @@ type OutofbandManagementService struct {
 	cs *CloudStackClient
 }
 
-func NewOutofbandManagementService(cs *CloudStackClient) *OutofbandManagementService {
+func NewOutofbandManagementService(cs *CloudStackClient) OutofbandManagementServiceIface {
 	return &OutofbandManagementService{cs: cs}
 }
 
@@ -873,7 +939,7 @@ type OvsElementService struct {
 	cs *CloudStackClient
 }
 
-func NewOvsElementService(cs *CloudStackClient) *OvsElementService {
+func NewOvsElementService(cs *CloudStackClient) OvsElementServiceIface {
 	return &OvsElementService{cs: cs}
 }
 
@@ -881,7 +947,7 @@ type PodService struct {
 	cs *CloudStackClient
 }
 
-func NewPodService(cs *CloudStackClient) *PodService {
+func NewPodService(cs *CloudStackClient) PodServiceIface {
 	return &PodService{cs: cs}
 }
 
@@ -889,7 +955,7 @@ type PoolService struct {
 	cs *CloudStackClient
 }
 
-func NewPoolService(cs *CloudStackClient) *PoolService {
+func NewPoolService(cs *CloudStackClient) PoolServiceIface {
 	return &PoolService{cs: cs}
 }
 
@@ -897,7 +963,7 @@ type PortableIPService struct {
 	cs *CloudStackClient
 }
 
-func NewPortableIPService(cs *CloudStackClient) *PortableIPService {
+func NewPortableIPService(cs *CloudStackClient) PortableIPServiceIface {
 	return &PortableIPService{cs: cs}
 }
 
@@ -905,7 +971,7 @@ type ProjectService struct {
 	cs *CloudStackClient
 }
 
-func NewProjectService(cs *CloudStackClient) *ProjectService {
+func NewProjectService(cs *CloudStackClient) ProjectServiceIface {
 	return &ProjectService{cs: cs}
 }
 
@@ -913,7 +979,7 @@ type QuotaService struct {
 	cs *CloudStackClient
 }
 
-func NewQuotaService(cs *CloudStackClient) *QuotaService {
+func NewQuotaService(cs *CloudStackClient) QuotaServiceIface {
 	return &QuotaService{cs: cs}
 }
 
@@ -921,7 +987,7 @@ type RegionService struct {
 	cs *CloudStackClient
 }
 
-func NewRegionService(cs *CloudStackClient) *RegionService {
+func NewRegionService(cs *CloudStackClient) RegionServiceIface {
 	return &RegionService{cs: cs}
 }
 
@@ -929,7 +995,7 @@ type ResourcemetadataService struct {
 	cs *CloudStackClient
 }
 
-func NewResourcemetadataService(cs *CloudStackClient) *ResourcemetadataService {
+func NewResourcemetadataService(cs *CloudStackClient) ResourcemetadataServiceIface {
 	return &ResourcemetadataService{cs: cs}
 }
 
@@ -937,7 +1003,7 @@ type ResourcetagsService struct {
 	cs *CloudStackClient
 }
 
-func NewResourcetagsService(cs *CloudStackClient) *ResourcetagsService {
+func NewResourcetagsService(cs *CloudStackClient) ResourcetagsServiceIface {
 	return &ResourcetagsService{cs: cs}
 }
 
@@ -945,7 +1011,7 @@ type RoleService struct {
 	cs *CloudStackClient
 }
 
-func NewRoleService(cs *CloudStackClient) *RoleService {
+func NewRoleService(cs *CloudStackClient) RoleServiceIface {
 	return &RoleService{cs: cs}
 }
 
@@ -953,7 +1019,7 @@ type RouterService struct {
 	cs *CloudStackClient
 }
 
-func NewRouterService(cs *CloudStackClient) *RouterService {
+func NewRouterService(cs *CloudStackClient) RouterServiceIface {
 	return &RouterService{cs: cs}
 }
 
@@ -961,7 +1027,7 @@ type SSHService struct {
 	cs *CloudStackClient
 }
 
-func NewSSHService(cs *CloudStackClient) *SSHService {
+func NewSSHService(cs *CloudStackClient) SSHServiceIface {
 	return &SSHService{cs: cs}
 }
 
@@ -969,7 +1035,7 @@ type SecurityGroupService struct {
 	cs *CloudStackClient
 }
 
-func NewSecurityGroupService(cs *CloudStackClient) *SecurityGroupService {
+func NewSecurityGroupService(cs *CloudStackClient) SecurityGroupServiceIface {
 	return &SecurityGroupService{cs: cs}
 }
 
@@ -977,7 +1043,7 @@ type ServiceOfferingService struct {
 	cs *CloudStackClient
 }
 
-func NewServiceOfferingService(cs *CloudStackClient) *ServiceOfferingService {
+func NewServiceOfferingService(cs *CloudStackClient) ServiceOfferingServiceIface {
 	return &ServiceOfferingService{cs: cs}
 }
 
@@ -985,7 +1051,7 @@ type SnapshotService struct {
 	cs *CloudStackClient
 }
 
-func NewSnapshotService(cs *CloudStackClient) *SnapshotService {
+func NewSnapshotService(cs *CloudStackClient) SnapshotServiceIface {
 	return &SnapshotService{cs: cs}
 }
 
@@ -993,7 +1059,7 @@ type StoragePoolService struct {
 	cs *CloudStackClient
 }
 
-func NewStoragePoolService(cs *CloudStackClient) *StoragePoolService {
+func NewStoragePoolService(cs *CloudStackClient) StoragePoolServiceIface {
 	return &StoragePoolService{cs: cs}
 }
 
@@ -1001,7 +1067,7 @@ type StratosphereSSPService struct {
 	cs *CloudStackClient
 }
 
-func NewStratosphereSSPService(cs *CloudStackClient) *StratosphereSSPService {
+func NewStratosphereSSPService(cs *CloudStackClient) StratosphereSSPServiceIface {
 	return &StratosphereSSPService{cs: cs}
 }
 
@@ -1009,7 +1075,7 @@ type SwiftService struct {
 	cs *CloudStackClient
 }
 
-func NewSwiftService(cs *CloudStackClient) *SwiftService {
+func NewSwiftService(cs *CloudStackClient) SwiftServiceIface {
 	return &SwiftService{cs: cs}
 }
 
@@ -1017,7 +1083,7 @@ type SystemCapacityService struct {
 	cs *CloudStackClient
 }
 
-func NewSystemCapacityService(cs *CloudStackClient) *SystemCapacityService {
+func NewSystemCapacityService(cs *CloudStackClient) SystemCapacityServiceIface {
 	return &SystemCapacityService{cs: cs}
 }
 
@@ -1025,7 +1091,7 @@ type SystemVMService struct {
 	cs *CloudStackClient
 }
 
-func NewSystemVMService(cs *CloudStackClient) *SystemVMService {
+func NewSystemVMService(cs *CloudStackClient) SystemVMServiceIface {
 	return &SystemVMService{cs: cs}
 }
 
@@ -1033,7 +1099,7 @@ type TemplateService struct {
 	cs *CloudStackClient
 }
 
-func NewTemplateService(cs *CloudStackClient) *TemplateService {
+func NewTemplateService(cs *CloudStackClient) TemplateServiceIface {
 	return &TemplateService{cs: cs}
 }
 
@@ -1041,7 +1107,7 @@ type UCSService struct {
 	cs *CloudStackClient
 }
 
-func NewUCSService(cs *CloudStackClient) *UCSService {
+func NewUCSService(cs *CloudStackClient) UCSServiceIface {
 	return &UCSService{cs: cs}
 }
 
@@ -1049,7 +1115,7 @@ type UsageService struct {
 	cs *CloudStackClient
 }
 
-func NewUsageService(cs *CloudStackClient) *UsageService {
+func NewUsageService(cs *CloudStackClient) UsageServiceIface {
 	return &UsageService{cs: cs}
 }
 
@@ -1057,7 +1123,7 @@ type UserService struct {
 	cs *CloudStackClient
 }
 
-func NewUserService(cs *CloudStackClient) *UserService {
+func NewUserService(cs *CloudStackClient) UserServiceIface {
 	return &UserService{cs: cs}
 }
 
@@ -1065,7 +1131,7 @@ type VLANService struct {
 	cs *CloudStackClient
 }
 
-func NewVLANService(cs *CloudStackClient) *VLANService {
+func NewVLANService(cs *CloudStackClient) VLANServiceIface {
 	return &VLANService{cs: cs}
 }
 
@@ -1073,7 +1139,7 @@ type VMGroupService struct {
 	cs *CloudStackClient
 }
 
-func NewVMGroupService(cs *CloudStackClient) *VMGroupService {
+func NewVMGroupService(cs *CloudStackClient) VMGroupServiceIface {
 	return &VMGroupService{cs: cs}
 }
 
@@ -1081,7 +1147,7 @@ type VPCService struct {
 	cs *CloudStackClient
 }
 
-func NewVPCService(cs *CloudStackClient) *VPCService {
+func NewVPCService(cs *CloudStackClient) VPCServiceIface {
 	return &VPCService{cs: cs}
 }
 
@@ -1089,7 +1155,7 @@ type VPNService struct {
 	cs *CloudStackClient
 }
 
-func NewVPNService(cs *CloudStackClient) *VPNService {
+func NewVPNService(cs *CloudStackClient) VPNServiceIface {
 	return &VPNService{cs: cs}
 }
 
@@ -1097,7 +1163,7 @@ type VirtualMachineService struct {
 	cs *CloudStackClient
 }
 
-func NewVirtualMachineService(cs *CloudStackClient) *VirtualMachineService {
+func NewVirtualMachineService(cs *CloudStackClient) VirtualMachineServiceIface {
 	return &VirtualMachineService{cs: cs}
 }
 
@@ -1105,7 +1171,7 @@ type VolumeService struct {
 	cs *CloudStackClient
 }
 
-func NewVolumeService(cs *CloudStackClient) *VolumeService {
+func NewVolumeService(cs *CloudStackClient) VolumeServiceIface {
 	return &VolumeService{cs: cs}
 }
 
@@ -1113,6 +1179,6 @@ type ZoneService struct {
 	cs *CloudStackClient
 }
 
-func NewZoneService(cs *CloudStackClient) *ZoneService {
+func NewZoneService(cs *CloudStackClient) ZoneServiceIface {
 	return &ZoneService{cs: cs}
 }
@@ -0,0 +1,191 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"errors"
+	"net"
+	"sort"
+	"sync"
+	"time"
+)
+
+// EndpointPolicy defines how API requests are distributed over the configured endpoints
+type EndpointPolicy int
+
+const (
+	// PriorityPolicy sends all requests to the first healthy endpoint, in the order
+	// in which the endpoints are configured. This is the default policy.
+	PriorityPolicy EndpointPolicy = iota
+
+	// RoundRobinPolicy distributes the requests evenly over all healthy endpoints
+	RoundRobinPolicy
+)
+
+// DefaultEndpointCooldown is the time an unreachable endpoint is skipped before it is tried again
+const DefaultEndpointCooldown = 30 * time.Second
+
+// EndpointStatus describes the health of a single API endpoint
+type EndpointStatus struct {
+	URL     string
+	Healthy bool
+	Error   error
+}
+
+type endpoint struct {
+	url       string
+	downUntil time.Time
+}
+
+type endpointPool struct {
+	sync.Mutex
+
+	endpoints []*endpoint
+	policy    EndpointPolicy
+	cooldown  time.Duration
+	next      int
+}
+
+func newEndpointPool(apiurl string) *endpointPool {
+	return &endpointPool{
+		endpoints: []*endpoint{{url: apiurl}},
+		policy:    PriorityPolicy,
+		cooldown:  DefaultEndpointCooldown,
+	}
+}
+
+func (ep *endpointPool) add(apiurls ...string) {
+	ep.Lock()
+	defer ep.Unlock()
+
+	for _, u := range apiurls {
+		ep.endpoints = append(ep.endpoints, &endpoint{url: u})
+	}
+}
+
+// candidates returns the endpoints in the order they should be tried for a
+// single request. Healthy endpoints are ordered according to the configured
+// policy, followed by the unhealthy ones as a last resort (the one that will
+// recover first comes first).
+func (ep *endpointPool) candidates() []*endpoint {
+	ep.Lock()
+	defer ep.Unlock()
+
+	now := time.Now()
+	var healthy, down []*endpoint
+	for _, e := range ep.endpoints {
+		if now.Before(e.downUntil) {
+			down = append(down, e)
+		} else {
+			healthy = append(healthy, e)
+		}
+	}
+
+	if ep.policy == RoundRobinPolicy && len(healthy) > 1 {
+		n := ep.next % len(healthy)
+		healthy = append(healthy[n:], healthy[:n]...)
+		ep.next++
+	}
+
+	sort.SliceStable(down, func(i, j int) bool {
+		return down[i].downUntil.Before(down[j].downUntil)
+	})
+
+	return append(healthy, down...)
+}
+
+func (ep *endpointPool) markDown(e *endpoint) {
+	ep.Lock()
+	defer ep.Unlock()
+	e.downUntil = time.Now().Add(ep.cooldown)
+}
+
+func (ep *endpointPool) markUp(e *endpoint) {
+	ep.Lock()
+	defer ep.Unlock()
+	e.downUntil = time.Time{}
+}
+
+// isTimeout returns true if the error indicates the endpoint did not respond in time
+func isTimeout(err error) bool {
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// WithEndpoints adds one or more additional API endpoints (management servers) to
+// the CloudStackClient. The API URL passed when creating the client is always the
+// first endpoint. When an endpoint cannot be reached, the request is retried against
+// the next endpoint and the unreachable endpoint is skipped for the configured
+// cooldown period. An endpoint that times out after the request was sent is also
+// skipped, but the request is not retried as it may already have been processed.
+// Async jobs can be queried on any of the endpoints.
+//
+// An endpoint is only marked healthy again when it answers a request, or when its
+// cooldown has passed. The client does not check the endpoints in the background,
+// so call CheckEndpoints periodically to detect recovered or failed endpoints
+// before requests are sent to them.
+func WithEndpoints(apiurls ...string) ClientOption {
+	return func(cs *CloudStackClient) {
+		cs.endpoints.add(apiurls...)
+	}
+}
+
+// WithEndpointPolicy sets the policy used to distribute requests over the endpoints
+func WithEndpointPolicy(policy EndpointPolicy) ClientOption {
+	return func(cs *CloudStackClient) {
+		cs.endpoints.policy = policy
+	}
+}
+
+// WithEndpointCooldown sets the time an unreachable endpoint is skipped before it is tried again
+func WithEndpointCooldown(cooldown time.Duration) ClientOption {
+	return func(cs *CloudStackClient) {
+		if cooldown != 0 {
+			cs.endpoints.cooldown = cooldown
+		}
+	}
+}
+
+// CheckEndpoints actively checks if all configured endpoints can be reached and
+// updates their health accordingly. Any HTTP response counts as healthy, as the
+// check is only meant to detect management servers that are down or unreachable.
+// It is not called by the client itself, so callers that want to detect unhealthy
+// endpoints before a request fails should run it periodically.
+func (cs *CloudStackClient) CheckEndpoints() []EndpointStatus {
+	cs.endpoints.Lock()
+	endpoints := append([]*endpoint(nil), cs.endpoints.endpoints...)
+	cs.endpoints.Unlock()
+
+	var status []EndpointStatus
+	for _, e := range endpoints {
+		resp, err := cs.client.Get(e.url)
+		if err != nil {
+			cs.endpoints.markDown(e)
+			status = append(status, EndpointStatus{URL: e.url, Healthy: false, Error: err})
+			continue
+		}
+		resp.Body.Close()
+
+		cs.endpoints.markUp(e)
+		status = append(status, EndpointStatus{URL: e.url, Healthy: true})
+	}
+
+	return status
+}
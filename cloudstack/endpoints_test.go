@@ -0,0 +1,275 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type testEndpoint struct {
+	*httptest.Server
+	hits int32
+}
+
+// newTestEndpoint starts a management server answering every request with the
+// given status code
+func newTestEndpoint(t *testing.T, status int) *testEndpoint {
+	t.Helper()
+
+	e := &testEndpoint{}
+	e.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&e.hits, 1)
+		w.WriteHeader(status)
+		if status == http.StatusOK {
+			w.Write([]byte(`{"listzonesresponse":{"count":0}}`))
+		} else {
+			w.Write([]byte(`{"errorresponse":{"errorcode":530,"errortext":"Internal error"}}`))
+		}
+	}))
+
+	return e
+}
+
+func (e *testEndpoint) Hits() int {
+	return int(atomic.LoadInt32(&e.hits))
+}
+
+// unreachableURL returns the URL of a server that was closed again, so
+// connecting to it fails
+func unreachableURL(t *testing.T) string {
+	t.Helper()
+
+	srv := httptest.NewServer(http.NotFoundHandler())
+	srv.Close()
+	return srv.URL
+}
+
+func request(cs *CloudStackClient) error {
+	_, err := cs.newRequest("listZones", url.Values{})
+	return err
+}
+
+func TestEndpointFailover(t *testing.T) {
+	down := unreachableURL(t)
+	up := newTestEndpoint(t, http.StatusOK)
+	defer up.Close()
+
+	cs := NewClient(down, "key", "secret", false, WithEndpoints(up.URL))
+
+	for n := 1; n <= 3; n++ {
+		if err := request(cs); err != nil {
+			t.Fatalf("Request %d: expected to fail over, got: %v", n, err)
+		}
+	}
+	if up.Hits() != 3 {
+		t.Fatalf("Expected 3 requests to the second endpoint, got %d", up.Hits())
+	}
+
+	// The unreachable endpoint is skipped during its cooldown
+	c := cs.endpoints.candidates()
+	if len(c) != 2 || c[0].url != up.URL || c[1].url != down {
+		t.Fatalf("Expected the unreachable endpoint to be tried last, got %s, %s", c[0].url, c[1].url)
+	}
+}
+
+func TestEndpointFailoverAllDown(t *testing.T) {
+	cs := NewClient(unreachableURL(t), "key", "secret", false, WithEndpoints(unreachableURL(t)))
+
+	if err := request(cs); err == nil {
+		t.Fatal("Expected an error when no endpoint can be reached")
+	}
+
+	for _, e := range cs.endpoints.candidates() {
+		if e.downUntil.IsZero() {
+			t.Fatalf("Expected endpoint %s to be marked down", e.url)
+		}
+	}
+}
+
+func TestEndpointFailoverOnHandshakeFailure(t *testing.T) {
+	// The client does not trust the certificate of this server
+	untrusted := httptest.NewTLSServer(http.NotFoundHandler())
+	defer untrusted.Close()
+	up := newTestEndpoint(t, http.StatusOK)
+	defer up.Close()
+
+	cs := NewClient(untrusted.URL, "key", "secret", true, WithEndpoints(up.URL))
+
+	if err := request(cs); err != nil {
+		t.Fatalf("Expected to fail over, got: %v", err)
+	}
+	if up.Hits() != 1 {
+		t.Fatalf("Expected 1 request to the second endpoint, got %d", up.Hits())
+	}
+
+	c := cs.endpoints.candidates()
+	if c[len(c)-1].url != untrusted.URL {
+		t.Fatalf("Expected the endpoint failing the handshake to be tried last, got %s", c[len(c)-1].url)
+	}
+}
+
+func TestEndpointNoFailoverOnTimeout(t *testing.T) {
+	done := make(chan struct{})
+	hanging := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-done
+	}))
+	defer hanging.Close()
+	defer close(done)
+
+	up := newTestEndpoint(t, http.StatusOK)
+	defer up.Close()
+
+	cs := NewClient(hanging.URL, "key", "secret", false,
+		WithEndpoints(up.URL),
+		WithHTTPClient(&http.Client{Timeout: 100 * time.Millisecond}),
+	)
+
+	// The request may have been processed, so it must not be sent again
+	if err := request(cs); err == nil {
+		t.Fatal("Expected the timeout to be returned")
+	}
+	if up.Hits() != 0 {
+		t.Fatalf("Expected no requests to the second endpoint, got %d", up.Hits())
+	}
+
+	// But the hanging endpoint must not be marked healthy
+	c := cs.endpoints.candidates()
+	if c[0].url != up.URL {
+		t.Fatalf("Expected the hanging endpoint to be skipped, got %s", c[0].url)
+	}
+}
+
+func TestEndpointNoFailoverOnAPIError(t *testing.T) {
+	failing := newTestEndpoint(t, 530)
+	defer failing.Close()
+	up := newTestEndpoint(t, http.StatusOK)
+	defer up.Close()
+
+	cs := NewClient(failing.URL, "key", "secret", false, WithEndpoints(up.URL))
+
+	// The request reached the first endpoint, so it must not be sent again
+	if err := request(cs); err == nil {
+		t.Fatal("Expected the API error to be returned")
+	}
+	if failing.Hits() != 1 || up.Hits() != 0 {
+		t.Fatalf("Expected only the first endpoint to be used, got %d and %d requests", failing.Hits(), up.Hits())
+	}
+}
+
+func TestEndpointPolicies(t *testing.T) {
+	cases := []struct {
+		name   string
+		policy EndpointPolicy
+		first  int
+		second int
+	}{
+		{"priority", PriorityPolicy, 4, 0},
+		{"round robin", RoundRobinPolicy, 2, 2},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			first := newTestEndpoint(t, http.StatusOK)
+			defer first.Close()
+			second := newTestEndpoint(t, http.StatusOK)
+			defer second.Close()
+
+			cs := NewClient(first.URL, "key", "secret", false,
+				WithEndpoints(second.URL),
+				WithEndpointPolicy(c.policy),
+			)
+
+			for n := 0; n < 4; n++ {
+				if err := request(cs); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if first.Hits() != c.first || second.Hits() != c.second {
+				t.Fatalf("Expected %d and %d requests, got %d and %d", c.first, c.second, first.Hits(), second.Hits())
+			}
+		})
+	}
+}
+
+func TestEndpointCooldown(t *testing.T) {
+	first := newTestEndpoint(t, http.StatusOK)
+	defer first.Close()
+	second := newTestEndpoint(t, http.StatusOK)
+	defer second.Close()
+
+	cs := NewClient(first.URL, "key", "secret", false,
+		WithEndpoints(second.URL),
+		WithEndpointCooldown(50*time.Millisecond),
+	)
+
+	cs.endpoints.markDown(cs.endpoints.endpoints[0])
+	if err := request(cs); err != nil {
+		t.Fatal(err)
+	}
+	if first.Hits() != 0 || second.Hits() != 1 {
+		t.Fatalf("Expected the first endpoint to be skipped, got %d and %d requests", first.Hits(), second.Hits())
+	}
+
+	time.Sleep(100 * time.Millisecond)
+
+	if err := request(cs); err != nil {
+		t.Fatal(err)
+	}
+	if first.Hits() != 1 {
+		t.Fatalf("Expected the first endpoint to be used again after its cooldown, got %d requests", first.Hits())
+	}
+}
+
+func TestCheckEndpoints(t *testing.T) {
+	up := newTestEndpoint(t, http.StatusOK)
+	defer up.Close()
+	failing := newTestEndpoint(t, 530)
+	defer failing.Close()
+	down := unreachableURL(t)
+
+	cs := NewClient(up.URL, "key", "secret", false, WithEndpoints(down, failing.URL))
+
+	status := cs.CheckEndpoints()
+	if len(status) != 3 {
+		t.Fatalf("Expected 3 statuses, got %d", len(status))
+	}
+
+	// Any HTTP response counts as healthy
+	want := []bool{true, false, true}
+	for n, s := range status {
+		if s.Healthy != want[n] {
+			t.Errorf("Expected endpoint %s to be healthy: %t, got: %t (%v)", s.URL, want[n], s.Healthy, s.Error)
+		}
+		if !s.Healthy && s.Error == nil {
+			t.Errorf("Expected an error for unhealthy endpoint %s", s.URL)
+		}
+	}
+
+	c := cs.endpoints.candidates()
+	if c[len(c)-1].url != down {
+		t.Fatalf("Expected the unreachable endpoint to be tried last, got %s", c[len(c)-1].url)
+	}
+}
@@ -0,0 +1,83 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../cloudstack/CertificateService.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	cloudstack "github.com/xanzy/go-cloudstack/v2/cloudstack"
+)
+
+// MockCertificateServiceIface is a mock of CertificateServiceIface interface.
+type MockCertificateServiceIface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCertificateServiceIfaceMockRecorder
+}
+
+// MockCertificateServiceIfaceMockRecorder is the mock recorder for MockCertificateServiceIface.
+type MockCertificateServiceIfaceMockRecorder struct {
+	mock *MockCertificateServiceIface
+}
+
+// NewMockCertificateServiceIface creates a new mock instance.
+func NewMockCertificateServiceIface(ctrl *gomock.Controller) *MockCertificateServiceIface {
+	mock := &MockCertificateServiceIface{ctrl: ctrl}
+	mock.recorder = &MockCertificateServiceIfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCertificateServiceIface) EXPECT() *MockCertificateServiceIfaceMockRecorder {
+	return m.recorder
+}
+
+// NewUploadCustomCertificateParams mocks base method.
+func (m *MockCertificateServiceIface) NewUploadCustomCertificateParams(certificate, domainsuffix string) *cloudstack.UploadCustomCertificateParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewUploadCustomCertificateParams", certificate, domainsuffix)
+	ret0, _ := ret[0].(*cloudstack.UploadCustomCertificateParams)
+	return ret0
+}
+
+// NewUploadCustomCertificateParams indicates an expected call of NewUploadCustomCertificateParams.
+func (mr *MockCertificateServiceIfaceMockRecorder) NewUploadCustomCertificateParams(certificate, domainsuffix interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewUploadCustomCertificateParams", reflect.TypeOf((*MockCertificateServiceIface)(nil).NewUploadCustomCertificateParams), certificate, domainsuffix)
+}
+
+// UploadCustomCertificate mocks base method.
+func (m *MockCertificateServiceIface) UploadCustomCertificate(p *cloudstack.UploadCustomCertificateParams) (*cloudstack.UploadCustomCertificateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadCustomCertificate", p)
+	ret0, _ := ret[0].(*cloudstack.UploadCustomCertificateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadCustomCertificate indicates an expected call of UploadCustomCertificate.
+func (mr *MockCertificateServiceIfaceMockRecorder) UploadCustomCertificate(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadCustomCertificate", reflect.TypeOf((*MockCertificateServiceIface)(nil).UploadCustomCertificate), p)
+}
@@ -0,0 +1,470 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../cloudstack/ClusterService.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	cloudstack "github.com/xanzy/go-cloudstack/v2/cloudstack"
+)
+
+// MockClusterServiceIface is a mock of ClusterServiceIface interface.
+type MockClusterServiceIface struct {
+	ctrl     *gomock.Controller
+	recorder *MockClusterServiceIfaceMockRecorder
+}
+
+// MockClusterServiceIfaceMockRecorder is the mock recorder for MockClusterServiceIface.
+type MockClusterServiceIfaceMockRecorder struct {
+	mock *MockClusterServiceIface
+}
+
+// NewMockClusterServiceIface creates a new mock instance.
+func NewMockClusterServiceIface(ctrl *gomock.Controller) *MockClusterServiceIface {
+	mock := &MockClusterServiceIface{ctrl: ctrl}
+	mock.recorder = &MockClusterServiceIfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClusterServiceIface) EXPECT() *MockClusterServiceIfaceMockRecorder {
+	return m.recorder
+}
+
+// AddCluster mocks base method.
+func (m *MockClusterServiceIface) AddCluster(p *cloudstack.AddClusterParams) (*cloudstack.AddClusterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCluster", p)
+	ret0, _ := ret[0].(*cloudstack.AddClusterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddCluster indicates an expected call of AddCluster.
+func (mr *MockClusterServiceIfaceMockRecorder) AddCluster(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCluster", reflect.TypeOf((*MockClusterServiceIface)(nil).AddCluster), p)
+}
+
+// DedicateCluster mocks base method.
+func (m *MockClusterServiceIface) DedicateCluster(p *cloudstack.DedicateClusterParams) (*cloudstack.DedicateClusterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DedicateCluster", p)
+	ret0, _ := ret[0].(*cloudstack.DedicateClusterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DedicateCluster indicates an expected call of DedicateCluster.
+func (mr *MockClusterServiceIfaceMockRecorder) DedicateCluster(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DedicateCluster", reflect.TypeOf((*MockClusterServiceIface)(nil).DedicateCluster), p)
+}
+
+// DeleteCluster mocks base method.
+func (m *MockClusterServiceIface) DeleteCluster(p *cloudstack.DeleteClusterParams) (*cloudstack.DeleteClusterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCluster", p)
+	ret0, _ := ret[0].(*cloudstack.DeleteClusterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCluster indicates an expected call of DeleteCluster.
+func (mr *MockClusterServiceIfaceMockRecorder) DeleteCluster(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCluster", reflect.TypeOf((*MockClusterServiceIface)(nil).DeleteCluster), p)
+}
+
+// DisableOutOfBandManagementForCluster mocks base method.
+func (m *MockClusterServiceIface) DisableOutOfBandManagementForCluster(p *cloudstack.DisableOutOfBandManagementForClusterParams) (*cloudstack.DisableOutOfBandManagementForClusterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableOutOfBandManagementForCluster", p)
+	ret0, _ := ret[0].(*cloudstack.DisableOutOfBandManagementForClusterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisableOutOfBandManagementForCluster indicates an expected call of DisableOutOfBandManagementForCluster.
+func (mr *MockClusterServiceIfaceMockRecorder) DisableOutOfBandManagementForCluster(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableOutOfBandManagementForCluster", reflect.TypeOf((*MockClusterServiceIface)(nil).DisableOutOfBandManagementForCluster), p)
+}
+
+// EnableOutOfBandManagementForCluster mocks base method.
+func (m *MockClusterServiceIface) EnableOutOfBandManagementForCluster(p *cloudstack.EnableOutOfBandManagementForClusterParams) (*cloudstack.EnableOutOfBandManagementForClusterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableOutOfBandManagementForCluster", p)
+	ret0, _ := ret[0].(*cloudstack.EnableOutOfBandManagementForClusterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnableOutOfBandManagementForCluster indicates an expected call of EnableOutOfBandManagementForCluster.
+func (mr *MockClusterServiceIfaceMockRecorder) EnableOutOfBandManagementForCluster(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableOutOfBandManagementForCluster", reflect.TypeOf((*MockClusterServiceIface)(nil).EnableOutOfBandManagementForCluster), p)
+}
+
+// GetClusterByID mocks base method.
+func (m *MockClusterServiceIface) GetClusterByID(id string, opts ...cloudstack.OptionFunc) (*cloudstack.Cluster, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetClusterByID", varargs...)
+	ret0, _ := ret[0].(*cloudstack.Cluster)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetClusterByID indicates an expected call of GetClusterByID.
+func (mr *MockClusterServiceIfaceMockRecorder) GetClusterByID(id interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterByID", reflect.TypeOf((*MockClusterServiceIface)(nil).GetClusterByID), varargs...)
+}
+
+// GetClusterByName mocks base method.
+func (m *MockClusterServiceIface) GetClusterByName(name string, opts ...cloudstack.OptionFunc) (*cloudstack.Cluster, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetClusterByName", varargs...)
+	ret0, _ := ret[0].(*cloudstack.Cluster)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetClusterByName indicates an expected call of GetClusterByName.
+func (mr *MockClusterServiceIfaceMockRecorder) GetClusterByName(name interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterByName", reflect.TypeOf((*MockClusterServiceIface)(nil).GetClusterByName), varargs...)
+}
+
+// GetClusterID mocks base method.
+func (m *MockClusterServiceIface) GetClusterID(name string, opts ...cloudstack.OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetClusterID", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetClusterID indicates an expected call of GetClusterID.
+func (mr *MockClusterServiceIfaceMockRecorder) GetClusterID(name interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterID", reflect.TypeOf((*MockClusterServiceIface)(nil).GetClusterID), varargs...)
+}
+
+// GetClustersMetricByID mocks base method.
+func (m *MockClusterServiceIface) GetClustersMetricByID(id string, opts ...cloudstack.OptionFunc) (*cloudstack.ClustersMetric, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetClustersMetricByID", varargs...)
+	ret0, _ := ret[0].(*cloudstack.ClustersMetric)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetClustersMetricByID indicates an expected call of GetClustersMetricByID.
+func (mr *MockClusterServiceIfaceMockRecorder) GetClustersMetricByID(id interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClustersMetricByID", reflect.TypeOf((*MockClusterServiceIface)(nil).GetClustersMetricByID), varargs...)
+}
+
+// GetClustersMetricByName mocks base method.
+func (m *MockClusterServiceIface) GetClustersMetricByName(name string, opts ...cloudstack.OptionFunc) (*cloudstack.ClustersMetric, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetClustersMetricByName", varargs...)
+	ret0, _ := ret[0].(*cloudstack.ClustersMetric)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetClustersMetricByName indicates an expected call of GetClustersMetricByName.
+func (mr *MockClusterServiceIfaceMockRecorder) GetClustersMetricByName(name interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClustersMetricByName", reflect.TypeOf((*MockClusterServiceIface)(nil).GetClustersMetricByName), varargs...)
+}
+
+// GetClustersMetricID mocks base method.
+func (m *MockClusterServiceIface) GetClustersMetricID(name string, opts ...cloudstack.OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetClustersMetricID", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetClustersMetricID indicates an expected call of GetClustersMetricID.
+func (mr *MockClusterServiceIfaceMockRecorder) GetClustersMetricID(name interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClustersMetricID", reflect.TypeOf((*MockClusterServiceIface)(nil).GetClustersMetricID), varargs...)
+}
+
+// ListClusters mocks base method.
+func (m *MockClusterServiceIface) ListClusters(p *cloudstack.ListClustersParams) (*cloudstack.ListClustersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListClusters", p)
+	ret0, _ := ret[0].(*cloudstack.ListClustersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClusters indicates an expected call of ListClusters.
+func (mr *MockClusterServiceIfaceMockRecorder) ListClusters(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusters", reflect.TypeOf((*MockClusterServiceIface)(nil).ListClusters), p)
+}
+
+// ListClustersMetrics mocks base method.
+func (m *MockClusterServiceIface) ListClustersMetrics(p *cloudstack.ListClustersMetricsParams) (*cloudstack.ListClustersMetricsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListClustersMetrics", p)
+	ret0, _ := ret[0].(*cloudstack.ListClustersMetricsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClustersMetrics indicates an expected call of ListClustersMetrics.
+func (mr *MockClusterServiceIfaceMockRecorder) ListClustersMetrics(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClustersMetrics", reflect.TypeOf((*MockClusterServiceIface)(nil).ListClustersMetrics), p)
+}
+
+// ListDedicatedClusters mocks base method.
+func (m *MockClusterServiceIface) ListDedicatedClusters(p *cloudstack.ListDedicatedClustersParams) (*cloudstack.ListDedicatedClustersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDedicatedClusters", p)
+	ret0, _ := ret[0].(*cloudstack.ListDedicatedClustersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDedicatedClusters indicates an expected call of ListDedicatedClusters.
+func (mr *MockClusterServiceIfaceMockRecorder) ListDedicatedClusters(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDedicatedClusters", reflect.TypeOf((*MockClusterServiceIface)(nil).ListDedicatedClusters), p)
+}
+
+// NewAddClusterParams mocks base method.
+func (m *MockClusterServiceIface) NewAddClusterParams(clustername, clustertype, hypervisor, podid, zoneid string) *cloudstack.AddClusterParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewAddClusterParams", clustername, clustertype, hypervisor, podid, zoneid)
+	ret0, _ := ret[0].(*cloudstack.AddClusterParams)
+	return ret0
+}
+
+// NewAddClusterParams indicates an expected call of NewAddClusterParams.
+func (mr *MockClusterServiceIfaceMockRecorder) NewAddClusterParams(clustername, clustertype, hypervisor, podid, zoneid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewAddClusterParams", reflect.TypeOf((*MockClusterServiceIface)(nil).NewAddClusterParams), clustername, clustertype, hypervisor, podid, zoneid)
+}
+
+// NewDedicateClusterParams mocks base method.
+func (m *MockClusterServiceIface) NewDedicateClusterParams(clusterid, domainid string) *cloudstack.DedicateClusterParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewDedicateClusterParams", clusterid, domainid)
+	ret0, _ := ret[0].(*cloudstack.DedicateClusterParams)
+	return ret0
+}
+
+// NewDedicateClusterParams indicates an expected call of NewDedicateClusterParams.
+func (mr *MockClusterServiceIfaceMockRecorder) NewDedicateClusterParams(clusterid, domainid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewDedicateClusterParams", reflect.TypeOf((*MockClusterServiceIface)(nil).NewDedicateClusterParams), clusterid, domainid)
+}
+
+// NewDeleteClusterParams mocks base method.
+func (m *MockClusterServiceIface) NewDeleteClusterParams(id string) *cloudstack.DeleteClusterParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewDeleteClusterParams", id)
+	ret0, _ := ret[0].(*cloudstack.DeleteClusterParams)
+	return ret0
+}
+
+// NewDeleteClusterParams indicates an expected call of NewDeleteClusterParams.
+func (mr *MockClusterServiceIfaceMockRecorder) NewDeleteClusterParams(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewDeleteClusterParams", reflect.TypeOf((*MockClusterServiceIface)(nil).NewDeleteClusterParams), id)
+}
+
+// NewDisableOutOfBandManagementForClusterParams mocks base method.
+func (m *MockClusterServiceIface) NewDisableOutOfBandManagementForClusterParams(clusterid string) *cloudstack.DisableOutOfBandManagementForClusterParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewDisableOutOfBandManagementForClusterParams", clusterid)
+	ret0, _ := ret[0].(*cloudstack.DisableOutOfBandManagementForClusterParams)
+	return ret0
+}
+
+// NewDisableOutOfBandManagementForClusterParams indicates an expected call of NewDisableOutOfBandManagementForClusterParams.
+func (mr *MockClusterServiceIfaceMockRecorder) NewDisableOutOfBandManagementForClusterParams(clusterid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewDisableOutOfBandManagementForClusterParams", reflect.TypeOf((*MockClusterServiceIface)(nil).NewDisableOutOfBandManagementForClusterParams), clusterid)
+}
+
+// NewEnableOutOfBandManagementForClusterParams mocks base method.
+func (m *MockClusterServiceIface) NewEnableOutOfBandManagementForClusterParams(clusterid string) *cloudstack.EnableOutOfBandManagementForClusterParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewEnableOutOfBandManagementForClusterParams", clusterid)
+	ret0, _ := ret[0].(*cloudstack.EnableOutOfBandManagementForClusterParams)
+	return ret0
+}
+
+// NewEnableOutOfBandManagementForClusterParams indicates an expected call of NewEnableOutOfBandManagementForClusterParams.
+func (mr *MockClusterServiceIfaceMockRecorder) NewEnableOutOfBandManagementForClusterParams(clusterid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewEnableOutOfBandManagementForClusterParams", reflect.TypeOf((*MockClusterServiceIface)(nil).NewEnableOutOfBandManagementForClusterParams), clusterid)
+}
+
+// NewListClustersMetricsParams mocks base method.
+func (m *MockClusterServiceIface) NewListClustersMetricsParams() *cloudstack.ListClustersMetricsParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListClustersMetricsParams")
+	ret0, _ := ret[0].(*cloudstack.ListClustersMetricsParams)
+	return ret0
+}
+
+// NewListClustersMetricsParams indicates an expected call of NewListClustersMetricsParams.
+func (mr *MockClusterServiceIfaceMockRecorder) NewListClustersMetricsParams() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListClustersMetricsParams", reflect.TypeOf((*MockClusterServiceIface)(nil).NewListClustersMetricsParams))
+}
+
+// NewListClustersParams mocks base method.
+func (m *MockClusterServiceIface) NewListClustersParams() *cloudstack.ListClustersParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListClustersParams")
+	ret0, _ := ret[0].(*cloudstack.ListClustersParams)
+	return ret0
+}
+
+// NewListClustersParams indicates an expected call of NewListClustersParams.
+func (mr *MockClusterServiceIfaceMockRecorder) NewListClustersParams() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListClustersParams", reflect.TypeOf((*MockClusterServiceIface)(nil).NewListClustersParams))
+}
+
+// NewListDedicatedClustersParams mocks base method.
+func (m *MockClusterServiceIface) NewListDedicatedClustersParams() *cloudstack.ListDedicatedClustersParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListDedicatedClustersParams")
+	ret0, _ := ret[0].(*cloudstack.ListDedicatedClustersParams)
+	return ret0
+}
+
+// NewListDedicatedClustersParams indicates an expected call of NewListDedicatedClustersParams.
+func (mr *MockClusterServiceIfaceMockRecorder) NewListDedicatedClustersParams() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListDedicatedClustersParams", reflect.TypeOf((*MockClusterServiceIface)(nil).NewListDedicatedClustersParams))
+}
+
+// NewReleaseDedicatedClusterParams mocks base method.
+func (m *MockClusterServiceIface) NewReleaseDedicatedClusterParams(clusterid string) *cloudstack.ReleaseDedicatedClusterParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewReleaseDedicatedClusterParams", clusterid)
+	ret0, _ := ret[0].(*cloudstack.ReleaseDedicatedClusterParams)
+	return ret0
+}
+
+// NewReleaseDedicatedClusterParams indicates an expected call of NewReleaseDedicatedClusterParams.
+func (mr *MockClusterServiceIfaceMockRecorder) NewReleaseDedicatedClusterParams(clusterid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewReleaseDedicatedClusterParams", reflect.TypeOf((*MockClusterServiceIface)(nil).NewReleaseDedicatedClusterParams), clusterid)
+}
+
+// NewUpdateClusterParams mocks base method.
+func (m *MockClusterServiceIface) NewUpdateClusterParams(id string) *cloudstack.UpdateClusterParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewUpdateClusterParams", id)
+	ret0, _ := ret[0].(*cloudstack.UpdateClusterParams)
+	return ret0
+}
+
+// NewUpdateClusterParams indicates an expected call of NewUpdateClusterParams.
+func (mr *MockClusterServiceIfaceMockRecorder) NewUpdateClusterParams(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewUpdateClusterParams", reflect.TypeOf((*MockClusterServiceIface)(nil).NewUpdateClusterParams), id)
+}
+
+// ReleaseDedicatedCluster mocks base method.
+func (m *MockClusterServiceIface) ReleaseDedicatedCluster(p *cloudstack.ReleaseDedicatedClusterParams) (*cloudstack.ReleaseDedicatedClusterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseDedicatedCluster", p)
+	ret0, _ := ret[0].(*cloudstack.ReleaseDedicatedClusterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseDedicatedCluster indicates an expected call of ReleaseDedicatedCluster.
+func (mr *MockClusterServiceIfaceMockRecorder) ReleaseDedicatedCluster(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseDedicatedCluster", reflect.TypeOf((*MockClusterServiceIface)(nil).ReleaseDedicatedCluster), p)
+}
+
+// UpdateCluster mocks base method.
+func (m *MockClusterServiceIface) UpdateCluster(p *cloudstack.UpdateClusterParams) (*cloudstack.UpdateClusterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCluster", p)
+	ret0, _ := ret[0].(*cloudstack.UpdateClusterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCluster indicates an expected call of UpdateCluster.
+func (mr *MockClusterServiceIfaceMockRecorder) UpdateCluster(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCluster", reflect.TypeOf((*MockClusterServiceIface)(nil).UpdateCluster), p)
+}
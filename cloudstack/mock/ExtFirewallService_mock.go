@@ -0,0 +1,51 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../cloudstack/ExtFirewallService.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockExtFirewallServiceIface is a mock of ExtFirewallServiceIface interface.
+type MockExtFirewallServiceIface struct {
+	ctrl     *gomock.Controller
+	recorder *MockExtFirewallServiceIfaceMockRecorder
+}
+
+// MockExtFirewallServiceIfaceMockRecorder is the mock recorder for MockExtFirewallServiceIface.
+type MockExtFirewallServiceIfaceMockRecorder struct {
+	mock *MockExtFirewallServiceIface
+}
+
+// NewMockExtFirewallServiceIface creates a new mock instance.
+func NewMockExtFirewallServiceIface(ctrl *gomock.Controller) *MockExtFirewallServiceIface {
+	mock := &MockExtFirewallServiceIface{ctrl: ctrl}
+	mock.recorder = &MockExtFirewallServiceIfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExtFirewallServiceIface) EXPECT() *MockExtFirewallServiceIfaceMockRecorder {
+	return m.recorder
+}
@@ -0,0 +1,51 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../cloudstack/ExtLoadBalancerService.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockExtLoadBalancerServiceIface is a mock of ExtLoadBalancerServiceIface interface.
+type MockExtLoadBalancerServiceIface struct {
+	ctrl     *gomock.Controller
+	recorder *MockExtLoadBalancerServiceIfaceMockRecorder
+}
+
+// MockExtLoadBalancerServiceIfaceMockRecorder is the mock recorder for MockExtLoadBalancerServiceIface.
+type MockExtLoadBalancerServiceIfaceMockRecorder struct {
+	mock *MockExtLoadBalancerServiceIface
+}
+
+// NewMockExtLoadBalancerServiceIface creates a new mock instance.
+func NewMockExtLoadBalancerServiceIface(ctrl *gomock.Controller) *MockExtLoadBalancerServiceIface {
+	mock := &MockExtLoadBalancerServiceIface{ctrl: ctrl}
+	mock.recorder = &MockExtLoadBalancerServiceIfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExtLoadBalancerServiceIface) EXPECT() *MockExtLoadBalancerServiceIfaceMockRecorder {
+	return m.recorder
+}
@@ -0,0 +1,420 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../cloudstack/GuestOSService.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	cloudstack "github.com/xanzy/go-cloudstack/v2/cloudstack"
+)
+
+// MockGuestOSServiceIface is a mock of GuestOSServiceIface interface.
+type MockGuestOSServiceIface struct {
+	ctrl     *gomock.Controller
+	recorder *MockGuestOSServiceIfaceMockRecorder
+}
+
+// MockGuestOSServiceIfaceMockRecorder is the mock recorder for MockGuestOSServiceIface.
+type MockGuestOSServiceIfaceMockRecorder struct {
+	mock *MockGuestOSServiceIface
+}
+
+// NewMockGuestOSServiceIface creates a new mock instance.
+func NewMockGuestOSServiceIface(ctrl *gomock.Controller) *MockGuestOSServiceIface {
+	mock := &MockGuestOSServiceIface{ctrl: ctrl}
+	mock.recorder = &MockGuestOSServiceIfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGuestOSServiceIface) EXPECT() *MockGuestOSServiceIfaceMockRecorder {
+	return m.recorder
+}
+
+// AddGuestOs mocks base method.
+func (m *MockGuestOSServiceIface) AddGuestOs(p *cloudstack.AddGuestOsParams) (*cloudstack.AddGuestOsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddGuestOs", p)
+	ret0, _ := ret[0].(*cloudstack.AddGuestOsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddGuestOs indicates an expected call of AddGuestOs.
+func (mr *MockGuestOSServiceIfaceMockRecorder) AddGuestOs(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGuestOs", reflect.TypeOf((*MockGuestOSServiceIface)(nil).AddGuestOs), p)
+}
+
+// AddGuestOsMapping mocks base method.
+func (m *MockGuestOSServiceIface) AddGuestOsMapping(p *cloudstack.AddGuestOsMappingParams) (*cloudstack.AddGuestOsMappingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddGuestOsMapping", p)
+	ret0, _ := ret[0].(*cloudstack.AddGuestOsMappingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddGuestOsMapping indicates an expected call of AddGuestOsMapping.
+func (mr *MockGuestOSServiceIfaceMockRecorder) AddGuestOsMapping(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGuestOsMapping", reflect.TypeOf((*MockGuestOSServiceIface)(nil).AddGuestOsMapping), p)
+}
+
+// GetGuestOsMappingByID mocks base method.
+func (m *MockGuestOSServiceIface) GetGuestOsMappingByID(id string, opts ...cloudstack.OptionFunc) (*cloudstack.GuestOsMapping, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetGuestOsMappingByID", varargs...)
+	ret0, _ := ret[0].(*cloudstack.GuestOsMapping)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetGuestOsMappingByID indicates an expected call of GetGuestOsMappingByID.
+func (mr *MockGuestOSServiceIfaceMockRecorder) GetGuestOsMappingByID(id interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGuestOsMappingByID", reflect.TypeOf((*MockGuestOSServiceIface)(nil).GetGuestOsMappingByID), varargs...)
+}
+
+// GetOsCategoryByID mocks base method.
+func (m *MockGuestOSServiceIface) GetOsCategoryByID(id string, opts ...cloudstack.OptionFunc) (*cloudstack.OsCategory, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetOsCategoryByID", varargs...)
+	ret0, _ := ret[0].(*cloudstack.OsCategory)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOsCategoryByID indicates an expected call of GetOsCategoryByID.
+func (mr *MockGuestOSServiceIfaceMockRecorder) GetOsCategoryByID(id interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOsCategoryByID", reflect.TypeOf((*MockGuestOSServiceIface)(nil).GetOsCategoryByID), varargs...)
+}
+
+// GetOsCategoryByName mocks base method.
+func (m *MockGuestOSServiceIface) GetOsCategoryByName(name string, opts ...cloudstack.OptionFunc) (*cloudstack.OsCategory, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetOsCategoryByName", varargs...)
+	ret0, _ := ret[0].(*cloudstack.OsCategory)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOsCategoryByName indicates an expected call of GetOsCategoryByName.
+func (mr *MockGuestOSServiceIfaceMockRecorder) GetOsCategoryByName(name interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOsCategoryByName", reflect.TypeOf((*MockGuestOSServiceIface)(nil).GetOsCategoryByName), varargs...)
+}
+
+// GetOsCategoryID mocks base method.
+func (m *MockGuestOSServiceIface) GetOsCategoryID(name string, opts ...cloudstack.OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetOsCategoryID", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOsCategoryID indicates an expected call of GetOsCategoryID.
+func (mr *MockGuestOSServiceIfaceMockRecorder) GetOsCategoryID(name interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOsCategoryID", reflect.TypeOf((*MockGuestOSServiceIface)(nil).GetOsCategoryID), varargs...)
+}
+
+// GetOsTypeByID mocks base method.
+func (m *MockGuestOSServiceIface) GetOsTypeByID(id string, opts ...cloudstack.OptionFunc) (*cloudstack.OsType, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetOsTypeByID", varargs...)
+	ret0, _ := ret[0].(*cloudstack.OsType)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOsTypeByID indicates an expected call of GetOsTypeByID.
+func (mr *MockGuestOSServiceIfaceMockRecorder) GetOsTypeByID(id interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOsTypeByID", reflect.TypeOf((*MockGuestOSServiceIface)(nil).GetOsTypeByID), varargs...)
+}
+
+// ListGuestOsMapping mocks base method.
+func (m *MockGuestOSServiceIface) ListGuestOsMapping(p *cloudstack.ListGuestOsMappingParams) (*cloudstack.ListGuestOsMappingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGuestOsMapping", p)
+	ret0, _ := ret[0].(*cloudstack.ListGuestOsMappingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGuestOsMapping indicates an expected call of ListGuestOsMapping.
+func (mr *MockGuestOSServiceIfaceMockRecorder) ListGuestOsMapping(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGuestOsMapping", reflect.TypeOf((*MockGuestOSServiceIface)(nil).ListGuestOsMapping), p)
+}
+
+// ListOsCategories mocks base method.
+func (m *MockGuestOSServiceIface) ListOsCategories(p *cloudstack.ListOsCategoriesParams) (*cloudstack.ListOsCategoriesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOsCategories", p)
+	ret0, _ := ret[0].(*cloudstack.ListOsCategoriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOsCategories indicates an expected call of ListOsCategories.
+func (mr *MockGuestOSServiceIfaceMockRecorder) ListOsCategories(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOsCategories", reflect.TypeOf((*MockGuestOSServiceIface)(nil).ListOsCategories), p)
+}
+
+// ListOsTypes mocks base method.
+func (m *MockGuestOSServiceIface) ListOsTypes(p *cloudstack.ListOsTypesParams) (*cloudstack.ListOsTypesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOsTypes", p)
+	ret0, _ := ret[0].(*cloudstack.ListOsTypesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOsTypes indicates an expected call of ListOsTypes.
+func (mr *MockGuestOSServiceIfaceMockRecorder) ListOsTypes(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOsTypes", reflect.TypeOf((*MockGuestOSServiceIface)(nil).ListOsTypes), p)
+}
+
+// NewAddGuestOsMappingParams mocks base method.
+func (m *MockGuestOSServiceIface) NewAddGuestOsMappingParams(hypervisor, hypervisorversion, osnameforhypervisor string) *cloudstack.AddGuestOsMappingParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewAddGuestOsMappingParams", hypervisor, hypervisorversion, osnameforhypervisor)
+	ret0, _ := ret[0].(*cloudstack.AddGuestOsMappingParams)
+	return ret0
+}
+
+// NewAddGuestOsMappingParams indicates an expected call of NewAddGuestOsMappingParams.
+func (mr *MockGuestOSServiceIfaceMockRecorder) NewAddGuestOsMappingParams(hypervisor, hypervisorversion, osnameforhypervisor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewAddGuestOsMappingParams", reflect.TypeOf((*MockGuestOSServiceIface)(nil).NewAddGuestOsMappingParams), hypervisor, hypervisorversion, osnameforhypervisor)
+}
+
+// NewAddGuestOsParams mocks base method.
+func (m *MockGuestOSServiceIface) NewAddGuestOsParams(details map[string]string, oscategoryid, osdisplayname string) *cloudstack.AddGuestOsParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewAddGuestOsParams", details, oscategoryid, osdisplayname)
+	ret0, _ := ret[0].(*cloudstack.AddGuestOsParams)
+	return ret0
+}
+
+// NewAddGuestOsParams indicates an expected call of NewAddGuestOsParams.
+func (mr *MockGuestOSServiceIfaceMockRecorder) NewAddGuestOsParams(details, oscategoryid, osdisplayname interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewAddGuestOsParams", reflect.TypeOf((*MockGuestOSServiceIface)(nil).NewAddGuestOsParams), details, oscategoryid, osdisplayname)
+}
+
+// NewListGuestOsMappingParams mocks base method.
+func (m *MockGuestOSServiceIface) NewListGuestOsMappingParams() *cloudstack.ListGuestOsMappingParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListGuestOsMappingParams")
+	ret0, _ := ret[0].(*cloudstack.ListGuestOsMappingParams)
+	return ret0
+}
+
+// NewListGuestOsMappingParams indicates an expected call of NewListGuestOsMappingParams.
+func (mr *MockGuestOSServiceIfaceMockRecorder) NewListGuestOsMappingParams() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListGuestOsMappingParams", reflect.TypeOf((*MockGuestOSServiceIface)(nil).NewListGuestOsMappingParams))
+}
+
+// NewListOsCategoriesParams mocks base method.
+func (m *MockGuestOSServiceIface) NewListOsCategoriesParams() *cloudstack.ListOsCategoriesParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListOsCategoriesParams")
+	ret0, _ := ret[0].(*cloudstack.ListOsCategoriesParams)
+	return ret0
+}
+
+// NewListOsCategoriesParams indicates an expected call of NewListOsCategoriesParams.
+func (mr *MockGuestOSServiceIfaceMockRecorder) NewListOsCategoriesParams() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListOsCategoriesParams", reflect.TypeOf((*MockGuestOSServiceIface)(nil).NewListOsCategoriesParams))
+}
+
+// NewListOsTypesParams mocks base method.
+func (m *MockGuestOSServiceIface) NewListOsTypesParams() *cloudstack.ListOsTypesParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListOsTypesParams")
+	ret0, _ := ret[0].(*cloudstack.ListOsTypesParams)
+	return ret0
+}
+
+// NewListOsTypesParams indicates an expected call of NewListOsTypesParams.
+func (mr *MockGuestOSServiceIfaceMockRecorder) NewListOsTypesParams() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListOsTypesParams", reflect.TypeOf((*MockGuestOSServiceIface)(nil).NewListOsTypesParams))
+}
+
+// NewRemoveGuestOsMappingParams mocks base method.
+func (m *MockGuestOSServiceIface) NewRemoveGuestOsMappingParams(id string) *cloudstack.RemoveGuestOsMappingParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewRemoveGuestOsMappingParams", id)
+	ret0, _ := ret[0].(*cloudstack.RemoveGuestOsMappingParams)
+	return ret0
+}
+
+// NewRemoveGuestOsMappingParams indicates an expected call of NewRemoveGuestOsMappingParams.
+func (mr *MockGuestOSServiceIfaceMockRecorder) NewRemoveGuestOsMappingParams(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewRemoveGuestOsMappingParams", reflect.TypeOf((*MockGuestOSServiceIface)(nil).NewRemoveGuestOsMappingParams), id)
+}
+
+// NewRemoveGuestOsParams mocks base method.
+func (m *MockGuestOSServiceIface) NewRemoveGuestOsParams(id string) *cloudstack.RemoveGuestOsParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewRemoveGuestOsParams", id)
+	ret0, _ := ret[0].(*cloudstack.RemoveGuestOsParams)
+	return ret0
+}
+
+// NewRemoveGuestOsParams indicates an expected call of NewRemoveGuestOsParams.
+func (mr *MockGuestOSServiceIfaceMockRecorder) NewRemoveGuestOsParams(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewRemoveGuestOsParams", reflect.TypeOf((*MockGuestOSServiceIface)(nil).NewRemoveGuestOsParams), id)
+}
+
+// NewUpdateGuestOsMappingParams mocks base method.
+func (m *MockGuestOSServiceIface) NewUpdateGuestOsMappingParams(id, osnameforhypervisor string) *cloudstack.UpdateGuestOsMappingParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewUpdateGuestOsMappingParams", id, osnameforhypervisor)
+	ret0, _ := ret[0].(*cloudstack.UpdateGuestOsMappingParams)
+	return ret0
+}
+
+// NewUpdateGuestOsMappingParams indicates an expected call of NewUpdateGuestOsMappingParams.
+func (mr *MockGuestOSServiceIfaceMockRecorder) NewUpdateGuestOsMappingParams(id, osnameforhypervisor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewUpdateGuestOsMappingParams", reflect.TypeOf((*MockGuestOSServiceIface)(nil).NewUpdateGuestOsMappingParams), id, osnameforhypervisor)
+}
+
+// NewUpdateGuestOsParams mocks base method.
+func (m *MockGuestOSServiceIface) NewUpdateGuestOsParams(details map[string]string, id, osdisplayname string) *cloudstack.UpdateGuestOsParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewUpdateGuestOsParams", details, id, osdisplayname)
+	ret0, _ := ret[0].(*cloudstack.UpdateGuestOsParams)
+	return ret0
+}
+
+// NewUpdateGuestOsParams indicates an expected call of NewUpdateGuestOsParams.
+func (mr *MockGuestOSServiceIfaceMockRecorder) NewUpdateGuestOsParams(details, id, osdisplayname interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewUpdateGuestOsParams", reflect.TypeOf((*MockGuestOSServiceIface)(nil).NewUpdateGuestOsParams), details, id, osdisplayname)
+}
+
+// RemoveGuestOs mocks base method.
+func (m *MockGuestOSServiceIface) RemoveGuestOs(p *cloudstack.RemoveGuestOsParams) (*cloudstack.RemoveGuestOsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveGuestOs", p)
+	ret0, _ := ret[0].(*cloudstack.RemoveGuestOsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveGuestOs indicates an expected call of RemoveGuestOs.
+func (mr *MockGuestOSServiceIfaceMockRecorder) RemoveGuestOs(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveGuestOs", reflect.TypeOf((*MockGuestOSServiceIface)(nil).RemoveGuestOs), p)
+}
+
+// RemoveGuestOsMapping mocks base method.
+func (m *MockGuestOSServiceIface) RemoveGuestOsMapping(p *cloudstack.RemoveGuestOsMappingParams) (*cloudstack.RemoveGuestOsMappingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveGuestOsMapping", p)
+	ret0, _ := ret[0].(*cloudstack.RemoveGuestOsMappingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveGuestOsMapping indicates an expected call of RemoveGuestOsMapping.
+func (mr *MockGuestOSServiceIfaceMockRecorder) RemoveGuestOsMapping(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveGuestOsMapping", reflect.TypeOf((*MockGuestOSServiceIface)(nil).RemoveGuestOsMapping), p)
+}
+
+// UpdateGuestOs mocks base method.
+func (m *MockGuestOSServiceIface) UpdateGuestOs(p *cloudstack.UpdateGuestOsParams) (*cloudstack.UpdateGuestOsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGuestOs", p)
+	ret0, _ := ret[0].(*cloudstack.UpdateGuestOsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateGuestOs indicates an expected call of UpdateGuestOs.
+func (mr *MockGuestOSServiceIfaceMockRecorder) UpdateGuestOs(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGuestOs", reflect.TypeOf((*MockGuestOSServiceIface)(nil).UpdateGuestOs), p)
+}
+
+// UpdateGuestOsMapping mocks base method.
+func (m *MockGuestOSServiceIface) UpdateGuestOsMapping(p *cloudstack.UpdateGuestOsMappingParams) (*cloudstack.UpdateGuestOsMappingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGuestOsMapping", p)
+	ret0, _ := ret[0].(*cloudstack.UpdateGuestOsMappingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateGuestOsMapping indicates an expected call of UpdateGuestOsMapping.
+func (mr *MockGuestOSServiceIfaceMockRecorder) UpdateGuestOsMapping(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGuestOsMapping", reflect.TypeOf((*MockGuestOSServiceIface)(nil).UpdateGuestOsMapping), p)
+}
@@ -0,0 +1,170 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../cloudstack/NuageVSPService.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	cloudstack "github.com/xanzy/go-cloudstack/v2/cloudstack"
+)
+
+// MockNuageVSPServiceIface is a mock of NuageVSPServiceIface interface.
+type MockNuageVSPServiceIface struct {
+	ctrl     *gomock.Controller
+	recorder *MockNuageVSPServiceIfaceMockRecorder
+}
+
+// MockNuageVSPServiceIfaceMockRecorder is the mock recorder for MockNuageVSPServiceIface.
+type MockNuageVSPServiceIfaceMockRecorder struct {
+	mock *MockNuageVSPServiceIface
+}
+
+// NewMockNuageVSPServiceIface creates a new mock instance.
+func NewMockNuageVSPServiceIface(ctrl *gomock.Controller) *MockNuageVSPServiceIface {
+	mock := &MockNuageVSPServiceIface{ctrl: ctrl}
+	mock.recorder = &MockNuageVSPServiceIfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNuageVSPServiceIface) EXPECT() *MockNuageVSPServiceIfaceMockRecorder {
+	return m.recorder
+}
+
+// AddNuageVspDevice mocks base method.
+func (m *MockNuageVSPServiceIface) AddNuageVspDevice(p *cloudstack.AddNuageVspDeviceParams) (*cloudstack.AddNuageVspDeviceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddNuageVspDevice", p)
+	ret0, _ := ret[0].(*cloudstack.AddNuageVspDeviceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddNuageVspDevice indicates an expected call of AddNuageVspDevice.
+func (mr *MockNuageVSPServiceIfaceMockRecorder) AddNuageVspDevice(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNuageVspDevice", reflect.TypeOf((*MockNuageVSPServiceIface)(nil).AddNuageVspDevice), p)
+}
+
+// DeleteNuageVspDevice mocks base method.
+func (m *MockNuageVSPServiceIface) DeleteNuageVspDevice(p *cloudstack.DeleteNuageVspDeviceParams) (*cloudstack.DeleteNuageVspDeviceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNuageVspDevice", p)
+	ret0, _ := ret[0].(*cloudstack.DeleteNuageVspDeviceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNuageVspDevice indicates an expected call of DeleteNuageVspDevice.
+func (mr *MockNuageVSPServiceIfaceMockRecorder) DeleteNuageVspDevice(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNuageVspDevice", reflect.TypeOf((*MockNuageVSPServiceIface)(nil).DeleteNuageVspDevice), p)
+}
+
+// ListNuageVspDevices mocks base method.
+func (m *MockNuageVSPServiceIface) ListNuageVspDevices(p *cloudstack.ListNuageVspDevicesParams) (*cloudstack.ListNuageVspDevicesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNuageVspDevices", p)
+	ret0, _ := ret[0].(*cloudstack.ListNuageVspDevicesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNuageVspDevices indicates an expected call of ListNuageVspDevices.
+func (mr *MockNuageVSPServiceIfaceMockRecorder) ListNuageVspDevices(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNuageVspDevices", reflect.TypeOf((*MockNuageVSPServiceIface)(nil).ListNuageVspDevices), p)
+}
+
+// NewAddNuageVspDeviceParams mocks base method.
+func (m *MockNuageVSPServiceIface) NewAddNuageVspDeviceParams(hostname, password, physicalnetworkid string, port int, username string) *cloudstack.AddNuageVspDeviceParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewAddNuageVspDeviceParams", hostname, password, physicalnetworkid, port, username)
+	ret0, _ := ret[0].(*cloudstack.AddNuageVspDeviceParams)
+	return ret0
+}
+
+// NewAddNuageVspDeviceParams indicates an expected call of NewAddNuageVspDeviceParams.
+func (mr *MockNuageVSPServiceIfaceMockRecorder) NewAddNuageVspDeviceParams(hostname, password, physicalnetworkid, port, username interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewAddNuageVspDeviceParams", reflect.TypeOf((*MockNuageVSPServiceIface)(nil).NewAddNuageVspDeviceParams), hostname, password, physicalnetworkid, port, username)
+}
+
+// NewDeleteNuageVspDeviceParams mocks base method.
+func (m *MockNuageVSPServiceIface) NewDeleteNuageVspDeviceParams(vspdeviceid string) *cloudstack.DeleteNuageVspDeviceParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewDeleteNuageVspDeviceParams", vspdeviceid)
+	ret0, _ := ret[0].(*cloudstack.DeleteNuageVspDeviceParams)
+	return ret0
+}
+
+// NewDeleteNuageVspDeviceParams indicates an expected call of NewDeleteNuageVspDeviceParams.
+func (mr *MockNuageVSPServiceIfaceMockRecorder) NewDeleteNuageVspDeviceParams(vspdeviceid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewDeleteNuageVspDeviceParams", reflect.TypeOf((*MockNuageVSPServiceIface)(nil).NewDeleteNuageVspDeviceParams), vspdeviceid)
+}
+
+// NewListNuageVspDevicesParams mocks base method.
+func (m *MockNuageVSPServiceIface) NewListNuageVspDevicesParams() *cloudstack.ListNuageVspDevicesParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListNuageVspDevicesParams")
+	ret0, _ := ret[0].(*cloudstack.ListNuageVspDevicesParams)
+	return ret0
+}
+
+// NewListNuageVspDevicesParams indicates an expected call of NewListNuageVspDevicesParams.
+func (mr *MockNuageVSPServiceIfaceMockRecorder) NewListNuageVspDevicesParams() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListNuageVspDevicesParams", reflect.TypeOf((*MockNuageVSPServiceIface)(nil).NewListNuageVspDevicesParams))
+}
+
+// NewUpdateNuageVspDeviceParams mocks base method.
+func (m *MockNuageVSPServiceIface) NewUpdateNuageVspDeviceParams(physicalnetworkid string) *cloudstack.UpdateNuageVspDeviceParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewUpdateNuageVspDeviceParams", physicalnetworkid)
+	ret0, _ := ret[0].(*cloudstack.UpdateNuageVspDeviceParams)
+	return ret0
+}
+
+// NewUpdateNuageVspDeviceParams indicates an expected call of NewUpdateNuageVspDeviceParams.
+func (mr *MockNuageVSPServiceIfaceMockRecorder) NewUpdateNuageVspDeviceParams(physicalnetworkid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewUpdateNuageVspDeviceParams", reflect.TypeOf((*MockNuageVSPServiceIface)(nil).NewUpdateNuageVspDeviceParams), physicalnetworkid)
+}
+
+// UpdateNuageVspDevice mocks base method.
+func (m *MockNuageVSPServiceIface) UpdateNuageVspDevice(p *cloudstack.UpdateNuageVspDeviceParams) (*cloudstack.UpdateNuageVspDeviceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNuageVspDevice", p)
+	ret0, _ := ret[0].(*cloudstack.UpdateNuageVspDeviceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNuageVspDevice indicates an expected call of UpdateNuageVspDevice.
+func (mr *MockNuageVSPServiceIfaceMockRecorder) UpdateNuageVspDevice(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNuageVspDevice", reflect.TypeOf((*MockNuageVSPServiceIface)(nil).UpdateNuageVspDevice), p)
+}
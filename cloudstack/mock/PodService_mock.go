@@ -0,0 +1,320 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../cloudstack/PodService.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	cloudstack "github.com/xanzy/go-cloudstack/v2/cloudstack"
+)
+
+// MockPodServiceIface is a mock of PodServiceIface interface.
+type MockPodServiceIface struct {
+	ctrl     *gomock.Controller
+	recorder *MockPodServiceIfaceMockRecorder
+}
+
+// MockPodServiceIfaceMockRecorder is the mock recorder for MockPodServiceIface.
+type MockPodServiceIfaceMockRecorder struct {
+	mock *MockPodServiceIface
+}
+
+// NewMockPodServiceIface creates a new mock instance.
+func NewMockPodServiceIface(ctrl *gomock.Controller) *MockPodServiceIface {
+	mock := &MockPodServiceIface{ctrl: ctrl}
+	mock.recorder = &MockPodServiceIfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPodServiceIface) EXPECT() *MockPodServiceIfaceMockRecorder {
+	return m.recorder
+}
+
+// CreatePod mocks base method.
+func (m *MockPodServiceIface) CreatePod(p *cloudstack.CreatePodParams) (*cloudstack.CreatePodResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePod", p)
+	ret0, _ := ret[0].(*cloudstack.CreatePodResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePod indicates an expected call of CreatePod.
+func (mr *MockPodServiceIfaceMockRecorder) CreatePod(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePod", reflect.TypeOf((*MockPodServiceIface)(nil).CreatePod), p)
+}
+
+// DedicatePod mocks base method.
+func (m *MockPodServiceIface) DedicatePod(p *cloudstack.DedicatePodParams) (*cloudstack.DedicatePodResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DedicatePod", p)
+	ret0, _ := ret[0].(*cloudstack.DedicatePodResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DedicatePod indicates an expected call of DedicatePod.
+func (mr *MockPodServiceIfaceMockRecorder) DedicatePod(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DedicatePod", reflect.TypeOf((*MockPodServiceIface)(nil).DedicatePod), p)
+}
+
+// DeletePod mocks base method.
+func (m *MockPodServiceIface) DeletePod(p *cloudstack.DeletePodParams) (*cloudstack.DeletePodResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePod", p)
+	ret0, _ := ret[0].(*cloudstack.DeletePodResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePod indicates an expected call of DeletePod.
+func (mr *MockPodServiceIfaceMockRecorder) DeletePod(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePod", reflect.TypeOf((*MockPodServiceIface)(nil).DeletePod), p)
+}
+
+// GetPodByID mocks base method.
+func (m *MockPodServiceIface) GetPodByID(id string, opts ...cloudstack.OptionFunc) (*cloudstack.Pod, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetPodByID", varargs...)
+	ret0, _ := ret[0].(*cloudstack.Pod)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPodByID indicates an expected call of GetPodByID.
+func (mr *MockPodServiceIfaceMockRecorder) GetPodByID(id interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPodByID", reflect.TypeOf((*MockPodServiceIface)(nil).GetPodByID), varargs...)
+}
+
+// GetPodByName mocks base method.
+func (m *MockPodServiceIface) GetPodByName(name string, opts ...cloudstack.OptionFunc) (*cloudstack.Pod, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetPodByName", varargs...)
+	ret0, _ := ret[0].(*cloudstack.Pod)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPodByName indicates an expected call of GetPodByName.
+func (mr *MockPodServiceIfaceMockRecorder) GetPodByName(name interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPodByName", reflect.TypeOf((*MockPodServiceIface)(nil).GetPodByName), varargs...)
+}
+
+// GetPodID mocks base method.
+func (m *MockPodServiceIface) GetPodID(name string, opts ...cloudstack.OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetPodID", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPodID indicates an expected call of GetPodID.
+func (mr *MockPodServiceIfaceMockRecorder) GetPodID(name interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPodID", reflect.TypeOf((*MockPodServiceIface)(nil).GetPodID), varargs...)
+}
+
+// ListDedicatedPods mocks base method.
+func (m *MockPodServiceIface) ListDedicatedPods(p *cloudstack.ListDedicatedPodsParams) (*cloudstack.ListDedicatedPodsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDedicatedPods", p)
+	ret0, _ := ret[0].(*cloudstack.ListDedicatedPodsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDedicatedPods indicates an expected call of ListDedicatedPods.
+func (mr *MockPodServiceIfaceMockRecorder) ListDedicatedPods(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDedicatedPods", reflect.TypeOf((*MockPodServiceIface)(nil).ListDedicatedPods), p)
+}
+
+// ListPods mocks base method.
+func (m *MockPodServiceIface) ListPods(p *cloudstack.ListPodsParams) (*cloudstack.ListPodsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPods", p)
+	ret0, _ := ret[0].(*cloudstack.ListPodsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPods indicates an expected call of ListPods.
+func (mr *MockPodServiceIfaceMockRecorder) ListPods(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPods", reflect.TypeOf((*MockPodServiceIface)(nil).ListPods), p)
+}
+
+// NewCreatePodParams mocks base method.
+func (m *MockPodServiceIface) NewCreatePodParams(gateway, name, netmask, startip, zoneid string) *cloudstack.CreatePodParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCreatePodParams", gateway, name, netmask, startip, zoneid)
+	ret0, _ := ret[0].(*cloudstack.CreatePodParams)
+	return ret0
+}
+
+// NewCreatePodParams indicates an expected call of NewCreatePodParams.
+func (mr *MockPodServiceIfaceMockRecorder) NewCreatePodParams(gateway, name, netmask, startip, zoneid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCreatePodParams", reflect.TypeOf((*MockPodServiceIface)(nil).NewCreatePodParams), gateway, name, netmask, startip, zoneid)
+}
+
+// NewDedicatePodParams mocks base method.
+func (m *MockPodServiceIface) NewDedicatePodParams(domainid, podid string) *cloudstack.DedicatePodParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewDedicatePodParams", domainid, podid)
+	ret0, _ := ret[0].(*cloudstack.DedicatePodParams)
+	return ret0
+}
+
+// NewDedicatePodParams indicates an expected call of NewDedicatePodParams.
+func (mr *MockPodServiceIfaceMockRecorder) NewDedicatePodParams(domainid, podid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewDedicatePodParams", reflect.TypeOf((*MockPodServiceIface)(nil).NewDedicatePodParams), domainid, podid)
+}
+
+// NewDeletePodParams mocks base method.
+func (m *MockPodServiceIface) NewDeletePodParams(id string) *cloudstack.DeletePodParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewDeletePodParams", id)
+	ret0, _ := ret[0].(*cloudstack.DeletePodParams)
+	return ret0
+}
+
+// NewDeletePodParams indicates an expected call of NewDeletePodParams.
+func (mr *MockPodServiceIfaceMockRecorder) NewDeletePodParams(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewDeletePodParams", reflect.TypeOf((*MockPodServiceIface)(nil).NewDeletePodParams), id)
+}
+
+// NewListDedicatedPodsParams mocks base method.
+func (m *MockPodServiceIface) NewListDedicatedPodsParams() *cloudstack.ListDedicatedPodsParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListDedicatedPodsParams")
+	ret0, _ := ret[0].(*cloudstack.ListDedicatedPodsParams)
+	return ret0
+}
+
+// NewListDedicatedPodsParams indicates an expected call of NewListDedicatedPodsParams.
+func (mr *MockPodServiceIfaceMockRecorder) NewListDedicatedPodsParams() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListDedicatedPodsParams", reflect.TypeOf((*MockPodServiceIface)(nil).NewListDedicatedPodsParams))
+}
+
+// NewListPodsParams mocks base method.
+func (m *MockPodServiceIface) NewListPodsParams() *cloudstack.ListPodsParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListPodsParams")
+	ret0, _ := ret[0].(*cloudstack.ListPodsParams)
+	return ret0
+}
+
+// NewListPodsParams indicates an expected call of NewListPodsParams.
+func (mr *MockPodServiceIfaceMockRecorder) NewListPodsParams() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListPodsParams", reflect.TypeOf((*MockPodServiceIface)(nil).NewListPodsParams))
+}
+
+// NewReleaseDedicatedPodParams mocks base method.
+func (m *MockPodServiceIface) NewReleaseDedicatedPodParams(podid string) *cloudstack.ReleaseDedicatedPodParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewReleaseDedicatedPodParams", podid)
+	ret0, _ := ret[0].(*cloudstack.ReleaseDedicatedPodParams)
+	return ret0
+}
+
+// NewReleaseDedicatedPodParams indicates an expected call of NewReleaseDedicatedPodParams.
+func (mr *MockPodServiceIfaceMockRecorder) NewReleaseDedicatedPodParams(podid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewReleaseDedicatedPodParams", reflect.TypeOf((*MockPodServiceIface)(nil).NewReleaseDedicatedPodParams), podid)
+}
+
+// NewUpdatePodParams mocks base method.
+func (m *MockPodServiceIface) NewUpdatePodParams(id string) *cloudstack.UpdatePodParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewUpdatePodParams", id)
+	ret0, _ := ret[0].(*cloudstack.UpdatePodParams)
+	return ret0
+}
+
+// NewUpdatePodParams indicates an expected call of NewUpdatePodParams.
+func (mr *MockPodServiceIfaceMockRecorder) NewUpdatePodParams(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewUpdatePodParams", reflect.TypeOf((*MockPodServiceIface)(nil).NewUpdatePodParams), id)
+}
+
+// ReleaseDedicatedPod mocks base method.
+func (m *MockPodServiceIface) ReleaseDedicatedPod(p *cloudstack.ReleaseDedicatedPodParams) (*cloudstack.ReleaseDedicatedPodResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseDedicatedPod", p)
+	ret0, _ := ret[0].(*cloudstack.ReleaseDedicatedPodResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseDedicatedPod indicates an expected call of ReleaseDedicatedPod.
+func (mr *MockPodServiceIfaceMockRecorder) ReleaseDedicatedPod(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseDedicatedPod", reflect.TypeOf((*MockPodServiceIface)(nil).ReleaseDedicatedPod), p)
+}
+
+// UpdatePod mocks base method.
+func (m *MockPodServiceIface) UpdatePod(p *cloudstack.UpdatePodParams) (*cloudstack.UpdatePodResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePod", p)
+	ret0, _ := ret[0].(*cloudstack.UpdatePodResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePod indicates an expected call of UpdatePod.
+func (mr *MockPodServiceIfaceMockRecorder) UpdatePod(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePod", reflect.TypeOf((*MockPodServiceIface)(nil).UpdatePod), p)
+}
@@ -0,0 +1,818 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../cloudstack/VirtualMachineService.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	cloudstack "github.com/xanzy/go-cloudstack/v2/cloudstack"
+)
+
+// MockVirtualMachineServiceIface is a mock of VirtualMachineServiceIface interface.
+type MockVirtualMachineServiceIface struct {
+	ctrl     *gomock.Controller
+	recorder *MockVirtualMachineServiceIfaceMockRecorder
+}
+
+// MockVirtualMachineServiceIfaceMockRecorder is the mock recorder for MockVirtualMachineServiceIface.
+type MockVirtualMachineServiceIfaceMockRecorder struct {
+	mock *MockVirtualMachineServiceIface
+}
+
+// NewMockVirtualMachineServiceIface creates a new mock instance.
+func NewMockVirtualMachineServiceIface(ctrl *gomock.Controller) *MockVirtualMachineServiceIface {
+	mock := &MockVirtualMachineServiceIface{ctrl: ctrl}
+	mock.recorder = &MockVirtualMachineServiceIfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVirtualMachineServiceIface) EXPECT() *MockVirtualMachineServiceIfaceMockRecorder {
+	return m.recorder
+}
+
+// AddNicToVirtualMachine mocks base method.
+func (m *MockVirtualMachineServiceIface) AddNicToVirtualMachine(p *cloudstack.AddNicToVirtualMachineParams) (*cloudstack.AddNicToVirtualMachineResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddNicToVirtualMachine", p)
+	ret0, _ := ret[0].(*cloudstack.AddNicToVirtualMachineResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddNicToVirtualMachine indicates an expected call of AddNicToVirtualMachine.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) AddNicToVirtualMachine(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNicToVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).AddNicToVirtualMachine), p)
+}
+
+// AssignVirtualMachine mocks base method.
+func (m *MockVirtualMachineServiceIface) AssignVirtualMachine(p *cloudstack.AssignVirtualMachineParams) (*cloudstack.AssignVirtualMachineResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignVirtualMachine", p)
+	ret0, _ := ret[0].(*cloudstack.AssignVirtualMachineResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssignVirtualMachine indicates an expected call of AssignVirtualMachine.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) AssignVirtualMachine(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).AssignVirtualMachine), p)
+}
+
+// ChangeServiceForVirtualMachine mocks base method.
+func (m *MockVirtualMachineServiceIface) ChangeServiceForVirtualMachine(p *cloudstack.ChangeServiceForVirtualMachineParams) (*cloudstack.ChangeServiceForVirtualMachineResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeServiceForVirtualMachine", p)
+	ret0, _ := ret[0].(*cloudstack.ChangeServiceForVirtualMachineResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeServiceForVirtualMachine indicates an expected call of ChangeServiceForVirtualMachine.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ChangeServiceForVirtualMachine(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeServiceForVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ChangeServiceForVirtualMachine), p)
+}
+
+// CleanVMReservations mocks base method.
+func (m *MockVirtualMachineServiceIface) CleanVMReservations(p *cloudstack.CleanVMReservationsParams) (*cloudstack.CleanVMReservationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CleanVMReservations", p)
+	ret0, _ := ret[0].(*cloudstack.CleanVMReservationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CleanVMReservations indicates an expected call of CleanVMReservations.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) CleanVMReservations(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CleanVMReservations", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).CleanVMReservations), p)
+}
+
+// DeployVirtualMachine mocks base method.
+func (m *MockVirtualMachineServiceIface) DeployVirtualMachine(p *cloudstack.DeployVirtualMachineParams) (*cloudstack.DeployVirtualMachineResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeployVirtualMachine", p)
+	ret0, _ := ret[0].(*cloudstack.DeployVirtualMachineResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeployVirtualMachine indicates an expected call of DeployVirtualMachine.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) DeployVirtualMachine(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).DeployVirtualMachine), p)
+}
+
+// DestroyVirtualMachine mocks base method.
+func (m *MockVirtualMachineServiceIface) DestroyVirtualMachine(p *cloudstack.DestroyVirtualMachineParams) (*cloudstack.DestroyVirtualMachineResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DestroyVirtualMachine", p)
+	ret0, _ := ret[0].(*cloudstack.DestroyVirtualMachineResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DestroyVirtualMachine indicates an expected call of DestroyVirtualMachine.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) DestroyVirtualMachine(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroyVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).DestroyVirtualMachine), p)
+}
+
+// ExpungeVirtualMachine mocks base method.
+func (m *MockVirtualMachineServiceIface) ExpungeVirtualMachine(p *cloudstack.ExpungeVirtualMachineParams) (*cloudstack.ExpungeVirtualMachineResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpungeVirtualMachine", p)
+	ret0, _ := ret[0].(*cloudstack.ExpungeVirtualMachineResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpungeVirtualMachine indicates an expected call of ExpungeVirtualMachine.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ExpungeVirtualMachine(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpungeVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ExpungeVirtualMachine), p)
+}
+
+// GetVMPassword mocks base method.
+func (m *MockVirtualMachineServiceIface) GetVMPassword(p *cloudstack.GetVMPasswordParams) (*cloudstack.GetVMPasswordResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVMPassword", p)
+	ret0, _ := ret[0].(*cloudstack.GetVMPasswordResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVMPassword indicates an expected call of GetVMPassword.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) GetVMPassword(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVMPassword", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).GetVMPassword), p)
+}
+
+// GetVirtualMachineByID mocks base method.
+func (m *MockVirtualMachineServiceIface) GetVirtualMachineByID(id string, opts ...cloudstack.OptionFunc) (*cloudstack.VirtualMachine, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVirtualMachineByID", varargs...)
+	ret0, _ := ret[0].(*cloudstack.VirtualMachine)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVirtualMachineByID indicates an expected call of GetVirtualMachineByID.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) GetVirtualMachineByID(id interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVirtualMachineByID", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).GetVirtualMachineByID), varargs...)
+}
+
+// GetVirtualMachineByName mocks base method.
+func (m *MockVirtualMachineServiceIface) GetVirtualMachineByName(name string, opts ...cloudstack.OptionFunc) (*cloudstack.VirtualMachine, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVirtualMachineByName", varargs...)
+	ret0, _ := ret[0].(*cloudstack.VirtualMachine)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVirtualMachineByName indicates an expected call of GetVirtualMachineByName.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) GetVirtualMachineByName(name interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVirtualMachineByName", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).GetVirtualMachineByName), varargs...)
+}
+
+// GetVirtualMachineID mocks base method.
+func (m *MockVirtualMachineServiceIface) GetVirtualMachineID(name string, opts ...cloudstack.OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVirtualMachineID", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVirtualMachineID indicates an expected call of GetVirtualMachineID.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) GetVirtualMachineID(name interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVirtualMachineID", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).GetVirtualMachineID), varargs...)
+}
+
+// GetVirtualMachinesMetricByID mocks base method.
+func (m *MockVirtualMachineServiceIface) GetVirtualMachinesMetricByID(id string, opts ...cloudstack.OptionFunc) (*cloudstack.VirtualMachinesMetric, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVirtualMachinesMetricByID", varargs...)
+	ret0, _ := ret[0].(*cloudstack.VirtualMachinesMetric)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVirtualMachinesMetricByID indicates an expected call of GetVirtualMachinesMetricByID.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) GetVirtualMachinesMetricByID(id interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVirtualMachinesMetricByID", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).GetVirtualMachinesMetricByID), varargs...)
+}
+
+// GetVirtualMachinesMetricByName mocks base method.
+func (m *MockVirtualMachineServiceIface) GetVirtualMachinesMetricByName(name string, opts ...cloudstack.OptionFunc) (*cloudstack.VirtualMachinesMetric, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVirtualMachinesMetricByName", varargs...)
+	ret0, _ := ret[0].(*cloudstack.VirtualMachinesMetric)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVirtualMachinesMetricByName indicates an expected call of GetVirtualMachinesMetricByName.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) GetVirtualMachinesMetricByName(name interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVirtualMachinesMetricByName", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).GetVirtualMachinesMetricByName), varargs...)
+}
+
+// GetVirtualMachinesMetricID mocks base method.
+func (m *MockVirtualMachineServiceIface) GetVirtualMachinesMetricID(name string, opts ...cloudstack.OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVirtualMachinesMetricID", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVirtualMachinesMetricID indicates an expected call of GetVirtualMachinesMetricID.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) GetVirtualMachinesMetricID(name interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVirtualMachinesMetricID", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).GetVirtualMachinesMetricID), varargs...)
+}
+
+// ListVirtualMachines mocks base method.
+func (m *MockVirtualMachineServiceIface) ListVirtualMachines(p *cloudstack.ListVirtualMachinesParams) (*cloudstack.ListVirtualMachinesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVirtualMachines", p)
+	ret0, _ := ret[0].(*cloudstack.ListVirtualMachinesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVirtualMachines indicates an expected call of ListVirtualMachines.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ListVirtualMachines(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVirtualMachines", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ListVirtualMachines), p)
+}
+
+// ListVirtualMachinesMetrics mocks base method.
+func (m *MockVirtualMachineServiceIface) ListVirtualMachinesMetrics(p *cloudstack.ListVirtualMachinesMetricsParams) (*cloudstack.ListVirtualMachinesMetricsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVirtualMachinesMetrics", p)
+	ret0, _ := ret[0].(*cloudstack.ListVirtualMachinesMetricsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVirtualMachinesMetrics indicates an expected call of ListVirtualMachinesMetrics.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ListVirtualMachinesMetrics(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVirtualMachinesMetrics", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ListVirtualMachinesMetrics), p)
+}
+
+// MigrateVirtualMachine mocks base method.
+func (m *MockVirtualMachineServiceIface) MigrateVirtualMachine(p *cloudstack.MigrateVirtualMachineParams) (*cloudstack.MigrateVirtualMachineResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateVirtualMachine", p)
+	ret0, _ := ret[0].(*cloudstack.MigrateVirtualMachineResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateVirtualMachine indicates an expected call of MigrateVirtualMachine.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) MigrateVirtualMachine(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).MigrateVirtualMachine), p)
+}
+
+// MigrateVirtualMachineWithVolume mocks base method.
+func (m *MockVirtualMachineServiceIface) MigrateVirtualMachineWithVolume(p *cloudstack.MigrateVirtualMachineWithVolumeParams) (*cloudstack.MigrateVirtualMachineWithVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateVirtualMachineWithVolume", p)
+	ret0, _ := ret[0].(*cloudstack.MigrateVirtualMachineWithVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateVirtualMachineWithVolume indicates an expected call of MigrateVirtualMachineWithVolume.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) MigrateVirtualMachineWithVolume(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateVirtualMachineWithVolume", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).MigrateVirtualMachineWithVolume), p)
+}
+
+// NewAddNicToVirtualMachineParams mocks base method.
+func (m *MockVirtualMachineServiceIface) NewAddNicToVirtualMachineParams(networkid, virtualmachineid string) *cloudstack.AddNicToVirtualMachineParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewAddNicToVirtualMachineParams", networkid, virtualmachineid)
+	ret0, _ := ret[0].(*cloudstack.AddNicToVirtualMachineParams)
+	return ret0
+}
+
+// NewAddNicToVirtualMachineParams indicates an expected call of NewAddNicToVirtualMachineParams.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) NewAddNicToVirtualMachineParams(networkid, virtualmachineid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewAddNicToVirtualMachineParams", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).NewAddNicToVirtualMachineParams), networkid, virtualmachineid)
+}
+
+// NewAssignVirtualMachineParams mocks base method.
+func (m *MockVirtualMachineServiceIface) NewAssignVirtualMachineParams(virtualmachineid string) *cloudstack.AssignVirtualMachineParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewAssignVirtualMachineParams", virtualmachineid)
+	ret0, _ := ret[0].(*cloudstack.AssignVirtualMachineParams)
+	return ret0
+}
+
+// NewAssignVirtualMachineParams indicates an expected call of NewAssignVirtualMachineParams.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) NewAssignVirtualMachineParams(virtualmachineid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewAssignVirtualMachineParams", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).NewAssignVirtualMachineParams), virtualmachineid)
+}
+
+// NewChangeServiceForVirtualMachineParams mocks base method.
+func (m *MockVirtualMachineServiceIface) NewChangeServiceForVirtualMachineParams(id, serviceofferingid string) *cloudstack.ChangeServiceForVirtualMachineParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewChangeServiceForVirtualMachineParams", id, serviceofferingid)
+	ret0, _ := ret[0].(*cloudstack.ChangeServiceForVirtualMachineParams)
+	return ret0
+}
+
+// NewChangeServiceForVirtualMachineParams indicates an expected call of NewChangeServiceForVirtualMachineParams.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) NewChangeServiceForVirtualMachineParams(id, serviceofferingid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewChangeServiceForVirtualMachineParams", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).NewChangeServiceForVirtualMachineParams), id, serviceofferingid)
+}
+
+// NewCleanVMReservationsParams mocks base method.
+func (m *MockVirtualMachineServiceIface) NewCleanVMReservationsParams() *cloudstack.CleanVMReservationsParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCleanVMReservationsParams")
+	ret0, _ := ret[0].(*cloudstack.CleanVMReservationsParams)
+	return ret0
+}
+
+// NewCleanVMReservationsParams indicates an expected call of NewCleanVMReservationsParams.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) NewCleanVMReservationsParams() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCleanVMReservationsParams", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).NewCleanVMReservationsParams))
+}
+
+// NewDeployVirtualMachineParams mocks base method.
+func (m *MockVirtualMachineServiceIface) NewDeployVirtualMachineParams(serviceofferingid, templateid, zoneid string) *cloudstack.DeployVirtualMachineParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewDeployVirtualMachineParams", serviceofferingid, templateid, zoneid)
+	ret0, _ := ret[0].(*cloudstack.DeployVirtualMachineParams)
+	return ret0
+}
+
+// NewDeployVirtualMachineParams indicates an expected call of NewDeployVirtualMachineParams.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) NewDeployVirtualMachineParams(serviceofferingid, templateid, zoneid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewDeployVirtualMachineParams", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).NewDeployVirtualMachineParams), serviceofferingid, templateid, zoneid)
+}
+
+// NewDestroyVirtualMachineParams mocks base method.
+func (m *MockVirtualMachineServiceIface) NewDestroyVirtualMachineParams(id string) *cloudstack.DestroyVirtualMachineParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewDestroyVirtualMachineParams", id)
+	ret0, _ := ret[0].(*cloudstack.DestroyVirtualMachineParams)
+	return ret0
+}
+
+// NewDestroyVirtualMachineParams indicates an expected call of NewDestroyVirtualMachineParams.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) NewDestroyVirtualMachineParams(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewDestroyVirtualMachineParams", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).NewDestroyVirtualMachineParams), id)
+}
+
+// NewExpungeVirtualMachineParams mocks base method.
+func (m *MockVirtualMachineServiceIface) NewExpungeVirtualMachineParams(id string) *cloudstack.ExpungeVirtualMachineParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewExpungeVirtualMachineParams", id)
+	ret0, _ := ret[0].(*cloudstack.ExpungeVirtualMachineParams)
+	return ret0
+}
+
+// NewExpungeVirtualMachineParams indicates an expected call of NewExpungeVirtualMachineParams.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) NewExpungeVirtualMachineParams(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewExpungeVirtualMachineParams", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).NewExpungeVirtualMachineParams), id)
+}
+
+// NewGetVMPasswordParams mocks base method.
+func (m *MockVirtualMachineServiceIface) NewGetVMPasswordParams(id string) *cloudstack.GetVMPasswordParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGetVMPasswordParams", id)
+	ret0, _ := ret[0].(*cloudstack.GetVMPasswordParams)
+	return ret0
+}
+
+// NewGetVMPasswordParams indicates an expected call of NewGetVMPasswordParams.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) NewGetVMPasswordParams(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetVMPasswordParams", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).NewGetVMPasswordParams), id)
+}
+
+// NewListVirtualMachinesMetricsParams mocks base method.
+func (m *MockVirtualMachineServiceIface) NewListVirtualMachinesMetricsParams() *cloudstack.ListVirtualMachinesMetricsParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListVirtualMachinesMetricsParams")
+	ret0, _ := ret[0].(*cloudstack.ListVirtualMachinesMetricsParams)
+	return ret0
+}
+
+// NewListVirtualMachinesMetricsParams indicates an expected call of NewListVirtualMachinesMetricsParams.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) NewListVirtualMachinesMetricsParams() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListVirtualMachinesMetricsParams", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).NewListVirtualMachinesMetricsParams))
+}
+
+// NewListVirtualMachinesParams mocks base method.
+func (m *MockVirtualMachineServiceIface) NewListVirtualMachinesParams() *cloudstack.ListVirtualMachinesParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListVirtualMachinesParams")
+	ret0, _ := ret[0].(*cloudstack.ListVirtualMachinesParams)
+	return ret0
+}
+
+// NewListVirtualMachinesParams indicates an expected call of NewListVirtualMachinesParams.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) NewListVirtualMachinesParams() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListVirtualMachinesParams", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).NewListVirtualMachinesParams))
+}
+
+// NewMigrateVirtualMachineParams mocks base method.
+func (m *MockVirtualMachineServiceIface) NewMigrateVirtualMachineParams(virtualmachineid string) *cloudstack.MigrateVirtualMachineParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewMigrateVirtualMachineParams", virtualmachineid)
+	ret0, _ := ret[0].(*cloudstack.MigrateVirtualMachineParams)
+	return ret0
+}
+
+// NewMigrateVirtualMachineParams indicates an expected call of NewMigrateVirtualMachineParams.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) NewMigrateVirtualMachineParams(virtualmachineid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewMigrateVirtualMachineParams", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).NewMigrateVirtualMachineParams), virtualmachineid)
+}
+
+// NewMigrateVirtualMachineWithVolumeParams mocks base method.
+func (m *MockVirtualMachineServiceIface) NewMigrateVirtualMachineWithVolumeParams(hostid, virtualmachineid string) *cloudstack.MigrateVirtualMachineWithVolumeParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewMigrateVirtualMachineWithVolumeParams", hostid, virtualmachineid)
+	ret0, _ := ret[0].(*cloudstack.MigrateVirtualMachineWithVolumeParams)
+	return ret0
+}
+
+// NewMigrateVirtualMachineWithVolumeParams indicates an expected call of NewMigrateVirtualMachineWithVolumeParams.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) NewMigrateVirtualMachineWithVolumeParams(hostid, virtualmachineid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewMigrateVirtualMachineWithVolumeParams", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).NewMigrateVirtualMachineWithVolumeParams), hostid, virtualmachineid)
+}
+
+// NewRebootVirtualMachineParams mocks base method.
+func (m *MockVirtualMachineServiceIface) NewRebootVirtualMachineParams(id string) *cloudstack.RebootVirtualMachineParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewRebootVirtualMachineParams", id)
+	ret0, _ := ret[0].(*cloudstack.RebootVirtualMachineParams)
+	return ret0
+}
+
+// NewRebootVirtualMachineParams indicates an expected call of NewRebootVirtualMachineParams.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) NewRebootVirtualMachineParams(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewRebootVirtualMachineParams", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).NewRebootVirtualMachineParams), id)
+}
+
+// NewRecoverVirtualMachineParams mocks base method.
+func (m *MockVirtualMachineServiceIface) NewRecoverVirtualMachineParams(id string) *cloudstack.RecoverVirtualMachineParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewRecoverVirtualMachineParams", id)
+	ret0, _ := ret[0].(*cloudstack.RecoverVirtualMachineParams)
+	return ret0
+}
+
+// NewRecoverVirtualMachineParams indicates an expected call of NewRecoverVirtualMachineParams.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) NewRecoverVirtualMachineParams(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewRecoverVirtualMachineParams", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).NewRecoverVirtualMachineParams), id)
+}
+
+// NewRemoveNicFromVirtualMachineParams mocks base method.
+func (m *MockVirtualMachineServiceIface) NewRemoveNicFromVirtualMachineParams(nicid, virtualmachineid string) *cloudstack.RemoveNicFromVirtualMachineParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewRemoveNicFromVirtualMachineParams", nicid, virtualmachineid)
+	ret0, _ := ret[0].(*cloudstack.RemoveNicFromVirtualMachineParams)
+	return ret0
+}
+
+// NewRemoveNicFromVirtualMachineParams indicates an expected call of NewRemoveNicFromVirtualMachineParams.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) NewRemoveNicFromVirtualMachineParams(nicid, virtualmachineid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewRemoveNicFromVirtualMachineParams", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).NewRemoveNicFromVirtualMachineParams), nicid, virtualmachineid)
+}
+
+// NewResetPasswordForVirtualMachineParams mocks base method.
+func (m *MockVirtualMachineServiceIface) NewResetPasswordForVirtualMachineParams(id string) *cloudstack.ResetPasswordForVirtualMachineParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewResetPasswordForVirtualMachineParams", id)
+	ret0, _ := ret[0].(*cloudstack.ResetPasswordForVirtualMachineParams)
+	return ret0
+}
+
+// NewResetPasswordForVirtualMachineParams indicates an expected call of NewResetPasswordForVirtualMachineParams.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) NewResetPasswordForVirtualMachineParams(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewResetPasswordForVirtualMachineParams", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).NewResetPasswordForVirtualMachineParams), id)
+}
+
+// NewRestoreVirtualMachineParams mocks base method.
+func (m *MockVirtualMachineServiceIface) NewRestoreVirtualMachineParams(virtualmachineid string) *cloudstack.RestoreVirtualMachineParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewRestoreVirtualMachineParams", virtualmachineid)
+	ret0, _ := ret[0].(*cloudstack.RestoreVirtualMachineParams)
+	return ret0
+}
+
+// NewRestoreVirtualMachineParams indicates an expected call of NewRestoreVirtualMachineParams.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) NewRestoreVirtualMachineParams(virtualmachineid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewRestoreVirtualMachineParams", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).NewRestoreVirtualMachineParams), virtualmachineid)
+}
+
+// NewScaleVirtualMachineParams mocks base method.
+func (m *MockVirtualMachineServiceIface) NewScaleVirtualMachineParams(id, serviceofferingid string) *cloudstack.ScaleVirtualMachineParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewScaleVirtualMachineParams", id, serviceofferingid)
+	ret0, _ := ret[0].(*cloudstack.ScaleVirtualMachineParams)
+	return ret0
+}
+
+// NewScaleVirtualMachineParams indicates an expected call of NewScaleVirtualMachineParams.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) NewScaleVirtualMachineParams(id, serviceofferingid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewScaleVirtualMachineParams", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).NewScaleVirtualMachineParams), id, serviceofferingid)
+}
+
+// NewStartVirtualMachineParams mocks base method.
+func (m *MockVirtualMachineServiceIface) NewStartVirtualMachineParams(id string) *cloudstack.StartVirtualMachineParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewStartVirtualMachineParams", id)
+	ret0, _ := ret[0].(*cloudstack.StartVirtualMachineParams)
+	return ret0
+}
+
+// NewStartVirtualMachineParams indicates an expected call of NewStartVirtualMachineParams.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) NewStartVirtualMachineParams(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewStartVirtualMachineParams", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).NewStartVirtualMachineParams), id)
+}
+
+// NewStopVirtualMachineParams mocks base method.
+func (m *MockVirtualMachineServiceIface) NewStopVirtualMachineParams(id string) *cloudstack.StopVirtualMachineParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewStopVirtualMachineParams", id)
+	ret0, _ := ret[0].(*cloudstack.StopVirtualMachineParams)
+	return ret0
+}
+
+// NewStopVirtualMachineParams indicates an expected call of NewStopVirtualMachineParams.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) NewStopVirtualMachineParams(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewStopVirtualMachineParams", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).NewStopVirtualMachineParams), id)
+}
+
+// NewUpdateDefaultNicForVirtualMachineParams mocks base method.
+func (m *MockVirtualMachineServiceIface) NewUpdateDefaultNicForVirtualMachineParams(nicid, virtualmachineid string) *cloudstack.UpdateDefaultNicForVirtualMachineParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewUpdateDefaultNicForVirtualMachineParams", nicid, virtualmachineid)
+	ret0, _ := ret[0].(*cloudstack.UpdateDefaultNicForVirtualMachineParams)
+	return ret0
+}
+
+// NewUpdateDefaultNicForVirtualMachineParams indicates an expected call of NewUpdateDefaultNicForVirtualMachineParams.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) NewUpdateDefaultNicForVirtualMachineParams(nicid, virtualmachineid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewUpdateDefaultNicForVirtualMachineParams", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).NewUpdateDefaultNicForVirtualMachineParams), nicid, virtualmachineid)
+}
+
+// NewUpdateVirtualMachineParams mocks base method.
+func (m *MockVirtualMachineServiceIface) NewUpdateVirtualMachineParams(id string) *cloudstack.UpdateVirtualMachineParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewUpdateVirtualMachineParams", id)
+	ret0, _ := ret[0].(*cloudstack.UpdateVirtualMachineParams)
+	return ret0
+}
+
+// NewUpdateVirtualMachineParams indicates an expected call of NewUpdateVirtualMachineParams.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) NewUpdateVirtualMachineParams(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewUpdateVirtualMachineParams", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).NewUpdateVirtualMachineParams), id)
+}
+
+// RebootVirtualMachine mocks base method.
+func (m *MockVirtualMachineServiceIface) RebootVirtualMachine(p *cloudstack.RebootVirtualMachineParams) (*cloudstack.RebootVirtualMachineResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebootVirtualMachine", p)
+	ret0, _ := ret[0].(*cloudstack.RebootVirtualMachineResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RebootVirtualMachine indicates an expected call of RebootVirtualMachine.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) RebootVirtualMachine(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebootVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).RebootVirtualMachine), p)
+}
+
+// RecoverVirtualMachine mocks base method.
+func (m *MockVirtualMachineServiceIface) RecoverVirtualMachine(p *cloudstack.RecoverVirtualMachineParams) (*cloudstack.RecoverVirtualMachineResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecoverVirtualMachine", p)
+	ret0, _ := ret[0].(*cloudstack.RecoverVirtualMachineResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecoverVirtualMachine indicates an expected call of RecoverVirtualMachine.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) RecoverVirtualMachine(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecoverVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).RecoverVirtualMachine), p)
+}
+
+// RemoveNicFromVirtualMachine mocks base method.
+func (m *MockVirtualMachineServiceIface) RemoveNicFromVirtualMachine(p *cloudstack.RemoveNicFromVirtualMachineParams) (*cloudstack.RemoveNicFromVirtualMachineResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveNicFromVirtualMachine", p)
+	ret0, _ := ret[0].(*cloudstack.RemoveNicFromVirtualMachineResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveNicFromVirtualMachine indicates an expected call of RemoveNicFromVirtualMachine.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) RemoveNicFromVirtualMachine(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveNicFromVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).RemoveNicFromVirtualMachine), p)
+}
+
+// ResetPasswordForVirtualMachine mocks base method.
+func (m *MockVirtualMachineServiceIface) ResetPasswordForVirtualMachine(p *cloudstack.ResetPasswordForVirtualMachineParams) (*cloudstack.ResetPasswordForVirtualMachineResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetPasswordForVirtualMachine", p)
+	ret0, _ := ret[0].(*cloudstack.ResetPasswordForVirtualMachineResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetPasswordForVirtualMachine indicates an expected call of ResetPasswordForVirtualMachine.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ResetPasswordForVirtualMachine(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPasswordForVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ResetPasswordForVirtualMachine), p)
+}
+
+// RestoreVirtualMachine mocks base method.
+func (m *MockVirtualMachineServiceIface) RestoreVirtualMachine(p *cloudstack.RestoreVirtualMachineParams) (*cloudstack.RestoreVirtualMachineResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreVirtualMachine", p)
+	ret0, _ := ret[0].(*cloudstack.RestoreVirtualMachineResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreVirtualMachine indicates an expected call of RestoreVirtualMachine.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) RestoreVirtualMachine(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).RestoreVirtualMachine), p)
+}
+
+// ScaleVirtualMachine mocks base method.
+func (m *MockVirtualMachineServiceIface) ScaleVirtualMachine(p *cloudstack.ScaleVirtualMachineParams) (*cloudstack.ScaleVirtualMachineResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScaleVirtualMachine", p)
+	ret0, _ := ret[0].(*cloudstack.ScaleVirtualMachineResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScaleVirtualMachine indicates an expected call of ScaleVirtualMachine.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ScaleVirtualMachine(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScaleVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ScaleVirtualMachine), p)
+}
+
+// StartVirtualMachine mocks base method.
+func (m *MockVirtualMachineServiceIface) StartVirtualMachine(p *cloudstack.StartVirtualMachineParams) (*cloudstack.StartVirtualMachineResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartVirtualMachine", p)
+	ret0, _ := ret[0].(*cloudstack.StartVirtualMachineResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartVirtualMachine indicates an expected call of StartVirtualMachine.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) StartVirtualMachine(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).StartVirtualMachine), p)
+}
+
+// StopVirtualMachine mocks base method.
+func (m *MockVirtualMachineServiceIface) StopVirtualMachine(p *cloudstack.StopVirtualMachineParams) (*cloudstack.StopVirtualMachineResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopVirtualMachine", p)
+	ret0, _ := ret[0].(*cloudstack.StopVirtualMachineResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StopVirtualMachine indicates an expected call of StopVirtualMachine.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) StopVirtualMachine(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).StopVirtualMachine), p)
+}
+
+// UpdateDefaultNicForVirtualMachine mocks base method.
+func (m *MockVirtualMachineServiceIface) UpdateDefaultNicForVirtualMachine(p *cloudstack.UpdateDefaultNicForVirtualMachineParams) (*cloudstack.UpdateDefaultNicForVirtualMachineResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDefaultNicForVirtualMachine", p)
+	ret0, _ := ret[0].(*cloudstack.UpdateDefaultNicForVirtualMachineResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDefaultNicForVirtualMachine indicates an expected call of UpdateDefaultNicForVirtualMachine.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) UpdateDefaultNicForVirtualMachine(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDefaultNicForVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).UpdateDefaultNicForVirtualMachine), p)
+}
+
+// UpdateVirtualMachine mocks base method.
+func (m *MockVirtualMachineServiceIface) UpdateVirtualMachine(p *cloudstack.UpdateVirtualMachineParams) (*cloudstack.UpdateVirtualMachineResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVirtualMachine", p)
+	ret0, _ := ret[0].(*cloudstack.UpdateVirtualMachineResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVirtualMachine indicates an expected call of UpdateVirtualMachine.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) UpdateVirtualMachine(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).UpdateVirtualMachine), p)
+}
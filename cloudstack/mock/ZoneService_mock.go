@@ -0,0 +1,378 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../cloudstack/ZoneService.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	cloudstack "github.com/xanzy/go-cloudstack/v2/cloudstack"
+)
+
+// MockZoneServiceIface is a mock of ZoneServiceIface interface.
+type MockZoneServiceIface struct {
+	ctrl     *gomock.Controller
+	recorder *MockZoneServiceIfaceMockRecorder
+}
+
+// MockZoneServiceIfaceMockRecorder is the mock recorder for MockZoneServiceIface.
+type MockZoneServiceIfaceMockRecorder struct {
+	mock *MockZoneServiceIface
+}
+
+// NewMockZoneServiceIface creates a new mock instance.
+func NewMockZoneServiceIface(ctrl *gomock.Controller) *MockZoneServiceIface {
+	mock := &MockZoneServiceIface{ctrl: ctrl}
+	mock.recorder = &MockZoneServiceIfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockZoneServiceIface) EXPECT() *MockZoneServiceIfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateZone mocks base method.
+func (m *MockZoneServiceIface) CreateZone(p *cloudstack.CreateZoneParams) (*cloudstack.CreateZoneResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateZone", p)
+	ret0, _ := ret[0].(*cloudstack.CreateZoneResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateZone indicates an expected call of CreateZone.
+func (mr *MockZoneServiceIfaceMockRecorder) CreateZone(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateZone", reflect.TypeOf((*MockZoneServiceIface)(nil).CreateZone), p)
+}
+
+// DedicateZone mocks base method.
+func (m *MockZoneServiceIface) DedicateZone(p *cloudstack.DedicateZoneParams) (*cloudstack.DedicateZoneResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DedicateZone", p)
+	ret0, _ := ret[0].(*cloudstack.DedicateZoneResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DedicateZone indicates an expected call of DedicateZone.
+func (mr *MockZoneServiceIfaceMockRecorder) DedicateZone(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DedicateZone", reflect.TypeOf((*MockZoneServiceIface)(nil).DedicateZone), p)
+}
+
+// DeleteZone mocks base method.
+func (m *MockZoneServiceIface) DeleteZone(p *cloudstack.DeleteZoneParams) (*cloudstack.DeleteZoneResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteZone", p)
+	ret0, _ := ret[0].(*cloudstack.DeleteZoneResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteZone indicates an expected call of DeleteZone.
+func (mr *MockZoneServiceIfaceMockRecorder) DeleteZone(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteZone", reflect.TypeOf((*MockZoneServiceIface)(nil).DeleteZone), p)
+}
+
+// DisableOutOfBandManagementForZone mocks base method.
+func (m *MockZoneServiceIface) DisableOutOfBandManagementForZone(p *cloudstack.DisableOutOfBandManagementForZoneParams) (*cloudstack.DisableOutOfBandManagementForZoneResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableOutOfBandManagementForZone", p)
+	ret0, _ := ret[0].(*cloudstack.DisableOutOfBandManagementForZoneResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisableOutOfBandManagementForZone indicates an expected call of DisableOutOfBandManagementForZone.
+func (mr *MockZoneServiceIfaceMockRecorder) DisableOutOfBandManagementForZone(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableOutOfBandManagementForZone", reflect.TypeOf((*MockZoneServiceIface)(nil).DisableOutOfBandManagementForZone), p)
+}
+
+// EnableOutOfBandManagementForZone mocks base method.
+func (m *MockZoneServiceIface) EnableOutOfBandManagementForZone(p *cloudstack.EnableOutOfBandManagementForZoneParams) (*cloudstack.EnableOutOfBandManagementForZoneResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableOutOfBandManagementForZone", p)
+	ret0, _ := ret[0].(*cloudstack.EnableOutOfBandManagementForZoneResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnableOutOfBandManagementForZone indicates an expected call of EnableOutOfBandManagementForZone.
+func (mr *MockZoneServiceIfaceMockRecorder) EnableOutOfBandManagementForZone(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableOutOfBandManagementForZone", reflect.TypeOf((*MockZoneServiceIface)(nil).EnableOutOfBandManagementForZone), p)
+}
+
+// GetZoneByID mocks base method.
+func (m *MockZoneServiceIface) GetZoneByID(id string, opts ...cloudstack.OptionFunc) (*cloudstack.Zone, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetZoneByID", varargs...)
+	ret0, _ := ret[0].(*cloudstack.Zone)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetZoneByID indicates an expected call of GetZoneByID.
+func (mr *MockZoneServiceIfaceMockRecorder) GetZoneByID(id interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetZoneByID", reflect.TypeOf((*MockZoneServiceIface)(nil).GetZoneByID), varargs...)
+}
+
+// GetZoneByName mocks base method.
+func (m *MockZoneServiceIface) GetZoneByName(name string, opts ...cloudstack.OptionFunc) (*cloudstack.Zone, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetZoneByName", varargs...)
+	ret0, _ := ret[0].(*cloudstack.Zone)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetZoneByName indicates an expected call of GetZoneByName.
+func (mr *MockZoneServiceIfaceMockRecorder) GetZoneByName(name interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetZoneByName", reflect.TypeOf((*MockZoneServiceIface)(nil).GetZoneByName), varargs...)
+}
+
+// GetZoneID mocks base method.
+func (m *MockZoneServiceIface) GetZoneID(name string, opts ...cloudstack.OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetZoneID", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetZoneID indicates an expected call of GetZoneID.
+func (mr *MockZoneServiceIfaceMockRecorder) GetZoneID(name interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetZoneID", reflect.TypeOf((*MockZoneServiceIface)(nil).GetZoneID), varargs...)
+}
+
+// ListDedicatedZones mocks base method.
+func (m *MockZoneServiceIface) ListDedicatedZones(p *cloudstack.ListDedicatedZonesParams) (*cloudstack.ListDedicatedZonesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDedicatedZones", p)
+	ret0, _ := ret[0].(*cloudstack.ListDedicatedZonesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDedicatedZones indicates an expected call of ListDedicatedZones.
+func (mr *MockZoneServiceIfaceMockRecorder) ListDedicatedZones(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDedicatedZones", reflect.TypeOf((*MockZoneServiceIface)(nil).ListDedicatedZones), p)
+}
+
+// ListZones mocks base method.
+func (m *MockZoneServiceIface) ListZones(p *cloudstack.ListZonesParams) (*cloudstack.ListZonesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListZones", p)
+	ret0, _ := ret[0].(*cloudstack.ListZonesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListZones indicates an expected call of ListZones.
+func (mr *MockZoneServiceIfaceMockRecorder) ListZones(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListZones", reflect.TypeOf((*MockZoneServiceIface)(nil).ListZones), p)
+}
+
+// NewCreateZoneParams mocks base method.
+func (m *MockZoneServiceIface) NewCreateZoneParams(dns1, internaldns1, name, networktype string) *cloudstack.CreateZoneParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewCreateZoneParams", dns1, internaldns1, name, networktype)
+	ret0, _ := ret[0].(*cloudstack.CreateZoneParams)
+	return ret0
+}
+
+// NewCreateZoneParams indicates an expected call of NewCreateZoneParams.
+func (mr *MockZoneServiceIfaceMockRecorder) NewCreateZoneParams(dns1, internaldns1, name, networktype interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCreateZoneParams", reflect.TypeOf((*MockZoneServiceIface)(nil).NewCreateZoneParams), dns1, internaldns1, name, networktype)
+}
+
+// NewDedicateZoneParams mocks base method.
+func (m *MockZoneServiceIface) NewDedicateZoneParams(domainid, zoneid string) *cloudstack.DedicateZoneParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewDedicateZoneParams", domainid, zoneid)
+	ret0, _ := ret[0].(*cloudstack.DedicateZoneParams)
+	return ret0
+}
+
+// NewDedicateZoneParams indicates an expected call of NewDedicateZoneParams.
+func (mr *MockZoneServiceIfaceMockRecorder) NewDedicateZoneParams(domainid, zoneid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewDedicateZoneParams", reflect.TypeOf((*MockZoneServiceIface)(nil).NewDedicateZoneParams), domainid, zoneid)
+}
+
+// NewDeleteZoneParams mocks base method.
+func (m *MockZoneServiceIface) NewDeleteZoneParams(id string) *cloudstack.DeleteZoneParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewDeleteZoneParams", id)
+	ret0, _ := ret[0].(*cloudstack.DeleteZoneParams)
+	return ret0
+}
+
+// NewDeleteZoneParams indicates an expected call of NewDeleteZoneParams.
+func (mr *MockZoneServiceIfaceMockRecorder) NewDeleteZoneParams(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewDeleteZoneParams", reflect.TypeOf((*MockZoneServiceIface)(nil).NewDeleteZoneParams), id)
+}
+
+// NewDisableOutOfBandManagementForZoneParams mocks base method.
+func (m *MockZoneServiceIface) NewDisableOutOfBandManagementForZoneParams(zoneid string) *cloudstack.DisableOutOfBandManagementForZoneParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewDisableOutOfBandManagementForZoneParams", zoneid)
+	ret0, _ := ret[0].(*cloudstack.DisableOutOfBandManagementForZoneParams)
+	return ret0
+}
+
+// NewDisableOutOfBandManagementForZoneParams indicates an expected call of NewDisableOutOfBandManagementForZoneParams.
+func (mr *MockZoneServiceIfaceMockRecorder) NewDisableOutOfBandManagementForZoneParams(zoneid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewDisableOutOfBandManagementForZoneParams", reflect.TypeOf((*MockZoneServiceIface)(nil).NewDisableOutOfBandManagementForZoneParams), zoneid)
+}
+
+// NewEnableOutOfBandManagementForZoneParams mocks base method.
+func (m *MockZoneServiceIface) NewEnableOutOfBandManagementForZoneParams(zoneid string) *cloudstack.EnableOutOfBandManagementForZoneParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewEnableOutOfBandManagementForZoneParams", zoneid)
+	ret0, _ := ret[0].(*cloudstack.EnableOutOfBandManagementForZoneParams)
+	return ret0
+}
+
+// NewEnableOutOfBandManagementForZoneParams indicates an expected call of NewEnableOutOfBandManagementForZoneParams.
+func (mr *MockZoneServiceIfaceMockRecorder) NewEnableOutOfBandManagementForZoneParams(zoneid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewEnableOutOfBandManagementForZoneParams", reflect.TypeOf((*MockZoneServiceIface)(nil).NewEnableOutOfBandManagementForZoneParams), zoneid)
+}
+
+// NewListDedicatedZonesParams mocks base method.
+func (m *MockZoneServiceIface) NewListDedicatedZonesParams() *cloudstack.ListDedicatedZonesParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListDedicatedZonesParams")
+	ret0, _ := ret[0].(*cloudstack.ListDedicatedZonesParams)
+	return ret0
+}
+
+// NewListDedicatedZonesParams indicates an expected call of NewListDedicatedZonesParams.
+func (mr *MockZoneServiceIfaceMockRecorder) NewListDedicatedZonesParams() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListDedicatedZonesParams", reflect.TypeOf((*MockZoneServiceIface)(nil).NewListDedicatedZonesParams))
+}
+
+// NewListZonesParams mocks base method.
+func (m *MockZoneServiceIface) NewListZonesParams() *cloudstack.ListZonesParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListZonesParams")
+	ret0, _ := ret[0].(*cloudstack.ListZonesParams)
+	return ret0
+}
+
+// NewListZonesParams indicates an expected call of NewListZonesParams.
+func (mr *MockZoneServiceIfaceMockRecorder) NewListZonesParams() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListZonesParams", reflect.TypeOf((*MockZoneServiceIface)(nil).NewListZonesParams))
+}
+
+// NewReleaseDedicatedZoneParams mocks base method.
+func (m *MockZoneServiceIface) NewReleaseDedicatedZoneParams(zoneid string) *cloudstack.ReleaseDedicatedZoneParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewReleaseDedicatedZoneParams", zoneid)
+	ret0, _ := ret[0].(*cloudstack.ReleaseDedicatedZoneParams)
+	return ret0
+}
+
+// NewReleaseDedicatedZoneParams indicates an expected call of NewReleaseDedicatedZoneParams.
+func (mr *MockZoneServiceIfaceMockRecorder) NewReleaseDedicatedZoneParams(zoneid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewReleaseDedicatedZoneParams", reflect.TypeOf((*MockZoneServiceIface)(nil).NewReleaseDedicatedZoneParams), zoneid)
+}
+
+// NewUpdateZoneParams mocks base method.
+func (m *MockZoneServiceIface) NewUpdateZoneParams(id string) *cloudstack.UpdateZoneParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewUpdateZoneParams", id)
+	ret0, _ := ret[0].(*cloudstack.UpdateZoneParams)
+	return ret0
+}
+
+// NewUpdateZoneParams indicates an expected call of NewUpdateZoneParams.
+func (mr *MockZoneServiceIfaceMockRecorder) NewUpdateZoneParams(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewUpdateZoneParams", reflect.TypeOf((*MockZoneServiceIface)(nil).NewUpdateZoneParams), id)
+}
+
+// ReleaseDedicatedZone mocks base method.
+func (m *MockZoneServiceIface) ReleaseDedicatedZone(p *cloudstack.ReleaseDedicatedZoneParams) (*cloudstack.ReleaseDedicatedZoneResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseDedicatedZone", p)
+	ret0, _ := ret[0].(*cloudstack.ReleaseDedicatedZoneResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseDedicatedZone indicates an expected call of ReleaseDedicatedZone.
+func (mr *MockZoneServiceIfaceMockRecorder) ReleaseDedicatedZone(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseDedicatedZone", reflect.TypeOf((*MockZoneServiceIface)(nil).ReleaseDedicatedZone), p)
+}
+
+// UpdateZone mocks base method.
+func (m *MockZoneServiceIface) UpdateZone(p *cloudstack.UpdateZoneParams) (*cloudstack.UpdateZoneResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateZone", p)
+	ret0, _ := ret[0].(*cloudstack.UpdateZoneResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateZone indicates an expected call of UpdateZone.
+func (mr *MockZoneServiceIfaceMockRecorder) UpdateZone(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateZone", reflect.TypeOf((*MockZoneServiceIface)(nil).UpdateZone), p)
+}
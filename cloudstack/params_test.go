@@ -0,0 +1,127 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestListOfObjectParams(t *testing.T) {
+	p := &DeployVirtualMachineParams{}
+	p.SetIptonetworklist([]IPToNetwork{
+		{NetworkID: "n1", IP: "10.0.0.5"},
+		{NetworkID: "n2", IPv6: "fd00::5", MAC: "02:00:00:00:00:05"},
+	})
+	p.SetDatadiskofferinglist([]DataDiskOffering{
+		{DataDiskTemplateID: "t1", DiskOfferingID: "d1"},
+	})
+	p.SetDhcpoptionsnetworklist([]DHCPOptionsNetwork{
+		{NetworkID: "n1", Options: map[string]string{"dhcp:114": "url", "dhcp:66": "server"}},
+	})
+
+	want := url.Values{
+		"iptonetworklist[0].networkid":               {"n1"},
+		"iptonetworklist[0].ip":                      {"10.0.0.5"},
+		"iptonetworklist[1].networkid":               {"n2"},
+		"iptonetworklist[1].ipv6":                    {"fd00::5"},
+		"iptonetworklist[1].mac":                     {"02:00:00:00:00:05"},
+		"datadiskofferinglist[0].datadisktemplateid": {"t1"},
+		"datadiskofferinglist[0].diskofferingid":     {"d1"},
+		"dhcpoptionsnetworklist[0].networkid":        {"n1"},
+		"dhcpoptionsnetworklist[0].dhcp:114":         {"url"},
+		"dhcpoptionsnetworklist[0].dhcp:66":          {"server"},
+	}
+
+	got := p.toURLValues()
+	for k := range got {
+		if _, ok := want[k]; !ok && k != "response" {
+			t.Errorf("Unexpected parameter %s=%s", k, got.Get(k))
+		}
+	}
+	for k, v := range want {
+		if !reflect.DeepEqual(got[k], v) {
+			t.Errorf("Expected %s=%v, got %v", k, v, got[k])
+		}
+	}
+}
+
+func TestServiceCapabilityList(t *testing.T) {
+	p := &CreateVPCOfferingParams{}
+	p.SetServicecapabilitylist([]ServiceCapability{
+		{Service: "SourceNat", CapabilityType: "RedundantRouter", CapabilityValue: "true"},
+		{Service: "Connectivity", CapabilityType: "RegionLevelVpc"},
+	})
+
+	got := p.toURLValues()
+	want := map[string]string{
+		"servicecapabilitylist[0].service":         "SourceNat",
+		"servicecapabilitylist[0].capabilitytype":  "RedundantRouter",
+		"servicecapabilitylist[0].capabilityvalue": "true",
+		"servicecapabilitylist[1].service":         "Connectivity",
+		"servicecapabilitylist[1].capabilitytype":  "RegionLevelVpc",
+	}
+	for k, v := range want {
+		if got.Get(k) != v {
+			t.Errorf("Expected %s=%s, got %q", k, v, got.Get(k))
+		}
+	}
+	if _, ok := got["servicecapabilitylist[1].capabilityvalue"]; ok {
+		t.Error("Expected empty fields to be left out")
+	}
+}
+
+func TestGSLBRuleWeightsMap(t *testing.T) {
+	p := &AssignToGlobalLoadBalancerRuleParams{}
+	p.SetId("gslb1")
+	p.SetLoadbalancerrulelist([]string{"lb2", "lb1"})
+	p.SetGslblbruleweightsmap(map[string]string{"lb2": "3", "lb1": "1"})
+
+	// The entries are sorted by load balancer rule ID
+	want := url.Values{
+		"id":                                     {"gslb1"},
+		"loadbalancerrulelist":                   {"lb2,lb1"},
+		"gslblbruleweightsmap[0].loadbalancerid": {"lb1"},
+		"gslblbruleweightsmap[0].weight":         {"1"},
+		"gslblbruleweightsmap[1].loadbalancerid": {"lb2"},
+		"gslblbruleweightsmap[1].weight":         {"3"},
+	}
+
+	if got := p.toURLValues(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("Expected %v, got %v", want, got)
+	}
+}
+
+func TestParamsCopy(t *testing.T) {
+	p := &ListVirtualMachinesParams{}
+	p.SetState("Running")
+
+	c := p.Copy()
+	c.SetPage(2)
+	c.SetState("Stopped")
+
+	if got := p.toURLValues(); !reflect.DeepEqual(got, url.Values{"state": {"Running"}}) {
+		t.Fatalf("Expected the original params not to change, got %v", got)
+	}
+	if got := c.toURLValues(); !reflect.DeepEqual(got, url.Values{"page": {"2"}, "state": {"Stopped"}}) {
+		t.Fatalf("Expected the changed copy, got %v", got)
+	}
+}
@@ -0,0 +1,276 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// RegistryOption can be passed to NewRegistry to set custom options
+type RegistryOption func(*Registry)
+
+// RegionErrors contains the errors (per region name) returned by a fan-out call
+type RegionErrors map[string]error
+
+func (e RegionErrors) Error() string {
+	names := make([]string, 0, len(e))
+	for name := range e {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	msgs := make([]string, 0, len(e))
+	for _, name := range names {
+		msgs = append(msgs, fmt.Sprintf("%s: %v", name, e[name]))
+	}
+	return fmt.Sprintf("%d region(s) failed: %s", len(e), strings.Join(msgs, "; "))
+}
+
+type regionCredentials struct {
+	apiKey string
+	secret string
+}
+
+// Registry discovers the regions known by a seed client and creates and caches
+// a client for each of them. By default the region clients use the credentials
+// and settings of the seed client, and a copy of its HTTP client.
+type Registry struct {
+	sync.Mutex
+
+	seed          *CloudStackClient
+	credentials   map[string]regionCredentials
+	options       []ClientOption
+	regionOptions map[string][]ClientOption
+
+	discovered bool
+	endpoints  map[string]string
+	clients    map[string]*CloudStackClient
+}
+
+// NewRegistry creates a new registry using the given client to discover the regions
+func NewRegistry(seed *CloudStackClient, options ...RegistryOption) *Registry {
+	r := &Registry{
+		seed:          seed,
+		credentials:   make(map[string]regionCredentials),
+		regionOptions: make(map[string][]ClientOption),
+		endpoints:     make(map[string]string),
+		clients:       make(map[string]*CloudStackClient),
+	}
+
+	for _, fn := range options {
+		fn(r)
+	}
+
+	return r
+}
+
+// WithRegionCredentials sets the API key and secret to use for a specific region,
+// instead of the ones used by the seed client
+func WithRegionCredentials(region string, apikey string, secret string) RegistryOption {
+	return func(r *Registry) {
+		r.credentials[region] = regionCredentials{apiKey: apikey, secret: secret}
+	}
+}
+
+// WithRegionClientOptions sets additional options applied to every region client
+func WithRegionClientOptions(options ...ClientOption) RegistryOption {
+	return func(r *Registry) {
+		r.options = append(r.options, options...)
+	}
+}
+
+// WithRegionOptions sets additional options applied to the client of a specific
+// region, after the options set with WithRegionClientOptions
+func WithRegionOptions(region string, options ...ClientOption) RegistryOption {
+	return func(r *Registry) {
+		r.regionOptions[region] = append(r.regionOptions[region], options...)
+	}
+}
+
+// Discover (re)loads the regions known by the seed client. Regions that are already
+// known are kept, but their endpoint is updated if it changed.
+func (r *Registry) Discover() error {
+	l, err := r.seed.Region.ListRegions(r.seed.Region.NewListRegionsParams())
+	if err != nil {
+		return err
+	}
+
+	r.Lock()
+	defer r.Unlock()
+
+	for _, region := range l.Regions {
+		// Manually registered clients take precedence over discovered ones
+		if apiurl, ok := r.endpoints[region.Name]; ok && apiurl == "" {
+			continue
+		}
+
+		apiurl := regionAPIURL(region.Endpoint)
+		if r.endpoints[region.Name] != apiurl {
+			delete(r.clients, region.Name)
+		}
+		r.endpoints[region.Name] = apiurl
+	}
+	r.discovered = true
+
+	return nil
+}
+
+// Register adds a client for a region or cloud that is not (or cannot be) discovered
+// through the seed client, so it can be used like any other region in the registry.
+func (r *Registry) Register(name string, cs *CloudStackClient) {
+	r.Lock()
+	defer r.Unlock()
+
+	r.endpoints[name] = ""
+	r.clients[name] = cs
+}
+
+// Regions returns the names of all regions in the registry, discovering them if needed
+func (r *Registry) Regions() ([]string, error) {
+	if err := r.discoverOnce(); err != nil {
+		return nil, err
+	}
+
+	r.Lock()
+	defer r.Unlock()
+
+	names := make([]string, 0, len(r.endpoints))
+	for name := range r.endpoints {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names, nil
+}
+
+// Client returns the (cached) client for the given region, discovering the regions if needed
+func (r *Registry) Client(region string) (*CloudStackClient, error) {
+	if err := r.discoverOnce(); err != nil {
+		return nil, err
+	}
+
+	r.Lock()
+	defer r.Unlock()
+
+	if cs, ok := r.clients[region]; ok {
+		return cs, nil
+	}
+
+	apiurl, ok := r.endpoints[region]
+	if !ok {
+		return nil, fmt.Errorf("No region found with name: %s", region)
+	}
+
+	creds, ok := r.credentials[region]
+	if !ok {
+		creds = regionCredentials{apiKey: r.seed.apiKey, secret: r.seed.secret}
+	}
+
+	// Every region gets its own copy of the seed HTTP client and transport, so
+	// TLS options of one region do not change the seed or any other region.
+	options := append([]ClientOption{
+		WithHTTPClient(cloneHTTPClient(r.seed.client)),
+		WithAsyncTimeout(r.seed.timeout),
+	}, r.options...)
+	options = append(options, r.regionOptions[region]...)
+
+	cs := newClient(apiurl, creds.apiKey, creds.secret, r.seed.async, true, options...)
+	cs.HTTPGETOnly = r.seed.HTTPGETOnly
+	cs.DefaultOptions(r.seed.options...)
+
+	r.clients[region] = cs
+
+	return cs, nil
+}
+
+// ForEachRegion calls fn concurrently for every region in the registry. When one
+// or more calls fail, the returned error is of type RegionErrors.
+func (r *Registry) ForEachRegion(fn func(region string, cs *CloudStackClient) error) error {
+	names, err := r.Regions()
+	if err != nil {
+		return err
+	}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	errs := make(RegionErrors)
+
+	for _, name := range names {
+		wg.Add(1)
+		go func(name string) {
+			defer wg.Done()
+
+			cs, err := r.Client(name)
+			if err == nil {
+				err = fn(name, cs)
+			}
+
+			if err != nil {
+				mu.Lock()
+				errs[name] = err
+				mu.Unlock()
+			}
+		}(name)
+	}
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+func (r *Registry) discoverOnce() error {
+	r.Lock()
+	discovered := r.discovered
+	r.Unlock()
+
+	if discovered {
+		return nil
+	}
+
+	return r.Discover()
+}
+
+// regionAPIURL converts a region endpoint (e.g. http://cs.company.com:8080/client/)
+// into the URL of the API (e.g. http://cs.company.com:8080/client/api).
+func regionAPIURL(endpoint string) string {
+	endpoint = strings.TrimSuffix(endpoint, "/")
+	if strings.HasSuffix(endpoint, "/client") {
+		return endpoint + "/api"
+	}
+	return endpoint
+}
+
+// cloneHTTPClient returns a copy of the client with a copy of its transport (if
+// it is an *http.Transport), so changing the TLS config of the copy does not
+// affect the original client
+func cloneHTTPClient(c *http.Client) *http.Client {
+	clone := *c
+	if t, ok := c.Transport.(*http.Transport); ok {
+		clone.Transport = t.Clone()
+	}
+	return &clone
+}
@@ -0,0 +1,238 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"sync/atomic"
+	"testing"
+)
+
+// newRegionServer starts a management server that lists two regions, both
+// pointing back at the server itself
+func newRegionServer(t *testing.T) (*httptest.Server, *int32) {
+	t.Helper()
+
+	var lists int32
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("command") != "listRegions" {
+			w.Write([]byte(`{"listzonesresponse":{"count":0}}`))
+			return
+		}
+		atomic.AddInt32(&lists, 1)
+		fmt.Fprintf(w, `{"listregionsresponse":{"count":2,"region":[`+
+			`{"id":1,"name":"east","endpoint":"%[1]s/client/"},`+
+			`{"id":2,"name":"west","endpoint":"%[1]s/client"}]}}`, srv.URL)
+	}))
+
+	return srv, &lists
+}
+
+func TestRegistry(t *testing.T) {
+	srv, lists := newRegionServer(t)
+	defer srv.Close()
+
+	seed := NewClient(srv.URL+"/client/api", "key", "secret", false)
+	r := NewRegistry(seed, WithRegionCredentials("west", "west-key", "west-secret"))
+
+	names, err := r.Regions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(names, []string{"east", "west"}) {
+		t.Fatalf("Expected regions [east west], got %v", names)
+	}
+
+	east, err := r.Client("east")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := east.endpoints.endpoints[0].url; got != srv.URL+"/client/api" {
+		t.Fatalf("Expected the API URL of the region, got %s", got)
+	}
+	if east.apiKey != "key" {
+		t.Fatalf("Expected the credentials of the seed client, got %s", east.apiKey)
+	}
+
+	west, err := r.Client("west")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if west.apiKey != "west-key" || west.secret != "west-secret" {
+		t.Fatalf("Expected the credentials of the region, got %s", west.apiKey)
+	}
+
+	if again, _ := r.Client("east"); again != east {
+		t.Fatal("Expected the region client to be cached")
+	}
+	if n := atomic.LoadInt32(lists); n != 1 {
+		t.Fatalf("Expected the regions to be discovered once, got %d", n)
+	}
+
+	if _, err := r.Client("north"); err == nil {
+		t.Fatal("Expected an error for an unknown region")
+	}
+}
+
+func TestRegistryRegister(t *testing.T) {
+	srv, _ := newRegionServer(t)
+	defer srv.Close()
+
+	seed := NewClient(srv.URL+"/client/api", "key", "secret", false)
+	r := NewRegistry(seed)
+
+	// A registered client takes precedence over a discovered region
+	custom := NewClient("https://other.example.com/client/api", "other", "other", false)
+	r.Register("east", custom)
+	r.Register("private", custom)
+
+	if err := r.Discover(); err != nil {
+		t.Fatal(err)
+	}
+
+	names, err := r.Regions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(names, []string{"east", "private", "west"}) {
+		t.Fatalf("Expected regions [east private west], got %v", names)
+	}
+
+	for _, name := range []string{"east", "private"} {
+		if cs, _ := r.Client(name); cs != custom {
+			t.Fatalf("Expected the registered client for %s", name)
+		}
+	}
+}
+
+func TestForEachRegion(t *testing.T) {
+	srv, _ := newRegionServer(t)
+	defer srv.Close()
+
+	r := NewRegistry(NewClient(srv.URL+"/client/api", "key", "secret", false))
+
+	var calls int32
+	err := r.ForEachRegion(func(region string, cs *CloudStackClient) error {
+		atomic.AddInt32(&calls, 1)
+		if region == "west" {
+			return errors.New("failed")
+		}
+		return nil
+	})
+
+	if n := atomic.LoadInt32(&calls); n != 2 {
+		t.Fatalf("Expected 2 calls, got %d", n)
+	}
+
+	var errs RegionErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("Expected RegionErrors, got: %v", err)
+	}
+	if len(errs) != 1 || errs["west"] == nil {
+		t.Fatalf("Expected only west to fail, got: %v", errs)
+	}
+	if err.Error() != "1 region(s) failed: west: failed" {
+		t.Fatalf("Unexpected error message: %v", err)
+	}
+}
+
+func TestRegionAPIURL(t *testing.T) {
+	cases := map[string]string{
+		"http://cs.example.com:8080/client/":  "http://cs.example.com:8080/client/api",
+		"http://cs.example.com:8080/client":   "http://cs.example.com:8080/client/api",
+		"https://cs.example.com/client/api":   "https://cs.example.com/client/api",
+		"https://cs.example.com/custom/path/": "https://cs.example.com/custom/path",
+	}
+
+	for endpoint, want := range cases {
+		if got := regionAPIURL(endpoint); got != want {
+			t.Errorf("regionAPIURL(%q) = %q, want %q", endpoint, got, want)
+		}
+	}
+}
+
+// newTLSRegionServer starts a management server presenting the given certificate
+func newTLSRegionServer(t *testing.T, leaf *testCert) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"listzonesresponse":{"count":0}}`))
+	}))
+	srv.TLS = &tls.Config{Certificates: []tls.Certificate{{
+		Certificate: [][]byte{leaf.cert.Raw},
+		PrivateKey:  leaf.key,
+	}}}
+	srv.StartTLS()
+
+	return srv
+}
+
+func TestRegistryRegionTLS(t *testing.T) {
+	eastCA := newTestCert(t, "east CA", nil)
+	east := newTLSRegionServer(t, newTestCert(t, "east", eastCA))
+	defer east.Close()
+
+	westCA := newTestCert(t, "west CA", nil)
+	west := newTLSRegionServer(t, newTestCert(t, "west", westCA))
+	defer west.Close()
+
+	seed := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"listregionsresponse":{"count":2,"region":[`+
+			`{"id":1,"name":"east","endpoint":"%s/client"},`+
+			`{"id":2,"name":"west","endpoint":"%s/client"}]}}`, east.URL, west.URL)
+	}))
+	defer seed.Close()
+
+	pool := func(ca *testCert) *x509.CertPool {
+		p := x509.NewCertPool()
+		p.AddCert(ca.cert)
+		return p
+	}
+
+	cs := NewClient(seed.URL+"/client/api", "key", "secret", true)
+	r := NewRegistry(cs,
+		WithRegionOptions("east", WithRootCAs(pool(eastCA))),
+		WithRegionOptions("west", WithRootCAs(pool(westCA))),
+	)
+
+	// Each region must only trust its own CA, which fails if the options of
+	// one region are applied to the transport shared with another region
+	for _, region := range []string{"east", "west"} {
+		rc, err := r.Client(region)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := rc.newRequest("listZones", url.Values{}); err != nil {
+			t.Fatalf("Expected region %s to trust its own CA, got: %v", region, err)
+		}
+	}
+
+	if cs.client.Transport.(*http.Transport).TLSClientConfig.RootCAs != nil {
+		t.Fatal("Expected the CA pools of the regions not to be set on the seed client")
+	}
+}
@@ -0,0 +1,176 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// ErrPinMismatch is returned when none of the certificates of the server match
+// one of the pinned public keys
+var ErrPinMismatch = errors.New("None of the server certificates match a pinned public key")
+
+// LoadCertPool reads one or more PEM encoded files containing CA certificates
+// and returns a pool containing all of them, to be used with WithRootCAs
+func LoadCertPool(pemFiles ...string) (*x509.CertPool, error) {
+	pool := x509.NewCertPool()
+	for _, file := range pemFiles {
+		b, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		if !pool.AppendCertsFromPEM(b) {
+			return nil, fmt.Errorf("No valid PEM encoded certificates found in: %s", file)
+		}
+	}
+	return pool, nil
+}
+
+// tlsConfig returns the TLS config of the transport used by the client. A client
+// without a transport gets its own copy of the default transport. Any other type
+// of transport cannot be configured, so instead of silently ignoring the TLS
+// options this panics while the client is being created.
+func (cs *CloudStackClient) tlsConfig() *tls.Config {
+	if cs.client.Transport == nil {
+		cs.client.Transport = http.DefaultTransport.(*http.Transport).Clone()
+	}
+	t, ok := cs.client.Transport.(*http.Transport)
+	if !ok {
+		panic(fmt.Sprintf("cloudstack: TLS options require an *http.Transport, got %T", cs.client.Transport))
+	}
+	if t.TLSClientConfig == nil {
+		t.TLSClientConfig = &tls.Config{}
+	}
+	return t.TLSClientConfig
+}
+
+// HTTPClient returns the HTTP client used by the client, so requests that are
+// not API calls (e.g. uploads to secondary storage) use the same TLS settings
+func (cs *CloudStackClient) HTTPClient() *http.Client {
+	return cs.client
+}
+
+// WithRootCAs sets the CA certificates used to verify the certificate of the API.
+// Just like the other TLS options this modifies the transport of the HTTP client,
+// so when combined with WithHTTPClient it should be passed after it. The TLS
+// options panic if that client uses a transport other than an *http.Transport.
+func WithRootCAs(pool *x509.CertPool) ClientOption {
+	return func(cs *CloudStackClient) {
+		if pool != nil {
+			cs.tlsConfig().RootCAs = pool
+		}
+	}
+}
+
+// WithClientCertificates sets the certificates to present when the API requires mutual TLS
+func WithClientCertificates(certs ...tls.Certificate) ClientOption {
+	return func(cs *CloudStackClient) {
+		if len(certs) > 0 {
+			c := cs.tlsConfig()
+			c.Certificates = append(c.Certificates, certs...)
+		}
+	}
+}
+
+// WithServerName overrides the server name used for SNI and to verify the certificate
+// of the API, which is useful when connecting to a management server by IP address
+func WithServerName(name string) ClientOption {
+	return func(cs *CloudStackClient) {
+		if name != "" {
+			cs.tlsConfig().ServerName = name
+		}
+	}
+}
+
+// WithMinTLSVersion sets the minimum TLS version to use (e.g. tls.VersionTLS12)
+func WithMinTLSVersion(version uint16) ClientOption {
+	return func(cs *CloudStackClient) {
+		if version != 0 {
+			cs.tlsConfig().MinVersion = version
+		}
+	}
+}
+
+// WithPinnedPublicKeys only allows connections to servers whose certificate
+// chain contains at least one of the given public keys. Each pin is the base64
+// encoded SHA256 hash of a DER encoded SubjectPublicKeyInfo, optionally prefixed
+// with "sha256//" (the format used by curl).
+//
+// When certificates are verified, pins are matched against the verified chains,
+// so a pin can be the key of the server certificate or of any intermediate or
+// root CA in a valid chain. When verifyssl is false there is no verified chain,
+// and the certificates sent by the server prove nothing about each other, so only
+// the key of the server certificate itself is matched. In that case pin the key of
+// the server certificate, not of a CA.
+func WithPinnedPublicKeys(pins ...string) ClientOption {
+	return func(cs *CloudStackClient) {
+		if len(pins) == 0 {
+			return
+		}
+		c := cs.tlsConfig()
+
+		pinned := make(map[string]bool, len(pins))
+		for _, pin := range pins {
+			pinned[strings.TrimPrefix(pin, "sha256//")] = true
+		}
+
+		c.VerifyPeerCertificate = func(rawCerts [][]byte, verifiedChains [][]*x509.Certificate) error {
+			// The chain sent by the server is not verified, so any certificate
+			// (like a public CA certificate) can be appended to it
+			if c.InsecureSkipVerify {
+				if len(rawCerts) == 0 {
+					return ErrPinMismatch
+				}
+				cert, err := x509.ParseCertificate(rawCerts[0])
+				if err != nil {
+					return err
+				}
+				if pinned[PublicKeyPin(cert)] {
+					return nil
+				}
+				return ErrPinMismatch
+			}
+
+			for _, chain := range verifiedChains {
+				for _, cert := range chain {
+					if pinned[PublicKeyPin(cert)] {
+						return nil
+					}
+				}
+			}
+			return ErrPinMismatch
+		}
+	}
+}
+
+// PublicKeyPin returns the pin of the public key of the certificate, in the format
+// expected by WithPinnedPublicKeys
+func PublicKeyPin(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
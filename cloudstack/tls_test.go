@@ -0,0 +1,206 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+type testCert struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+// newTestCert creates a certificate signed by parent, or a self-signed CA
+// certificate if parent is nil
+func newTestCert(t *testing.T, name string, parent *testCert) *testCert {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tmpl := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	signer, signerKey := tmpl, key
+	if parent == nil {
+		tmpl.IsCA = true
+		tmpl.BasicConstraintsValid = true
+		tmpl.KeyUsage = x509.KeyUsageCertSign
+	} else {
+		tmpl.IPAddresses = []net.IP{net.ParseIP("127.0.0.1")}
+		tmpl.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+		tmpl.KeyUsage = x509.KeyUsageDigitalSignature
+		signer, signerKey = parent.cert, parent.key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, signer, &key.PublicKey, signerKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &testCert{cert: cert, key: key}
+}
+
+// newPinTestServer starts a TLS server presenting the leaf followed by the
+// extra certificates
+func newPinTestServer(t *testing.T, leaf *testCert, extra ...*testCert) *httptest.Server {
+	t.Helper()
+
+	chain := tls.Certificate{
+		Certificate: [][]byte{leaf.cert.Raw},
+		PrivateKey:  leaf.key,
+	}
+	for _, c := range extra {
+		chain.Certificate = append(chain.Certificate, c.cert.Raw)
+	}
+
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	srv.TLS = &tls.Config{Certificates: []tls.Certificate{chain}}
+	srv.StartTLS()
+
+	return srv
+}
+
+func TestWithPinnedPublicKeys(t *testing.T) {
+	pinnedCA := newTestCert(t, "pinned CA", nil)
+	pinnedLeaf := newTestCert(t, "pinned leaf", pinnedCA)
+
+	// A different CA the client also trusts, e.g. a public CA that issued a
+	// certificate for the API to an attacker
+	otherCA := newTestCert(t, "other CA", nil)
+	otherLeaf := newTestCert(t, "other leaf", otherCA)
+
+	unrelated := newTestCert(t, "unrelated", nil)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(pinnedCA.cert)
+	roots.AddCert(otherCA.cert)
+
+	cases := []struct {
+		name      string
+		verifyssl bool
+		pin       *testCert
+		leaf      *testCert
+		extra     []*testCert
+		ok        bool
+	}{
+		{"verified, pinned CA", true, pinnedCA, pinnedLeaf, nil, true},
+		{"verified, pinned leaf", true, pinnedLeaf, pinnedLeaf, nil, true},
+		{"verified, mismatch", true, unrelated, pinnedLeaf, nil, false},
+		{"verified, appended pinned CA", true, pinnedCA, otherLeaf, []*testCert{pinnedCA}, false},
+		{"unverified, pinned leaf", false, pinnedLeaf, pinnedLeaf, nil, true},
+		{"unverified, mismatch", false, unrelated, pinnedLeaf, nil, false},
+		{"unverified, appended pinned CA", false, pinnedCA, otherLeaf, []*testCert{pinnedCA}, false},
+		{"unverified, pinned CA only", false, pinnedCA, pinnedLeaf, []*testCert{pinnedCA}, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			srv := newPinTestServer(t, c.leaf, c.extra...)
+			defer srv.Close()
+
+			cs := NewClient(srv.URL, "key", "secret", c.verifyssl,
+				WithRootCAs(roots),
+				WithPinnedPublicKeys("sha256//"+PublicKeyPin(c.pin.cert)),
+			)
+
+			resp, err := cs.HTTPClient().Get(srv.URL)
+			if err == nil {
+				resp.Body.Close()
+			}
+
+			switch {
+			case c.ok && err != nil:
+				t.Fatalf("Expected the connection to succeed, got: %v", err)
+			case !c.ok && err == nil:
+				t.Fatal("Expected the connection to fail")
+			case !c.ok && !strings.Contains(err.Error(), ErrPinMismatch.Error()):
+				t.Fatalf("Expected %q, got: %v", ErrPinMismatch, err)
+			}
+		})
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (fn roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return fn(r)
+}
+
+func TestTLSOptionsTransport(t *testing.T) {
+	// A client without a transport gets its own copy of the default transport
+	cs := NewClient("https://127.0.0.1/client/api", "key", "secret", true,
+		WithHTTPClient(&http.Client{}),
+		WithServerName("api.example.com"),
+	)
+	tr, ok := cs.client.Transport.(*http.Transport)
+	if !ok || tr == http.DefaultTransport {
+		t.Fatal("Expected a copy of the default transport")
+	}
+	if tr.TLSClientConfig.ServerName != "api.example.com" {
+		t.Fatalf("Expected the server name to be set, got %q", tr.TLSClientConfig.ServerName)
+	}
+	if c := http.DefaultTransport.(*http.Transport).TLSClientConfig; c != nil && c.ServerName != "" {
+		t.Fatal("Expected the default transport not to be changed")
+	}
+
+	// Any other transport cannot be configured
+	custom := roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		return nil, nil
+	})
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("Expected a panic for a transport that cannot be configured")
+		}
+	}()
+	NewClient("https://127.0.0.1/client/api", "key", "secret", true,
+		WithHTTPClient(&http.Client{Transport: custom}),
+		WithServerName("api.example.com"),
+	)
+}
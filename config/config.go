@@ -0,0 +1,289 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+// Package config loads CloudStack endpoints and credentials from CloudMonkey
+// (cmk) config files and environment variables.
+package config
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/xanzy/go-cloudstack/v2/cloudstack"
+)
+
+// The environment variables that are used to configure or override a profile
+const (
+	EnvAPIURL    = "CLOUDSTACK_API_URL"
+	EnvAPIKey    = "CLOUDSTACK_API_KEY"
+	EnvSecretKey = "CLOUDSTACK_SECRET_KEY"
+	EnvProfile   = "CLOUDSTACK_PROFILE"
+	EnvConfig    = "CLOUDSTACK_CONFIG"
+)
+
+// coreSection is the section of a cmk config file containing the global settings
+const coreSection = "core"
+
+// Profile contains the settings needed to connect to a CloudStack API
+type Profile struct {
+	Name       string
+	URL        string
+	APIKey     string
+	SecretKey  string
+	VerifyCert bool
+	AsyncBlock bool  // Wait for async jobs to finish (use an async client)
+	Timeout    int64 // Max waiting timeout in seconds for async jobs to finish
+}
+
+// Config contains all profiles read from a cmk config file
+type Config struct {
+	DefaultProfile string
+	Profiles       map[string]*Profile
+}
+
+// DefaultPath returns the path of the config file to use, which is the value of
+// the CLOUDSTACK_CONFIG environment variable or otherwise ~/.cmk/config
+func DefaultPath() string {
+	if path := os.Getenv(EnvConfig); path != "" {
+		return path
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".cmk", "config")
+}
+
+// LoadFile reads and parses the cmk config file at the given path
+func LoadFile(path string) (*Config, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return Parse(f)
+}
+
+// Parse parses a config in the cmk INI format. Settings in the [core] section are
+// used as defaults for all profiles, while every other section defines a profile.
+func Parse(r io.Reader) (*Config, error) {
+	sections := make(map[string]map[string]string)
+	var order []string
+	var current map[string]string
+
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			name := strings.TrimSpace(line[1 : len(line)-1])
+			if _, ok := sections[name]; !ok {
+				sections[name] = make(map[string]string)
+				order = append(order, name)
+			}
+			current = sections[name]
+			continue
+		}
+
+		kv := strings.SplitN(line, "=", 2)
+		if len(kv) != 2 || current == nil {
+			return nil, fmt.Errorf("Invalid config on line %d: %s", n, line)
+		}
+		current[strings.ToLower(strings.TrimSpace(kv[0]))] = strings.TrimSpace(kv[1])
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	core := sections[coreSection]
+	c := &Config{
+		DefaultProfile: core["profile"],
+		Profiles:       make(map[string]*Profile),
+	}
+
+	for _, name := range order {
+		if name == coreSection {
+			continue
+		}
+
+		p, err := newProfile(name, core, sections[name])
+		if err != nil {
+			return nil, err
+		}
+		c.Profiles[name] = p
+	}
+
+	return c, nil
+}
+
+func newProfile(name string, core, section map[string]string) (*Profile, error) {
+	p := &Profile{
+		Name:       name,
+		URL:        section["url"],
+		APIKey:     section["apikey"],
+		SecretKey:  section["secretkey"],
+		VerifyCert: true,
+		AsyncBlock: true,
+	}
+
+	// Settings in the profile take precedence over the ones in the core section
+	lookup := func(key string) (string, bool) {
+		if v, ok := section[key]; ok && v != "" {
+			return v, true
+		}
+		v, ok := core[key]
+		return v, ok && v != ""
+	}
+
+	var err error
+	if v, ok := lookup("verifycert"); ok {
+		if p.VerifyCert, err = strconv.ParseBool(v); err != nil {
+			return nil, fmt.Errorf("Invalid verifycert value for profile %s: %s", name, v)
+		}
+	}
+	if v, ok := lookup("asyncblock"); ok {
+		if p.AsyncBlock, err = strconv.ParseBool(v); err != nil {
+			return nil, fmt.Errorf("Invalid asyncblock value for profile %s: %s", name, v)
+		}
+	}
+	if v, ok := lookup("timeout"); ok {
+		if p.Timeout, err = strconv.ParseInt(v, 10, 64); err != nil {
+			return nil, fmt.Errorf("Invalid timeout value for profile %s: %s", name, v)
+		}
+	}
+
+	return p, nil
+}
+
+// Profile returns the profile with the given name, or the default profile if
+// the name is empty
+func (c *Config) Profile(name string) (*Profile, error) {
+	if name == "" {
+		name = c.DefaultProfile
+	}
+
+	p, ok := c.Profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("No profile found with name: %s", name)
+	}
+	return p, nil
+}
+
+// LoadProfile loads a profile from the default config file, after which the
+// CLOUDSTACK_API_URL, CLOUDSTACK_API_KEY and CLOUDSTACK_SECRET_KEY environment
+// variables are applied on top of it. If the name is empty, the profile named by
+// CLOUDSTACK_PROFILE or the default profile of the config file is used. When no
+// config file exists, or it has no matching profile but the environment fully
+// configures the client, the profile is created from the environment only.
+func LoadProfile(name string) (*Profile, error) {
+	if name == "" {
+		name = os.Getenv(EnvProfile)
+	}
+
+	p := &Profile{Name: name, VerifyCert: true, AsyncBlock: true}
+
+	c, err := LoadFile(DefaultPath())
+	switch {
+	case err == nil:
+		cp, err := c.Profile(name)
+		if err != nil {
+			env := *p
+			env.applyEnv()
+			if env.Validate() != nil {
+				return nil, err
+			}
+			return &env, nil
+		}
+		p = cp
+	case os.IsNotExist(err):
+		// The environment could contain all we need
+	default:
+		return nil, err
+	}
+
+	// Copy the profile so we don't modify the parsed config
+	pp := *p
+	pp.applyEnv()
+
+	if err := pp.Validate(); err != nil {
+		return nil, err
+	}
+	return &pp, nil
+}
+
+func (p *Profile) applyEnv() {
+	if v := os.Getenv(EnvAPIURL); v != "" {
+		p.URL = v
+	}
+	if v := os.Getenv(EnvAPIKey); v != "" {
+		p.APIKey = v
+	}
+	if v := os.Getenv(EnvSecretKey); v != "" {
+		p.SecretKey = v
+	}
+}
+
+// Validate checks if the profile contains everything that is needed to create a client
+func (p *Profile) Validate() error {
+	var missing []string
+	if p.URL == "" {
+		missing = append(missing, "url")
+	}
+	if p.APIKey == "" {
+		missing = append(missing, "apikey")
+	}
+	if p.SecretKey == "" {
+		missing = append(missing, "secretkey")
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("Profile %q is missing required setting(s): %s", p.Name, strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// NewClient returns a new client configured using the settings of the profile
+func (p *Profile) NewClient(options ...cloudstack.ClientOption) *cloudstack.CloudStackClient {
+	options = append([]cloudstack.ClientOption{cloudstack.WithAsyncTimeout(p.Timeout)}, options...)
+
+	if p.AsyncBlock {
+		return cloudstack.NewAsyncClient(p.URL, p.APIKey, p.SecretKey, p.VerifyCert, options...)
+	}
+	return cloudstack.NewClient(p.URL, p.APIKey, p.SecretKey, p.VerifyCert, options...)
+}
+
+// NewClientFromProfile loads the named profile (see LoadProfile) and returns a
+// new client configured using the settings of the profile
+func NewClientFromProfile(name string, options ...cloudstack.ClientOption) (*cloudstack.CloudStackClient, error) {
+	p, err := LoadProfile(name)
+	if err != nil {
+		return nil, err
+	}
+	return p.NewClient(options...), nil
+}
@@ -0,0 +1,135 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const testConfig = `[core]
+profile = local
+
+[local]
+url = http://localhost:8080/client/api
+apikey = local-key
+secretkey = local-secret
+`
+
+// setenv sets the environment variables and returns a function restoring them
+func setenv(t *testing.T, env map[string]string) func() {
+	t.Helper()
+
+	old := make(map[string]*string)
+	for k, v := range env {
+		if prev, ok := os.LookupEnv(k); ok {
+			old[k] = &prev
+		} else {
+			old[k] = nil
+		}
+
+		var err error
+		if v == "" {
+			err = os.Unsetenv(k)
+		} else {
+			err = os.Setenv(k, v)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return func() {
+		for k, v := range old {
+			if v == nil {
+				os.Unsetenv(k)
+			} else {
+				os.Setenv(k, *v)
+			}
+		}
+	}
+}
+
+func TestLoadProfile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "config")
+	if err := ioutil.WriteFile(path, []byte(testConfig), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	envOnly := map[string]string{
+		EnvAPIURL:    "https://cloud.example.com/client/api",
+		EnvAPIKey:    "env-key",
+		EnvSecretKey: "env-secret",
+	}
+
+	cases := []struct {
+		name    string
+		config  string // Path of the config file
+		profile string
+		env     map[string]string
+		wantURL string
+		wantKey string
+		err     bool
+	}{
+		{"default profile", path, "", nil, "http://localhost:8080/client/api", "local-key", false},
+		{"named profile", path, "local", nil, "http://localhost:8080/client/api", "local-key", false},
+		{"env on top of profile", path, "local", map[string]string{EnvAPIKey: "env-key"}, "http://localhost:8080/client/api", "env-key", false},
+		{"unknown profile", path, "other", nil, "", "", true},
+		{"unknown profile, partial env", path, "other", map[string]string{EnvAPIURL: "https://cloud.example.com/client/api"}, "", "", true},
+		{"unknown profile, full env", path, "other", envOnly, "https://cloud.example.com/client/api", "env-key", false},
+		{"no config file, full env", filepath.Join(dir, "missing"), "", envOnly, "https://cloud.example.com/client/api", "env-key", false},
+		{"no config file, no env", filepath.Join(dir, "missing"), "", nil, "", "", true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			env := map[string]string{
+				EnvConfig:    c.config,
+				EnvProfile:   "",
+				EnvAPIURL:    "",
+				EnvAPIKey:    "",
+				EnvSecretKey: "",
+			}
+			for k, v := range c.env {
+				env[k] = v
+			}
+			defer setenv(t, env)()
+
+			p, err := LoadProfile(c.profile)
+			if (err != nil) != c.err {
+				t.Fatalf("Expected error %t, got: %v", c.err, err)
+			}
+			if err != nil {
+				return
+			}
+			if p.URL != c.wantURL || p.APIKey != c.wantKey {
+				t.Fatalf("Expected URL %q and API key %q, got %q and %q", c.wantURL, c.wantKey, p.URL, p.APIKey)
+			}
+		})
+	}
+}
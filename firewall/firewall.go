@@ -0,0 +1,516 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+// Package firewall reconciles the ingress firewall rules and port forwarding
+// rules of a public IP address, and the egress firewall rules of a network,
+// with a desired set of rules. New rules are added before obsolete rules are
+// deleted, so traffic that should keep flowing is not interrupted. The only
+// exception are port forwarding rules whose public ports overlap a live rule,
+// and firewall rules CloudStack refuses to add next to the live rule they
+// replace: the live rule is deleted first, so its traffic is interrupted until
+// the new rule is created.
+package firewall
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/xanzy/go-cloudstack/v2/cloudstack"
+	"github.com/xanzy/go-cloudstack/v2/internal/common"
+	"github.com/xanzy/go-cloudstack/v2/waiter"
+)
+
+// DefaultTimeout is the default time to wait for a single rule to be created
+// or deleted
+const DefaultTimeout = 5 * time.Minute
+
+// networkRuleConflictError is the error code CloudStack returns for a rule
+// that conflicts with a live rule
+const networkRuleConflictError = 537
+
+// Target is the public IP address and network the rules apply to. Ingress and
+// port forwarding rules are only reconciled if the IP address is set, egress
+// rules only if the network is set.
+type Target struct {
+	IPAddressID string
+	NetworkID   string
+}
+
+// Replacement is a new entry and the live entries it conflicts with. Firewall
+// rules are replaced by adding the new entry before deleting the live entries.
+// Port forwarding rules, and firewall rules CloudStack rejects as conflicting,
+// are replaced by deleting the live entries first, which interrupts their
+// traffic until the new entry is created. The live entries are restored if the
+// new entry cannot be created.
+type Replacement struct {
+	Add    Entry
+	Remove []Entry
+}
+
+// Plan is the set of changes needed to reconcile a target
+type Plan struct {
+	Target
+	Add     []Entry
+	Remove  []Entry
+	Replace []Replacement
+}
+
+// Empty returns true if the target is already in the desired state
+func (p *Plan) Empty() bool {
+	return len(p.Add) == 0 && len(p.Remove) == 0 && len(p.Replace) == 0
+}
+
+// Result is the result of applying a plan
+type Result struct {
+	*Plan
+	DryRun  bool
+	Added   []Entry
+	Removed []Entry
+	Errs    []error
+}
+
+// Failed returns true if any change failed
+func (r *Result) Failed() bool {
+	return len(r.Errs) > 0
+}
+
+// Option can be passed to New to set custom options
+type Option func(*Reconciler)
+
+// WithDryRun only plans the changes without applying them
+func WithDryRun(dryRun bool) Option {
+	return func(r *Reconciler) {
+		r.dryRun = dryRun
+	}
+}
+
+// WithTimeout sets the time to wait for a single rule to be created or deleted
+func WithTimeout(timeout time.Duration) Option {
+	return func(r *Reconciler) {
+		if timeout != 0 {
+			r.timeout = timeout
+		}
+	}
+}
+
+// WithOptions sets option functions (e.g. cloudstack.WithProject) that are
+// applied when listing rules and looking up the network
+func WithOptions(opts ...cloudstack.OptionFunc) Option {
+	return func(r *Reconciler) {
+		r.opts = append(r.opts, opts...)
+	}
+}
+
+// Reconciler reconciles firewall, egress and port forwarding rules
+type Reconciler struct {
+	cs      *cloudstack.CloudStackClient
+	dryRun  bool
+	timeout time.Duration
+	opts    []cloudstack.OptionFunc
+	waiter  *waiter.Waiter
+}
+
+// New returns a new reconciler using the given client
+func New(cs *cloudstack.CloudStackClient, options ...Option) *Reconciler {
+	r := &Reconciler{
+		cs:      cs,
+		timeout: DefaultTimeout,
+	}
+
+	for _, fn := range options {
+		fn(r)
+	}
+	r.waiter = waiter.New(cs, waiter.WithTimeout(r.timeout))
+
+	return r
+}
+
+// Live returns the live rules of the target
+func (r *Reconciler) Live(t Target) ([]Entry, error) {
+	var live []Entry
+
+	if t.IPAddressID != "" {
+		fp := r.cs.Firewall.NewListFirewallRulesParams()
+		fp.SetIpaddressid(t.IPAddressID)
+		fp.SetListall(true)
+		if err := common.ApplyOptions(r.cs, fp, r.opts...); err != nil {
+			return nil, err
+		}
+
+		fl, err := r.cs.Firewall.ListFirewallRules(fp)
+		if err != nil {
+			return nil, err
+		}
+		for _, fw := range fl.FirewallRules {
+			e, err := ingressEntry(fw)
+			if err != nil {
+				return nil, fmt.Errorf("Error in firewall rule %s: %v", fw.Id, err)
+			}
+			live = append(live, e)
+		}
+
+		pp := r.cs.Firewall.NewListPortForwardingRulesParams()
+		pp.SetIpaddressid(t.IPAddressID)
+		pp.SetListall(true)
+		if err := common.ApplyOptions(r.cs, pp, r.opts...); err != nil {
+			return nil, err
+		}
+
+		pl, err := r.cs.Firewall.ListPortForwardingRules(pp)
+		if err != nil {
+			return nil, err
+		}
+		for _, pf := range pl.PortForwardingRules {
+			e, err := portForwardingEntry(pf)
+			if err != nil {
+				return nil, fmt.Errorf("Error in port forwarding rule %s: %v", pf.Id, err)
+			}
+			live = append(live, e)
+		}
+	}
+
+	if t.NetworkID != "" {
+		cidr, err := r.networkCIDR(t.NetworkID)
+		if err != nil {
+			return nil, err
+		}
+
+		ep := r.cs.Firewall.NewListEgressFirewallRulesParams()
+		ep.SetNetworkid(t.NetworkID)
+		ep.SetListall(true)
+		if err := common.ApplyOptions(r.cs, ep, r.opts...); err != nil {
+			return nil, err
+		}
+
+		el, err := r.cs.Firewall.ListEgressFirewallRules(ep)
+		if err != nil {
+			return nil, err
+		}
+		for _, eg := range el.EgressFirewallRules {
+			e, err := egressEntry(eg, cidr)
+			if err != nil {
+				return nil, fmt.Errorf("Error in egress rule %s: %v", eg.Id, err)
+			}
+			live = append(live, e)
+		}
+	}
+
+	return live, nil
+}
+
+func (r *Reconciler) networkCIDR(id string) (string, error) {
+	n, _, err := r.cs.Network.GetNetworkByID(id, r.opts...)
+	if err != nil {
+		return "", err
+	}
+	return n.Cidr, nil
+}
+
+// Plan computes the changes needed to give the target exactly the desired rules
+func (r *Reconciler) Plan(t Target, desired Rules) (*Plan, error) {
+	if t.IPAddressID == "" && (len(desired.Ingress) > 0 || len(desired.PortForwards) > 0) {
+		return nil, fmt.Errorf("Ingress and port forwarding rules need a public IP address")
+	}
+	if t.NetworkID == "" && len(desired.Egress) > 0 {
+		return nil, fmt.Errorf("Egress rules need a network")
+	}
+
+	var cidr string
+	if t.NetworkID != "" {
+		var err error
+		if cidr, err = r.networkCIDR(t.NetworkID); err != nil {
+			return nil, err
+		}
+	}
+
+	want, err := desired.entries(cidr)
+	if err != nil {
+		return nil, err
+	}
+
+	live, err := r.Live(t)
+	if err != nil {
+		return nil, err
+	}
+
+	return diff(&Plan{Target: t}, want, live), nil
+}
+
+// diff fills the plan. Live entries that conflict with a new entry are paired
+// with it, as CloudStack may refuse to create the new entry while they exist.
+func diff(plan *Plan, want, live []Entry) *Plan {
+	kept := make([]bool, len(live))
+
+	var add []Entry
+	for _, w := range want {
+		found := false
+		for n, l := range live {
+			if !kept[n] && w.matches(l) {
+				kept[n], found = true, true
+				break
+			}
+		}
+		if !found {
+			add = append(add, w)
+		}
+	}
+
+	var remove []Entry
+	for n, l := range live {
+		if !kept[n] {
+			remove = append(remove, l)
+		}
+	}
+
+	replaced := make([]bool, len(remove))
+	for _, a := range add {
+		var conflicting []Entry
+		for n, rm := range remove {
+			if !replaced[n] && a.conflicts(rm) {
+				conflicting = append(conflicting, rm)
+				replaced[n] = true
+			}
+		}
+
+		if len(conflicting) > 0 {
+			plan.Replace = append(plan.Replace, Replacement{Add: a, Remove: conflicting})
+		} else {
+			plan.Add = append(plan.Add, a)
+		}
+	}
+
+	for n, rm := range remove {
+		if !replaced[n] {
+			plan.Remove = append(plan.Remove, rm)
+		}
+	}
+
+	return plan
+}
+
+// Apply applies the plan, unless the reconciler is in dry-run mode. New rules
+// are added first, then conflicting rules are replaced one by one, and finally
+// obsolete rules are deleted. Nothing is deleted if adding a rule failed. See
+// Replacement for when replacing a rule interrupts its traffic.
+func (r *Reconciler) Apply(ctx context.Context, plan *Plan) *Result {
+	res := &Result{Plan: plan, DryRun: r.dryRun}
+	if r.dryRun || plan.Empty() {
+		return res
+	}
+
+	for _, e := range plan.Add {
+		if err := r.create(ctx, plan.Target, e); err != nil {
+			res.Errs = append(res.Errs, fmt.Errorf("Error adding %s: %v", e, err))
+			continue
+		}
+		res.Added = append(res.Added, e)
+	}
+
+	if res.Failed() {
+		return res
+	}
+
+	for _, rp := range plan.Replace {
+		r.replace(ctx, plan.Target, rp, res)
+	}
+
+	if res.Failed() {
+		return res
+	}
+
+	for _, e := range plan.Remove {
+		if err := r.delete(ctx, e); err != nil {
+			res.Errs = append(res.Errs, fmt.Errorf("Error removing %s: %v", e, err))
+			continue
+		}
+		res.Removed = append(res.Removed, e)
+	}
+
+	return res
+}
+
+// replace replaces the live entries with the new entry. Firewall rules are
+// only rejected if they duplicate a live rule, so the new rule is added first
+// and the live rules are deleted afterwards. If CloudStack rejects the new rule
+// as conflicting, and always for port forwarding rules, the live entries are
+// deleted first, which interrupts their traffic until the new entry is created.
+// The deleted entries are created again if the new entry still fails.
+func (r *Reconciler) replace(ctx context.Context, t Target, rp Replacement, res *Result) {
+	if rp.Add.Kind != PortForwarding {
+		err := r.create(ctx, t, rp.Add)
+		if err == nil {
+			res.Added = append(res.Added, rp.Add)
+			for _, e := range rp.Remove {
+				if err := r.delete(ctx, e); err != nil {
+					res.Errs = append(res.Errs, fmt.Errorf("Error removing %s: %v", e, err))
+					continue
+				}
+				res.Removed = append(res.Removed, e)
+			}
+			return
+		}
+		if !isConflict(err) {
+			res.Errs = append(res.Errs, fmt.Errorf("Error adding %s: %v", rp.Add, err))
+			return
+		}
+	}
+
+	var removed []Entry
+	for _, e := range rp.Remove {
+		if err := r.delete(ctx, e); err != nil {
+			res.Errs = append(res.Errs, fmt.Errorf("Error removing %s: %v", e, err))
+			r.restore(ctx, t, removed, res)
+			return
+		}
+		removed = append(removed, e)
+	}
+
+	if err := r.create(ctx, t, rp.Add); err != nil {
+		res.Errs = append(res.Errs, fmt.Errorf("Error adding %s: %v", rp.Add, err))
+		r.restore(ctx, t, removed, res)
+		return
+	}
+	res.Added = append(res.Added, rp.Add)
+	res.Removed = append(res.Removed, removed...)
+}
+
+// restore creates the removed entries again after a failed replacement.
+// Entries that cannot be restored are reported as removed.
+func (r *Reconciler) restore(ctx context.Context, t Target, removed []Entry, res *Result) {
+	for _, e := range removed {
+		if err := r.create(ctx, t, e); err != nil {
+			res.Errs = append(res.Errs, fmt.Errorf("Error restoring %s: %v", e, err))
+			res.Removed = append(res.Removed, e)
+		}
+	}
+}
+
+// isConflict returns true if CloudStack rejected a rule because it conflicts
+// with a live rule. The error code is part of the message of a failed request,
+// and of the result of a failed job.
+func isConflict(err error) bool {
+	msg := err.Error()
+	return strings.Contains(msg, fmt.Sprintf("CloudStack API error %d ", networkRuleConflictError)) ||
+		strings.Contains(msg, fmt.Sprintf(`"errorcode":%d`, networkRuleConflictError))
+}
+
+// Reconcile plans and applies the desired rules of the target in one go
+func (r *Reconciler) Reconcile(ctx context.Context, t Target, desired Rules) (*Result, error) {
+	plan, err := r.Plan(t, desired)
+	if err != nil {
+		return nil, err
+	}
+	return r.Apply(ctx, plan), nil
+}
+
+func (r *Reconciler) create(ctx context.Context, t Target, e Entry) error {
+	var jobid string
+
+	switch e.Kind {
+	case Ingress:
+		p := r.cs.Firewall.NewCreateFirewallRuleParams(t.IPAddressID, e.Protocol)
+		p.SetCidrlist(e.CIDRs)
+		switch e.Protocol {
+		case "tcp", "udp":
+			p.SetStartport(e.StartPort)
+			p.SetEndport(e.EndPort)
+		case "icmp":
+			p.SetIcmptype(e.ICMPType)
+			p.SetIcmpcode(e.ICMPCode)
+		}
+
+		resp, err := r.cs.Firewall.CreateFirewallRule(p)
+		if err != nil {
+			return err
+		}
+		jobid = resp.JobID
+	case Egress:
+		p := r.cs.Firewall.NewCreateEgressFirewallRuleParams(t.NetworkID, e.Protocol)
+		p.SetCidrlist(e.CIDRs)
+		if len(e.DestCIDRs) > 0 {
+			p.SetDestcidrlist(e.DestCIDRs)
+		}
+		switch e.Protocol {
+		case "tcp", "udp":
+			p.SetStartport(e.StartPort)
+			p.SetEndport(e.EndPort)
+		case "icmp":
+			p.SetIcmptype(e.ICMPType)
+			p.SetIcmpcode(e.ICMPCode)
+		}
+
+		resp, err := r.cs.Firewall.CreateEgressFirewallRule(p)
+		if err != nil {
+			return err
+		}
+		jobid = resp.JobID
+	case PortForwarding:
+		p := r.cs.Firewall.NewCreatePortForwardingRuleParams(t.IPAddressID, e.PrivatePort, e.Protocol, e.StartPort, e.VirtualMachineID)
+		p.SetPublicendport(e.EndPort)
+		p.SetPrivateendport(e.PrivateEndPort)
+		p.SetCidrlist(e.CIDRs)
+		if e.VMGuestIP != "" {
+			p.SetVmguestip(e.VMGuestIP)
+		}
+		// Opening the firewall would create an ingress rule that is not part
+		// of the desired rules, and would be deleted again by the next run.
+		p.SetOpenfirewall(false)
+
+		resp, err := r.cs.Firewall.CreatePortForwardingRule(p)
+		if err != nil {
+			return err
+		}
+		jobid = resp.JobID
+	default:
+		return fmt.Errorf("Unknown rule kind: %s", e.Kind)
+	}
+
+	return r.waiter.WaitForJob(ctx, jobid)
+}
+
+func (r *Reconciler) delete(ctx context.Context, e Entry) error {
+	var jobid string
+
+	switch e.Kind {
+	case Ingress:
+		resp, err := r.cs.Firewall.DeleteFirewallRule(r.cs.Firewall.NewDeleteFirewallRuleParams(e.ID))
+		if err != nil {
+			return err
+		}
+		jobid = resp.JobID
+	case Egress:
+		resp, err := r.cs.Firewall.DeleteEgressFirewallRule(r.cs.Firewall.NewDeleteEgressFirewallRuleParams(e.ID))
+		if err != nil {
+			return err
+		}
+		jobid = resp.JobID
+	case PortForwarding:
+		resp, err := r.cs.Firewall.DeletePortForwardingRule(r.cs.Firewall.NewDeletePortForwardingRuleParams(e.ID))
+		if err != nil {
+			return err
+		}
+		jobid = resp.JobID
+	default:
+		return fmt.Errorf("Unknown rule kind: %s", e.Kind)
+	}
+
+	return r.waiter.WaitForJob(ctx, jobid)
+}
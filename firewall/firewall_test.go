@@ -0,0 +1,318 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package firewall
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/xanzy/go-cloudstack/v2/cloudstack"
+)
+
+func describe(entries []Entry) []string {
+	s := make([]string, len(entries))
+	for i, e := range entries {
+		s[i] = e.String()
+	}
+	return s
+}
+
+func TestEntries(t *testing.T) {
+	entries, err := Rules{
+		Ingress: []IngressRule{
+			{Protocol: "6", StartPort: 22, CIDRs: []string{"10.1.2.3/8", "1.2.3.4"}},
+			{Protocol: "icmp"},
+		},
+		Egress: []EgressRule{
+			{Protocol: "all"},
+			{Protocol: "udp", StartPort: 53, DestCIDRs: []string{"8.8.8.8"}},
+		},
+		PortForwards: []PortForward{
+			{Protocol: "tcp", PublicPort: 8080, PublicEndPort: 8081, PrivatePort: 80, PrivateEndPort: 81, VirtualMachineID: "vm1"},
+		},
+	}.entries("192.168.1.0/24")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		"ingress tcp/22 from 1.2.3.4/32,10.0.0.0/8",
+		"ingress icmp type -1 code -1 from 0.0.0.0/0",
+		"egress all from 192.168.1.0/24",
+		"egress udp/53 from 192.168.1.0/24 to 8.8.8.8/32",
+		"portforwarding tcp/8080-8081 -> vm1:80-81 from 0.0.0.0/0",
+	}
+	if got := describe(entries); !reflect.DeepEqual(got, want) {
+		t.Fatalf("Expected:\n%s\ngot:\n%s", strings.Join(want, "\n"), strings.Join(got, "\n"))
+	}
+}
+
+func TestEntriesValidation(t *testing.T) {
+	cases := map[string]Rules{
+		"no port":       {Ingress: []IngressRule{{Protocol: "tcp"}}},
+		"reversed":      {Egress: []EgressRule{{Protocol: "udp", StartPort: 80, EndPort: 22}}},
+		"invalid cidr":  {Ingress: []IngressRule{{Protocol: "all", CIDRs: []string{"10.0.0.0/33"}}}},
+		"pf protocol":   {PortForwards: []PortForward{{Protocol: "icmp", VirtualMachineID: "vm1"}}},
+		"pf no vm":      {PortForwards: []PortForward{{Protocol: "tcp", PublicPort: 80, PrivatePort: 80}}},
+		"pf range size": {PortForwards: []PortForward{{Protocol: "tcp", PublicPort: 80, PublicEndPort: 81, PrivatePort: 80, VirtualMachineID: "vm1"}}},
+	}
+
+	for name, rules := range cases {
+		if _, err := rules.entries(""); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestLiveEntries(t *testing.T) {
+	e, err := portForwardingEntry(&cloudstack.PortForwardingRule{
+		Id:               "pf1",
+		Protocol:         "TCP",
+		Publicport:       "8080",
+		Publicendport:    "8080",
+		Privateport:      "80",
+		Privateendport:   "80",
+		Virtualmachineid: "vm1",
+		Vmguestip:        "10.0.0.5",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	desired := Entry{Kind: PortForwarding, Protocol: "tcp", StartPort: 8080, PrivatePort: 80, VirtualMachineID: "vm1"}
+	if desired, err = desired.normalize(""); err != nil {
+		t.Fatal(err)
+	}
+	if !desired.matches(e) {
+		t.Fatalf("Expected %s to match %s, as no guest IP is desired", desired, e)
+	}
+	desired.VMGuestIP = "10.0.0.6"
+	if desired.matches(e) {
+		t.Fatalf("Expected %s not to match %s", desired, e)
+	}
+
+	e, err = egressEntry(&cloudstack.EgressFirewallRule{Id: "e1", Protocol: "all", Cidrlist: "", Destcidrlist: "8.8.8.8/32"}, "192.168.1.0/24")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := e.String(); got != "egress all from 192.168.1.0/24 to 8.8.8.8/32" {
+		t.Fatalf("Expected the network CIDR as default source, got %s", got)
+	}
+}
+
+func TestConflicts(t *testing.T) {
+	tcp := func(kind Kind, start, end int, cidrs ...string) Entry {
+		return Entry{Kind: kind, Protocol: "tcp", StartPort: start, EndPort: end, CIDRs: cidrs}
+	}
+
+	cases := []struct {
+		a, b Entry
+		want bool
+	}{
+		{tcp(Ingress, 20, 30, "10.0.0.0/8"), tcp(Ingress, 25, 40, "10.1.0.0/16"), true},
+		{tcp(Ingress, 20, 30, "10.0.0.0/8"), tcp(Ingress, 31, 40, "10.0.0.0/8"), false},
+		{tcp(Ingress, 20, 30, "10.0.0.0/8"), tcp(Ingress, 20, 30, "192.168.0.0/16"), false},
+		{tcp(Ingress, 20, 30, "10.0.0.0/8"), tcp(Egress, 20, 30, "10.0.0.0/8"), false},
+		{tcp(PortForwarding, 80, 80, "10.0.0.0/8"), tcp(PortForwarding, 80, 80, "192.168.0.0/16"), true},
+		{Entry{Kind: Ingress, Protocol: "icmp"}, Entry{Kind: Ingress, Protocol: "icmp"}, false},
+	}
+
+	for i, c := range cases {
+		if got := c.a.conflicts(c.b); got != c.want {
+			t.Errorf("%d: expected %s conflicts %s to be %v", i, c.a, c.b, c.want)
+		}
+	}
+}
+
+func TestDiff(t *testing.T) {
+	want, err := Rules{
+		Ingress: []IngressRule{
+			{Protocol: "tcp", StartPort: 22, CIDRs: []string{"10.0.0.0/8"}},
+			{Protocol: "tcp", StartPort: 80, EndPort: 90},
+			{Protocol: "tcp", StartPort: 443},
+		},
+	}.entries("")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	live := []Entry{
+		{Kind: Ingress, ID: "r1", Protocol: "tcp", StartPort: 22, EndPort: 22, CIDRs: []string{"10.0.0.0/8"}},
+		{Kind: Ingress, ID: "r2", Protocol: "tcp", StartPort: 22, EndPort: 22, CIDRs: []string{"10.0.0.0/8"}},
+		{Kind: Ingress, ID: "r3", Protocol: "tcp", StartPort: 80, EndPort: 80, CIDRs: []string{"0.0.0.0/0"}},
+		{Kind: Ingress, ID: "r4", Protocol: "tcp", StartPort: 85, EndPort: 85, CIDRs: []string{"0.0.0.0/0"}},
+		{Kind: Ingress, ID: "r5", Protocol: "udp", StartPort: 53, EndPort: 53, CIDRs: []string{"0.0.0.0/0"}},
+	}
+
+	plan := diff(&Plan{}, want, live)
+
+	if got := describe(plan.Add); !reflect.DeepEqual(got, []string{"ingress tcp/443 from 0.0.0.0/0"}) {
+		t.Fatalf("Unexpected additions: %v", got)
+	}
+	if len(plan.Replace) != 1 || plan.Replace[0].Add.String() != "ingress tcp/80-90 from 0.0.0.0/0" {
+		t.Fatalf("Expected tcp/80-90 to replace the conflicting rules, got %+v", plan.Replace)
+	}
+	if ids := ids(plan.Replace[0].Remove); !reflect.DeepEqual(ids, []string{"r3", "r4"}) {
+		t.Fatalf("Expected r3 and r4 to be replaced, got %v", ids)
+	}
+	if ids := ids(plan.Remove); !reflect.DeepEqual(ids, []string{"r2", "r5"}) {
+		t.Fatalf("Expected the duplicate r2 and r5 to be removed, got %v", ids)
+	}
+}
+
+func ids(entries []Entry) []string {
+	var ids []string
+	for _, e := range entries {
+		ids = append(ids, e.ID)
+	}
+	return ids
+}
+
+// rejection makes the job of a call fail with the error code
+type rejection struct {
+	call string
+	code int
+}
+
+// newJobServer returns a client for a server that records the API calls and
+// starts a job for each of them. The job of the first call matching each of the
+// rejections fails.
+func newJobServer(rejected ...rejection) (*cloudstack.CloudStackClient, *httptest.Server, func() []string) {
+	var mu sync.Mutex
+	var calls []string
+	failed := make(map[string]int)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		command := q.Get("command")
+
+		if command == "queryAsyncJobResult" {
+			mu.Lock()
+			code, ok := failed[q.Get("jobid")]
+			mu.Unlock()
+			if ok {
+				fmt.Fprintf(w, `{"queryasyncjobresultresponse":{"jobstatus":2,"jobresult":{"errorcode":%d,"errortext":"rejected"}}}`, code)
+				return
+			}
+			fmt.Fprint(w, `{"queryasyncjobresultresponse":{"jobstatus":1,"jobresult":{}}}`)
+			return
+		}
+
+		call := command + " " + q.Get("id") + q.Get("startport") + q.Get("publicport")
+
+		mu.Lock()
+		calls = append(calls, call)
+		jobid := fmt.Sprintf("job%d", len(calls))
+		for i, rj := range rejected {
+			if rj.call == call {
+				failed[jobid] = rj.code
+				rejected = append(rejected[:i], rejected[i+1:]...)
+				break
+			}
+		}
+		mu.Unlock()
+
+		fmt.Fprintf(w, `{"%sresponse":{"jobid":"%s"}}`, strings.ToLower(command), jobid)
+	}))
+
+	return cloudstack.NewClient(srv.URL, "key", "secret", false), srv, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string(nil), calls...)
+	}
+}
+
+func TestApplyReplace(t *testing.T) {
+	add := Entry{Kind: Ingress, Protocol: "tcp", StartPort: 80, EndPort: 90, CIDRs: []string{"0.0.0.0/0"}}
+	old := Entry{Kind: Ingress, ID: "r3", Protocol: "tcp", StartPort: 80, EndPort: 80, CIDRs: []string{"0.0.0.0/0"}}
+	pfAdd := Entry{Kind: PortForwarding, Protocol: "tcp", StartPort: 80, EndPort: 80, PrivatePort: 8080, PrivateEndPort: 8080, VirtualMachineID: "vm2"}
+	pfOld := Entry{Kind: PortForwarding, ID: "pf1", Protocol: "tcp", StartPort: 80, EndPort: 80, PrivatePort: 8080, PrivateEndPort: 8080, VirtualMachineID: "vm1"}
+
+	cases := map[string]struct {
+		replacement Replacement
+		rejected    []rejection
+		want        []string
+		removed     []string
+		errs        int
+	}{
+		"firewall rule added first": {
+			replacement: Replacement{Add: add, Remove: []Entry{old}},
+			want:        []string{"createFirewallRule 80", "deleteFirewallRule r3"},
+			removed:     []string{"r3"},
+		},
+		"firewall rule conflicting": {
+			replacement: Replacement{Add: add, Remove: []Entry{old}},
+			rejected:    []rejection{{"createFirewallRule 80", 537}},
+			want:        []string{"createFirewallRule 80", "deleteFirewallRule r3", "createFirewallRule 80"},
+			removed:     []string{"r3"},
+		},
+		"firewall rule failed": {
+			replacement: Replacement{Add: add, Remove: []Entry{old}},
+			rejected:    []rejection{{"createFirewallRule 80", 530}},
+			want:        []string{"createFirewallRule 80"},
+			errs:        1,
+		},
+		"firewall rule failed after removal": {
+			replacement: Replacement{Add: add, Remove: []Entry{old}},
+			rejected:    []rejection{{"createFirewallRule 80", 537}, {"createFirewallRule 80", 530}},
+			// The last call restores r3
+			want: []string{"createFirewallRule 80", "deleteFirewallRule r3", "createFirewallRule 80", "createFirewallRule 80"},
+			errs: 1,
+		},
+		"port forward removed first": {
+			replacement: Replacement{Add: pfAdd, Remove: []Entry{pfOld}},
+			want:        []string{"deletePortForwardingRule pf1", "createPortForwardingRule 80"},
+			removed:     []string{"pf1"},
+		},
+		"port forward not removed": {
+			replacement: Replacement{Add: pfAdd, Remove: []Entry{pfOld}},
+			rejected:    []rejection{{"deletePortForwardingRule pf1", 530}},
+			want:        []string{"deletePortForwardingRule pf1"},
+			errs:        1,
+		},
+	}
+
+	for name, c := range cases {
+		cs, srv, calls := newJobServer(c.rejected...)
+
+		r := New(cs)
+		res := r.Apply(context.Background(), &Plan{
+			Target:  Target{IPAddressID: "ip1"},
+			Replace: []Replacement{c.replacement},
+		})
+		srv.Close()
+
+		if got := calls(); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("%s: expected calls %v, got %v", name, c.want, got)
+		}
+		if got := ids(res.Removed); !reflect.DeepEqual(got, c.removed) {
+			t.Errorf("%s: expected removed %v, got %v", name, c.removed, got)
+		}
+		if len(res.Errs) != c.errs {
+			t.Errorf("%s: expected %d errors, got %v", name, c.errs, res.Errs)
+		}
+	}
+}
@@ -0,0 +1,367 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package firewall
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/xanzy/go-cloudstack/v2/cloudstack"
+	"github.com/xanzy/go-cloudstack/v2/internal/common"
+)
+
+// IngressRule is a desired firewall rule of a public IP address
+type IngressRule struct {
+	Protocol  string   `json:"protocol" yaml:"protocol"` // tcp, udp, icmp or all
+	StartPort int      `json:"startport,omitempty" yaml:"startport,omitempty"`
+	EndPort   int      `json:"endport,omitempty" yaml:"endport,omitempty"`   // Defaults to the start port
+	ICMPType  *int     `json:"icmptype,omitempty" yaml:"icmptype,omitempty"` // Defaults to any (-1)
+	ICMPCode  *int     `json:"icmpcode,omitempty" yaml:"icmpcode,omitempty"` // Defaults to any (-1)
+	CIDRs     []string `json:"cidrs,omitempty" yaml:"cidrs,omitempty"`       // Defaults to 0.0.0.0/0
+}
+
+// EgressRule is a desired egress rule of a network
+type EgressRule struct {
+	Protocol  string   `json:"protocol" yaml:"protocol"` // tcp, udp, icmp or all
+	StartPort int      `json:"startport,omitempty" yaml:"startport,omitempty"`
+	EndPort   int      `json:"endport,omitempty" yaml:"endport,omitempty"`   // Defaults to the start port
+	ICMPType  *int     `json:"icmptype,omitempty" yaml:"icmptype,omitempty"` // Defaults to any (-1)
+	ICMPCode  *int     `json:"icmpcode,omitempty" yaml:"icmpcode,omitempty"` // Defaults to any (-1)
+	CIDRs     []string `json:"cidrs,omitempty" yaml:"cidrs,omitempty"`       // Source CIDRs, defaults to the network CIDR
+	DestCIDRs []string `json:"destcidrs,omitempty" yaml:"destcidrs,omitempty"`
+}
+
+// PortForward is a desired port forwarding rule of a public IP address
+type PortForward struct {
+	Protocol         string   `json:"protocol" yaml:"protocol"` // tcp or udp
+	PublicPort       int      `json:"publicport" yaml:"publicport"`
+	PublicEndPort    int      `json:"publicendport,omitempty" yaml:"publicendport,omitempty"` // Defaults to the public port
+	PrivatePort      int      `json:"privateport" yaml:"privateport"`
+	PrivateEndPort   int      `json:"privateendport,omitempty" yaml:"privateendport,omitempty"` // Defaults to the private port
+	VirtualMachineID string   `json:"virtualmachineid" yaml:"virtualmachineid"`
+	VMGuestIP        string   `json:"vmguestip,omitempty" yaml:"vmguestip,omitempty"` // Defaults to the primary IP of the VM
+	CIDRs            []string `json:"cidrs,omitempty" yaml:"cidrs,omitempty"`         // Defaults to 0.0.0.0/0
+}
+
+// Rules are the desired rules of a public IP address and its network
+type Rules struct {
+	Ingress      []IngressRule `json:"ingress,omitempty" yaml:"ingress,omitempty"`
+	Egress       []EgressRule  `json:"egress,omitempty" yaml:"egress,omitempty"`
+	PortForwards []PortForward `json:"portforwards,omitempty" yaml:"portforwards,omitempty"`
+}
+
+// Kind is the kind of a rule
+type Kind string
+
+const (
+	// Ingress rules are firewall rules of a public IP address
+	Ingress Kind = "ingress"
+
+	// Egress rules are egress firewall rules of a network
+	Egress Kind = "egress"
+
+	// PortForwarding rules forward ports of a public IP address to a VM
+	PortForwarding Kind = "portforwarding"
+)
+
+// Entry is a normalized rule of any kind, either desired or live. Entries are
+// compared after normalizing them: port ranges are always complete, and CIDR
+// lists are compared as sets of networks, so e.g. "10.1.2.3/8,1.2.3.4" equals
+// "1.2.3.4/32,10.0.0.0/8".
+type Entry struct {
+	Kind             Kind
+	ID               string // Only set for live entries
+	Protocol         string
+	StartPort        int // The public port for port forwarding rules
+	EndPort          int
+	PrivatePort      int
+	PrivateEndPort   int
+	ICMPType         int
+	ICMPCode         int
+	CIDRs            []string
+	DestCIDRs        []string
+	VirtualMachineID string
+	VMGuestIP        string
+}
+
+// matches returns true if the desired entry matches the live entry. A port
+// forwarding rule without a guest IP matches any guest IP of the VM.
+func (e Entry) matches(live Entry) bool {
+	if e.Kind == PortForwarding && e.VMGuestIP == "" {
+		live.VMGuestIP = ""
+	}
+	return e.key() == live.key()
+}
+
+// key returns a string identifying the entry, ignoring its ID
+func (e Entry) key() string {
+	return fmt.Sprintf("%s|%s|%d|%d|%d|%d|%d|%d|%s|%s|%s|%s", e.Kind, e.Protocol,
+		e.StartPort, e.EndPort, e.PrivatePort, e.PrivateEndPort, e.ICMPType, e.ICMPCode,
+		strings.Join(e.CIDRs, ","), strings.Join(e.DestCIDRs, ","), e.VirtualMachineID, e.VMGuestIP)
+}
+
+func (e Entry) String() string {
+	var s string
+	switch e.Protocol {
+	case "tcp", "udp":
+		s = fmt.Sprintf("%s %s/%s", e.Kind, e.Protocol, portRange(e.StartPort, e.EndPort))
+	case "icmp":
+		s = fmt.Sprintf("%s icmp type %d code %d", e.Kind, e.ICMPType, e.ICMPCode)
+	default:
+		s = fmt.Sprintf("%s %s", e.Kind, e.Protocol)
+	}
+
+	if e.Kind == PortForwarding {
+		s = fmt.Sprintf("%s -> %s:%s", s, e.VirtualMachineID, portRange(e.PrivatePort, e.PrivateEndPort))
+		if e.VMGuestIP != "" {
+			s += " (" + e.VMGuestIP + ")"
+		}
+	}
+	if len(e.CIDRs) > 0 {
+		s += " from " + strings.Join(e.CIDRs, ",")
+	}
+	if len(e.DestCIDRs) > 0 {
+		s += " to " + strings.Join(e.DestCIDRs, ",")
+	}
+
+	return s
+}
+
+func portRange(start, end int) string {
+	if start == end {
+		return strconv.Itoa(start)
+	}
+	return fmt.Sprintf("%d-%d", start, end)
+}
+
+// conflicts returns true if CloudStack may reject both entries on the same IP
+// address or network, because their port ranges overlap
+func (e Entry) conflicts(o Entry) bool {
+	if e.Kind != o.Kind || e.Protocol != o.Protocol {
+		return false
+	}
+	if e.Protocol != "tcp" && e.Protocol != "udp" {
+		return false
+	}
+	if e.StartPort > o.EndPort || o.StartPort > e.EndPort {
+		return false
+	}
+
+	// Port forwarding rules conflict on overlapping public ports only,
+	// firewall rules also need overlapping source CIDRs
+	return e.Kind == PortForwarding || cidrsOverlap(e.CIDRs, o.CIDRs)
+}
+
+func cidrsOverlap(a, b []string) bool {
+	for _, x := range a {
+		_, nx, err := net.ParseCIDR(x)
+		if err != nil {
+			continue
+		}
+		for _, y := range b {
+			_, ny, err := net.ParseCIDR(y)
+			if err != nil {
+				continue
+			}
+			if nx.Contains(ny.IP) || ny.Contains(nx.IP) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// normalize cleans up the entry, so it can be compared with other entries.
+// The default source CIDR of egress rules is the CIDR of the network.
+func (e Entry) normalize(networkCIDR string) (Entry, error) {
+	e.Protocol = common.NormalizeProtocol(e.Protocol)
+
+	switch e.Protocol {
+	case "tcp", "udp":
+		if e.EndPort == 0 {
+			e.EndPort = e.StartPort
+		}
+		if e.Kind == PortForwarding && e.PrivateEndPort == 0 {
+			e.PrivateEndPort = e.PrivatePort
+		}
+		e.ICMPType, e.ICMPCode = 0, 0
+	case "icmp":
+		e.StartPort, e.EndPort = 0, 0
+	default:
+		e.StartPort, e.EndPort = 0, 0
+		e.ICMPType, e.ICMPCode = 0, 0
+	}
+
+	def := "0.0.0.0/0"
+	if e.Kind == Egress {
+		def = networkCIDR
+	}
+
+	var err error
+	if e.CIDRs, err = common.NormalizeCIDRs(e.CIDRs, def); err != nil {
+		return e, err
+	}
+	if e.DestCIDRs, err = common.NormalizeCIDRs(e.DestCIDRs, ""); err != nil {
+		return e, err
+	}
+
+	return e, nil
+}
+
+// validate checks the port ranges of a desired entry
+func (e Entry) validate() error {
+	if e.Protocol != "tcp" && e.Protocol != "udp" {
+		if e.Kind == PortForwarding {
+			return fmt.Errorf("Port forwarding rules must use tcp or udp, not %s", e.Protocol)
+		}
+		return nil
+	}
+
+	if e.StartPort < 1 || e.EndPort > 65535 || e.StartPort > e.EndPort {
+		return fmt.Errorf("Invalid port range %d-%d", e.StartPort, e.EndPort)
+	}
+
+	if e.Kind == PortForwarding {
+		if e.PrivatePort < 1 || e.PrivateEndPort > 65535 || e.PrivatePort > e.PrivateEndPort {
+			return fmt.Errorf("Invalid private port range %d-%d", e.PrivatePort, e.PrivateEndPort)
+		}
+		if e.EndPort-e.StartPort != e.PrivateEndPort-e.PrivatePort {
+			return fmt.Errorf("Public port range %d-%d and private port range %d-%d differ in size",
+				e.StartPort, e.EndPort, e.PrivatePort, e.PrivateEndPort)
+		}
+		if e.VirtualMachineID == "" {
+			return fmt.Errorf("Port forwarding rule %s has no virtual machine", portRange(e.StartPort, e.EndPort))
+		}
+	}
+
+	return nil
+}
+
+func icmp(v *int) int {
+	if v == nil {
+		return -1
+	}
+	return *v
+}
+
+// entries normalizes and validates the desired rules
+func (rs Rules) entries(networkCIDR string) ([]Entry, error) {
+	var desired []Entry
+
+	for _, r := range rs.Ingress {
+		desired = append(desired, Entry{
+			Kind:      Ingress,
+			Protocol:  r.Protocol,
+			StartPort: r.StartPort,
+			EndPort:   r.EndPort,
+			ICMPType:  icmp(r.ICMPType),
+			ICMPCode:  icmp(r.ICMPCode),
+			CIDRs:     r.CIDRs,
+		})
+	}
+
+	for _, r := range rs.Egress {
+		desired = append(desired, Entry{
+			Kind:      Egress,
+			Protocol:  r.Protocol,
+			StartPort: r.StartPort,
+			EndPort:   r.EndPort,
+			ICMPType:  icmp(r.ICMPType),
+			ICMPCode:  icmp(r.ICMPCode),
+			CIDRs:     r.CIDRs,
+			DestCIDRs: r.DestCIDRs,
+		})
+	}
+
+	for _, r := range rs.PortForwards {
+		desired = append(desired, Entry{
+			Kind:             PortForwarding,
+			Protocol:         r.Protocol,
+			StartPort:        r.PublicPort,
+			EndPort:          r.PublicEndPort,
+			PrivatePort:      r.PrivatePort,
+			PrivateEndPort:   r.PrivateEndPort,
+			VirtualMachineID: r.VirtualMachineID,
+			VMGuestIP:        r.VMGuestIP,
+			CIDRs:            r.CIDRs,
+		})
+	}
+
+	for n, e := range desired {
+		e, err := e.normalize(networkCIDR)
+		if err == nil {
+			err = e.validate()
+		}
+		if err != nil {
+			return nil, fmt.Errorf("Error in %s rule: %v", e.Kind, err)
+		}
+		desired[n] = e
+	}
+
+	return desired, nil
+}
+
+func ingressEntry(r *cloudstack.FirewallRule) (Entry, error) {
+	return Entry{
+		Kind:      Ingress,
+		ID:        r.Id,
+		Protocol:  r.Protocol,
+		StartPort: r.Startport,
+		EndPort:   r.Endport,
+		ICMPType:  r.Icmptype,
+		ICMPCode:  r.Icmpcode,
+		CIDRs:     common.SplitCIDRs(r.Cidrlist),
+	}.normalize("")
+}
+
+func egressEntry(r *cloudstack.EgressFirewallRule, networkCIDR string) (Entry, error) {
+	return Entry{
+		Kind:      Egress,
+		ID:        r.Id,
+		Protocol:  r.Protocol,
+		StartPort: r.Startport,
+		EndPort:   r.Endport,
+		ICMPType:  r.Icmptype,
+		ICMPCode:  r.Icmpcode,
+		CIDRs:     common.SplitCIDRs(r.Cidrlist),
+		DestCIDRs: common.SplitCIDRs(r.Destcidrlist),
+	}.normalize(networkCIDR)
+}
+
+// portForwardingEntry normalizes a live port forwarding rule. Unlike firewall
+// rules, CloudStack returns the ports of these rules as strings.
+func portForwardingEntry(r *cloudstack.PortForwardingRule) (Entry, error) {
+	e := Entry{
+		Kind:             PortForwarding,
+		ID:               r.Id,
+		Protocol:         r.Protocol,
+		CIDRs:            common.SplitCIDRs(r.Cidrlist),
+		VirtualMachineID: r.Virtualmachineid,
+		VMGuestIP:        r.Vmguestip,
+	}
+	e.StartPort, _ = strconv.Atoi(r.Publicport)
+	e.EndPort, _ = strconv.Atoi(r.Publicendport)
+	e.PrivatePort, _ = strconv.Atoi(r.Privateport)
+	e.PrivateEndPort, _ = strconv.Atoi(r.Privateendport)
+
+	return e.normalize("")
+}
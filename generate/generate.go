@@ -37,6 +37,10 @@ import (
 
 const pkg = "cloudstack"
 
+// mockPkg is the name of the package (and the directory within
+// the cloudstack package) that holds the generated mocks.
+const mockPkg = "mock"
+
 // detailsRequireKeyValue is a prefilled map with a list of details
 // that need to be encoded using an explicit key and a value entry.
 var detailsRequireKeyValue = map[string]bool{
@@ -49,9 +53,86 @@ var detailsRequireKeyValue = map[string]bool{
 	"updateZone":                  true,
 }
 
-// We prefill this one value to make sure it is not
-// created twice, as this is also a top level type.
-var typeNames = map[string]bool{"Nic": true}
+// listParam describes a parameter of type map, which is actually a list of
+// objects with a fixed set of fields (e.g. iptonetworklist[0].networkid=...).
+type listParam struct {
+	name     string      // The name of the parameter
+	typeName string      // The Go type of the list elements
+	fields   []listField // The fixed fields of every object
+	extra    string      // An optional map field used to set free-form keys
+}
+
+type listField struct {
+	name string // The name of the field in the Go type
+	key  string // The name of the key used to encode the field
+}
+
+var (
+	dataDiskOfferingParam = &listParam{
+		name:     "datadiskofferinglist",
+		typeName: "DataDiskOffering",
+		fields: []listField{
+			{"DataDiskTemplateID", "datadisktemplateid"},
+			{"DiskOfferingID", "diskofferingid"},
+		},
+	}
+	dhcpOptionsNetworkParam = &listParam{
+		name:     "dhcpoptionsnetworklist",
+		typeName: "DHCPOptionsNetwork",
+		fields: []listField{
+			{"NetworkID", "networkid"},
+		},
+		extra: "Options",
+	}
+	ipToNetworkParam = &listParam{
+		name:     "iptonetworklist",
+		typeName: "IPToNetwork",
+		fields: []listField{
+			{"NetworkID", "networkid"},
+			{"IP", "ip"},
+			{"IPv6", "ipv6"},
+			{"MAC", "mac"},
+		},
+	}
+	serviceCapabilityParam = &listParam{
+		name:     "servicecapabilitylist",
+		typeName: "ServiceCapability",
+		fields: []listField{
+			{"Service", "service"},
+			{"CapabilityType", "capabilitytype"},
+			{"CapabilityValue", "capabilityvalue"},
+		},
+	}
+)
+
+// listParams is a per command table of the parameters that need to be
+// generated as a typed list of objects instead of as a map[string]string.
+var listParams = map[string]map[string]*listParam{
+	"createNetworkOffering": {
+		"servicecapabilitylist": serviceCapabilityParam,
+	},
+	"createVPCOffering": {
+		"servicecapabilitylist": serviceCapabilityParam,
+	},
+	"deployVirtualMachine": {
+		"datadiskofferinglist":   dataDiskOfferingParam,
+		"dhcpoptionsnetworklist": dhcpOptionsNetworkParam,
+		"iptonetworklist":        ipToNetworkParam,
+	},
+	"updateVirtualMachine": {
+		"dhcpoptionsnetworklist": dhcpOptionsNetworkParam,
+	},
+}
+
+// We prefill these values to make sure they are not created
+// twice, as these are also top level types.
+var typeNames = map[string]bool{
+	"Nic":                true,
+	"DataDiskOffering":   true,
+	"DHCPOptionsNetwork": true,
+	"IPToNetwork":        true,
+	"ServiceCapability":  true,
+}
 
 type apiInfo map[string][]string
 
@@ -84,6 +165,14 @@ func (e *goimportError) Error() string {
 	return fmt.Sprintf("GoImport failed to format:\n%v", e.output)
 }
 
+type mockgenError struct {
+	output string
+}
+
+func (e *mockgenError) Error() string {
+	return fmt.Sprintf("MockGen failed to generate mocks:\n%v", e.output)
+}
+
 type service struct {
 	name string
 	apis []*API
@@ -196,6 +285,15 @@ func main() {
 		errors = append(errors, &goimportError{string(out)})
 	}
 
+	if err := os.MkdirAll(path.Join(outdir, mockPkg), 0755); err != nil {
+		log.Fatalf("Failed to Mkdir %s: %v", path.Join(outdir, mockPkg), err)
+	}
+	for _, s := range as.services {
+		if err = s.WriteMockCode(); err != nil {
+			errors = append(errors, &generateError{s, err})
+		}
+	}
+
 	if len(errors) > 0 {
 		log.Printf("%d API(s) failed to generate:", len(errors))
 		for _, ce := range errors {
@@ -282,16 +380,16 @@ func (as *allServices) GeneralCode() ([]byte, error) {
 	pn("type CloudStackClient struct {")
 	pn("	HTTPGETOnly bool // If `true` only use HTTP GET calls")
 	pn("")
-	pn("	client  *http.Client // The http client for communicating")
-	pn("	baseURL string       // The base URL of the API")
-	pn("	apiKey  string       // Api key")
-	pn("	secret  string       // Secret key")
-	pn("	async   bool         // Wait for async calls to finish")
-	pn("	options []OptionFunc // A list of option functions to apply to all API calls")
-	pn("	timeout int64        // Max waiting timeout in seconds for async jobs to finish; defaults to 300 seconds")
+	pn("	client    *http.Client  // The http client for communicating")
+	pn("	endpoints *endpointPool // The base URLs of the API (one per management server)")
+	pn("	apiKey    string        // Api key")
+	pn("	secret    string        // Secret key")
+	pn("	async     bool          // Wait for async calls to finish")
+	pn("	options   []OptionFunc  // A list of option functions to apply to all API calls")
+	pn("	timeout   int64         // Max waiting timeout in seconds for async jobs to finish; defaults to 300 seconds")
 	pn("")
 	for _, s := range as.services {
-		pn("  %s *%s", strings.TrimSuffix(s.name, "Service"), s.name)
+		pn("  %s %sIface", strings.TrimSuffix(s.name, "Service"), s.name)
 	}
 	pn("}")
 	pn("")
@@ -316,12 +414,12 @@ func (as *allServices) GeneralCode() ([]byte, error) {
 	pn("			},")
 	pn("		Timeout: time.Duration(60 * time.Second),")
 	pn("		},")
-	pn("		baseURL: apiurl,")
-	pn("		apiKey:  apikey,")
-	pn("		secret:  secret,")
-	pn("		async:   async,")
-	pn("		options: []OptionFunc{},")
-	pn("		timeout: 300,")
+	pn("		endpoints: newEndpointPool(apiurl),")
+	pn("		apiKey:    apikey,")
+	pn("		secret:    secret,")
+	pn("		async:     async,")
+	pn("		options:   []OptionFunc{},")
+	pn("		timeout:   300,")
 	pn("	}")
 	pn("")
 	pn("	for _, fn := range options {")
@@ -433,21 +531,31 @@ func (as *allServices) GeneralCode() ([]byte, error) {
 	pn("")
 	pn("	var err error")
 	pn("	var resp *http.Response")
-	pn("	if !cs.HTTPGETOnly && (api == \"deployVirtualMachine\" || api == \"login\" || api == \"updateVirtualMachine\") {")
-	pn("		// The deployVirtualMachine API should be called using a POST call")
-	pn("  	// so we don't have to worry about the userdata size")
-	pn("")
-	pn("		// Add the unescaped signature to the POST params")
-	pn("		params.Set(\"signature\", signature)")
-	pn("")
-	pn("		// Make a POST call")
-	pn("		resp, err = cs.client.PostForm(cs.baseURL, params)")
-	pn("	} else {")
-	pn("		// Create the final URL before we issue the request")
-	pn("		url := cs.baseURL + \"?\" + s + \"&signature=\" + url.QueryEscape(signature)")
+	pn("	for _, ep := range cs.endpoints.candidates() {")
+	pn("		if !cs.HTTPGETOnly && (api == \"deployVirtualMachine\" || api == \"login\" || api == \"updateVirtualMachine\") {")
+	pn("			// The deployVirtualMachine API should be called using a POST call")
+	pn("			// so we don't have to worry about the userdata size")
+	pn("")
+	pn("			// Add the unescaped signature to the POST params")
+	pn("			params.Set(\"signature\", signature)")
+	pn("")
+	pn("			// Make a POST call")
+	pn("			resp, err = cs.client.PostForm(ep.url, params)")
+	pn("		} else {")
+	pn("			// Create the final URL before we issue the request")
+	pn("			url := ep.url + \"?\" + s + \"&signature=\" + url.QueryEscape(signature)")
+	pn("")
+	pn("			// Make a GET call")
+	pn("			resp, err = cs.client.Get(url)")
+	pn("		}")
 	pn("")
-	pn("		// Make a GET call")
-	pn("		resp, err = cs.client.Get(url)")
+	pn("		// Only fail over to the next endpoint if this one could not be reached at all")
+	pn("		if err != nil && isConnectionError(err) {")
+	pn("			cs.endpoints.markDown(ep)")
+	pn("			continue")
+	pn("		}")
+	pn("		cs.endpoints.markUp(ep)")
+	pn("		break")
 	pn("	}")
 	pn("	if err != nil {")
 	pn("		return nil, err")
@@ -647,12 +755,24 @@ func (as *allServices) GeneralCode() ([]byte, error) {
 	pn("	}")
 	pn("}")
 	pn("")
+	for _, lp := range sortedListParams() {
+		pn("// %s is a single entry of the %s parameter", lp.typeName, lp.name)
+		pn("type %s struct {", lp.typeName)
+		for _, f := range lp.fields {
+			pn("	%s string", f.name)
+		}
+		if lp.extra != "" {
+			pn("	%s map[string]string", lp.extra)
+		}
+		pn("}")
+		pn("")
+	}
 	for _, s := range as.services {
 		pn("type %s struct {", s.name)
 		pn("  cs *CloudStackClient")
 		pn("}")
 		pn("")
-		pn("func New%s(cs *CloudStackClient) *%s {", s.name, s.name)
+		pn("func New%s(cs *CloudStackClient) %sIface {", s.name, s.name)
 		pn("	return &%s{cs: cs}", s.name)
 		pn("}")
 		pn("")
@@ -680,6 +800,41 @@ func (s *service) WriteGeneratedCode() error {
 	return ioutil.WriteFile(file, code, 0644)
 }
 
+// sortedListParams returns all unique list params sorted by their type name
+func sortedListParams() []*listParam {
+	found := make(map[string]*listParam)
+	for _, params := range listParams {
+		for _, lp := range params {
+			found[lp.typeName] = lp
+		}
+	}
+
+	var lps []*listParam
+	for _, lp := range found {
+		lps = append(lps, lp)
+	}
+	sort.Slice(lps, func(i, j int) bool {
+		return lps[i].typeName < lps[j].typeName
+	})
+
+	return lps
+}
+
+func (s *service) WriteMockCode() error {
+	// Use relative paths, as they end up in the header of the generated mocks
+	out, err := exec.Command(
+		"mockgen",
+		"-source", path.Join("..", pkg, s.name+".go"),
+		"-destination", path.Join("..", pkg, mockPkg, s.name+"_mock.go"),
+		"-package", mockPkg,
+		"-copyright_file", "header.txt",
+	).CombinedOutput()
+	if err != nil {
+		return &mockgenError{string(out)}
+	}
+	return nil
+}
+
 func (s *service) GenerateCode() ([]byte, error) {
 	// Buffer the output in memory, for gofmt'ing later in the defer.
 	var buf bytes.Buffer
@@ -715,6 +870,7 @@ func (s *service) GenerateCode() ([]byte, error) {
 	pn("")
 	pn("package %s", pkg)
 	pn("")
+	s.generateInterfaceType()
 	if s.name == "FirewallService" {
 		pn("// Helper function for maintaining backwards compatibility")
 		pn("func convertFirewallServiceResponse(b []byte) ([]byte, error) {")
@@ -806,6 +962,15 @@ func (s *service) GenerateCode() ([]byte, error) {
 		pn("	p map[string]interface{}")
 		pn("}")
 		pn("")
+		pn("// Copy returns a copy of the parameters, which can be changed without changing p")
+		pn("func (p *CustomServiceParams) Copy() *CustomServiceParams {")
+		pn("	c := &CustomServiceParams{p: make(map[string]interface{}, len(p.p))}")
+		pn("	for k, v := range p.p {")
+		pn("		c.p[k] = v")
+		pn("	}")
+		pn("	return c")
+		pn("}")
+		pn("")
 		pn("func (p *CustomServiceParams) toURLValues() url.Values {")
 		pn("	u := url.Values{}")
 		pn("	if p.p == nil {")
@@ -872,12 +1037,109 @@ func (s *service) GenerateCode() ([]byte, error) {
 	return clean, nil
 }
 
+func (s *service) generateInterfaceType() {
+	p, pn := s.p, s.pn
+
+	pn("type %sIface interface {", s.name)
+	if s.name == "CustomService" {
+		pn("	CustomRequest(api string, p *CustomServiceParams, result interface{}) error")
+	}
+	for _, a := range s.apis {
+		n := capitalize(a.Name)
+
+		// The method for creating a new parameter struct
+		p("	New%s(", n+"Params")
+		for _, ap := range a.Params {
+			if ap.Required {
+				p("%s %s, ", s.parseParamName(ap.Name), paramType(a.Name, ap))
+			}
+		}
+		pn(") *%s", n+"Params")
+
+		// The courtesy helper methods, if any
+		for _, h := range s.helperSignatures(a) {
+			pn("	%s", h)
+		}
+
+		// The method for calling the API itself
+		pn("	%s(p *%s) (*%s, error)", n, n+"Params", strings.TrimPrefix(n, "Configure")+"Response")
+	}
+	pn("}")
+	pn("")
+}
+
+// helperSignatures returns the signatures of the courtesy helper functions
+// generated by generateHelperFuncs, in the same order as they are generated.
+func (s *service) helperSignatures(a *API) []string {
+	var sigs []string
+
+	if !strings.HasPrefix(a.Name, "list") {
+		return sigs
+	}
+
+	ln := strings.TrimPrefix(a.Name, "list")
+	sn := parseSingular(ln)
+
+	v, found := hasNameOrKeywordParamField(a.Params)
+	if found && hasIDAndNameResponseField(a.Response) {
+		// Check if ID is a required parameters and bail if so
+		for _, ap := range a.Params {
+			if ap.Required && ap.Name == "id" {
+				return sigs
+			}
+		}
+
+		var args string
+		for _, ap := range a.Params {
+			if ap.Required {
+				args += fmt.Sprintf("%s %s, ", s.parseParamName(ap.Name), mapType(ap.Type))
+			}
+		}
+		if sn == "Iso" {
+			args += "isofilter string, "
+		}
+		if sn == "Template" || sn == "Iso" {
+			args += "zoneid string, "
+		}
+
+		sigs = append(sigs, fmt.Sprintf("Get%sID(%s string, %sopts ...OptionFunc) (string, int, error)", sn, v, args))
+		if hasIDParamField(a.Params) {
+			sigs = append(sigs, fmt.Sprintf("Get%sByName(name string, %sopts ...OptionFunc) (*%s, int, error)", sn, args, sn))
+		}
+	}
+
+	if hasIDParamField(a.Params) {
+		var args string
+		for _, ap := range a.Params {
+			if ap.Required && s.parseParamName(ap.Name) != "id" {
+				args += fmt.Sprintf("%s %s, ", ap.Name, mapType(ap.Type))
+			}
+		}
+
+		rt := sn
+		if ln == "LoadBalancerRuleInstances" {
+			rt = "VirtualMachine"
+		}
+		sigs = append(sigs, fmt.Sprintf("Get%sByID(id string, %sopts ...OptionFunc) (*%s, int, error)", sn, args, rt))
+	}
+
+	return sigs
+}
+
 func (s *service) generateParamType(a *API) {
 	pn := s.pn
 
 	pn("type %s struct {", capitalize(a.Name+"Params"))
 	pn("	p map[string]interface{}")
 	pn("}\n")
+	pn("// Copy returns a copy of the parameters, which can be changed without changing p")
+	pn("func (p *%s) Copy() *%s {", capitalize(a.Name+"Params"), capitalize(a.Name+"Params"))
+	pn("	c := &%s{p: make(map[string]interface{}, len(p.p))}", capitalize(a.Name+"Params"))
+	pn("	for k, v := range p.p {")
+	pn("		c.p[k] = v")
+	pn("	}")
+	pn("	return c")
+	pn("}\n")
 }
 
 func (s *service) generateToURLValuesFunc(a *API) {
@@ -890,7 +1152,11 @@ func (s *service) generateToURLValuesFunc(a *API) {
 	pn("	}")
 	for _, ap := range a.Params {
 		pn("	if v, found := p.p[\"%s\"]; found {", ap.Name)
-		s.generateConvertCode(a.Name, ap.Name, mapType(ap.Type))
+		if lp, ok := listParams[a.Name][ap.Name]; ok {
+			s.generateListParamConvertCode(ap.Name, lp)
+		} else {
+			s.generateConvertCode(a.Name, ap.Name, mapType(ap.Type))
+		}
 		pn("	}")
 	}
 	pn("	return u")
@@ -927,12 +1193,18 @@ func (s *service) generateConvertCode(cmd, name, typ string) {
 			} else {
 				pn("	u.Set(fmt.Sprintf(\"%s[%%d].%%s\", i, k), m[k])", name)
 			}
+		case "gslblbruleweightsmap":
+			pn("	u.Set(fmt.Sprintf(\"%s[%%d].loadbalancerid\", i), k)", name)
+			pn("	u.Set(fmt.Sprintf(\"%s[%%d].weight\", i), m[k])", name)
 		case "serviceproviderlist":
 			pn("	u.Set(fmt.Sprintf(\"%s[%%d].service\", i), k)", name)
 			pn("	u.Set(fmt.Sprintf(\"%s[%%d].provider\", i), m[k])", name)
 		case "usersecuritygrouplist":
 			pn("	u.Set(fmt.Sprintf(\"%s[%%d].account\", i), k)", name)
 			pn("	u.Set(fmt.Sprintf(\"%s[%%d].group\", i), m[k])", name)
+		case "vmidipmap":
+			pn("	u.Set(fmt.Sprintf(\"%s[%%d].vmid\", i), k)", name)
+			pn("	u.Set(fmt.Sprintf(\"%s[%%d].vmip\", i), m[k])", name)
 		default:
 			pn("	u.Set(fmt.Sprintf(\"%s[%%d].key\", i), k)", name)
 			pn("	u.Set(fmt.Sprintf(\"%s[%%d].value\", i), m[k])", name)
@@ -941,6 +1213,32 @@ func (s *service) generateConvertCode(cmd, name, typ string) {
 	}
 }
 
+func (s *service) generateListParamConvertCode(name string, lp *listParam) {
+	pn := s.pn
+
+	pn("l := v.([]%s)", lp.typeName)
+	pn("for i, e := range l {")
+	for _, f := range lp.fields {
+		pn("	if e.%s != \"\" {", f.name)
+		pn("		u.Set(fmt.Sprintf(\"%s[%%d].%s\", i), e.%s)", name, f.key, f.name)
+		pn("	}")
+	}
+	if lp.extra != "" {
+		pn("	for _, k := range getSortedKeysFromMap(e.%s) {", lp.extra)
+		pn("		u.Set(fmt.Sprintf(\"%s[%%d].%%s\", i, k), e.%s[k])", name, lp.extra)
+		pn("	}")
+	}
+	pn("}")
+}
+
+// paramType returns the Go type of the given param of the given command
+func paramType(cmd string, ap *APIParam) string {
+	if lp, ok := listParams[cmd][ap.Name]; ok {
+		return "[]" + lp.typeName
+	}
+	return mapType(ap.Type)
+}
+
 func (s *service) parseParamName(name string) string {
 	if name != "type" {
 		return name
@@ -954,7 +1252,7 @@ func (s *service) generateParamSettersFunc(a *API) {
 
 	for _, ap := range a.Params {
 		if !found[ap.Name] {
-			pn("func (p *%s) Set%s(v %s) {", capitalize(a.Name+"Params"), capitalize(ap.Name), mapType(ap.Type))
+			pn("func (p *%s) Set%s(v %s) {", capitalize(a.Name+"Params"), capitalize(ap.Name), paramType(a.Name, ap))
 			pn("	if p.p == nil {")
 			pn("		p.p = make(map[string]interface{})")
 			pn("	}")
@@ -978,7 +1276,7 @@ func (s *service) generateNewParamTypeFunc(a *API) {
 	for _, ap := range a.Params {
 		if ap.Required {
 			rp = append(rp, ap)
-			p("%s %s, ", s.parseParamName(ap.Name), mapType(ap.Type))
+			p("%s %s, ", s.parseParamName(ap.Name), paramType(a.Name, ap))
 		}
 	}
 	pn(") *%s {", tn)
@@ -1269,6 +1567,8 @@ func (s *service) generateNewAPICallFunc(a *API) {
 		"CreateSecurityGroup",
 		"CreateServiceOffering",
 		"CreateUser",
+		"GetUploadParamsForTemplate",
+		"GetUploadParamsForVolume",
 		"GetVirtualMachineUserData",
 		"RegisterSSHKeyPair",
 		"RegisterUserKeys":
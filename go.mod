@@ -2,4 +2,7 @@ module github.com/xanzy/go-cloudstack/v2
 
 go 1.13
 
-require golang.org/x/tools v0.1.0 // indirect
+require (
+	github.com/golang/mock v1.6.0
+	golang.org/x/crypto v0.0.0-20211117183948-ae814b36b871
+)
@@ -0,0 +1,493 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+// Package gslb assembles a global server load balancer (GSLB) from load
+// balancer rules in different zones. Sites are given by the name of their
+// zone-local load balancer rule, so callers do not have to look up the IDs
+// of rules in each zone themselves.
+package gslb
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/xanzy/go-cloudstack/v2/cloudstack"
+	"github.com/xanzy/go-cloudstack/v2/internal/common"
+	"github.com/xanzy/go-cloudstack/v2/waiter"
+)
+
+// DefaultTimeout is the default time to wait for a single change
+const DefaultTimeout = 5 * time.Minute
+
+// MaxWeight is the highest weight CloudStack accepts for a site
+const MaxWeight = 100
+
+// Site is a zone-local load balancer rule taking part in the GSLB
+type Site struct {
+	// Name is the name of the load balancer rule in the zone
+	Name string `json:"name" yaml:"name"`
+
+	// Zone is the name or ID of the zone of the load balancer rule
+	Zone string `json:"zone" yaml:"zone"`
+
+	// PublicIPID is only needed when more than one load balancer rule in
+	// the zone has the same name
+	PublicIPID string `json:"public_ip_id,omitempty" yaml:"public_ip_id,omitempty"`
+
+	// Weight is the share of traffic sent to the site, between 1 and
+	// MaxWeight. A weight of 0 leaves it to CloudStack, which uses 1.
+	Weight int `json:"weight,omitempty" yaml:"weight,omitempty"`
+}
+
+// Rule is the desired global load balancer rule
+type Rule struct {
+	Name         string `json:"name" yaml:"name"`
+	Description  string `json:"description,omitempty" yaml:"description,omitempty"`
+	DomainName   string `json:"domain_name" yaml:"domain_name"`
+	ServiceType  string `json:"service_type" yaml:"service_type"`                       // tcp, udp or http
+	Method       string `json:"method,omitempty" yaml:"method,omitempty"`               // roundrobin, leastconn or proximity
+	StickyMethod string `json:"sticky_method,omitempty" yaml:"sticky_method,omitempty"` // sourceip
+	RegionID     int    `json:"region_id" yaml:"region_id"`
+	Sites        []Site `json:"sites" yaml:"sites"`
+}
+
+// Option is a functional option for configuring a GSLB manager
+type Option func(*Manager)
+
+// WithDryRun only plans the changes, without applying them
+func WithDryRun(dryRun bool) Option {
+	return func(m *Manager) {
+		m.dryRun = dryRun
+	}
+}
+
+// WithReweight makes the plan reassign sites which are already part of the
+// GSLB, so their desired weights are applied. CloudStack does not return the
+// weights of sites, so changed weights cannot be detected otherwise. A site is
+// briefly not served by the GSLB while it is reassigned.
+func WithReweight(reweight bool) Option {
+	return func(m *Manager) {
+		m.reweight = reweight
+	}
+}
+
+// WithTimeout sets the time to wait for a single change
+func WithTimeout(timeout time.Duration) Option {
+	return func(m *Manager) {
+		if timeout != 0 {
+			m.timeout = timeout
+		}
+	}
+}
+
+// WithOptions sets the option functions applied to every request, for
+// example to select a project
+func WithOptions(opts ...cloudstack.OptionFunc) Option {
+	return func(m *Manager) {
+		m.opts = append(m.opts, opts...)
+	}
+}
+
+// Manager creates and updates global load balancer rules
+type Manager struct {
+	cs       *cloudstack.CloudStackClient
+	dryRun   bool
+	reweight bool
+	timeout  time.Duration
+	opts     []cloudstack.OptionFunc
+	waiter   *waiter.Waiter
+}
+
+// New returns a new manager using the given client
+func New(cs *cloudstack.CloudStackClient, options ...Option) *Manager {
+	m := &Manager{
+		cs:      cs,
+		timeout: DefaultTimeout,
+	}
+
+	for _, fn := range options {
+		fn(m)
+	}
+	m.waiter = waiter.New(cs, waiter.WithTimeout(m.timeout))
+
+	return m
+}
+
+// Lookup returns the global load balancer rule with the given name in the
+// region, or nil if there is no such rule
+func (m *Manager) Lookup(name string, regionid int) (*cloudstack.GlobalLoadBalancerRule, error) {
+	p := m.cs.LoadBalancer.NewListGlobalLoadBalancerRulesParams()
+	p.SetKeyword(name)
+	p.SetRegionid(regionid)
+	p.SetListall(true)
+	if err := common.ApplyOptions(m.cs, p, m.opts...); err != nil {
+		return nil, err
+	}
+
+	l, err := m.cs.LoadBalancer.ListGlobalLoadBalancerRules(p)
+	if err != nil {
+		return nil, err
+	}
+
+	// The keyword also matches partial names and descriptions
+	for _, r := range l.GlobalLoadBalancerRules {
+		if r.Name == name {
+			return r, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// Resolve returns the load balancer rule of the site
+func (m *Manager) Resolve(site Site) (*cloudstack.LoadBalancerRule, error) {
+	zoneid := site.Zone
+	if !cloudstack.IsID(zoneid) {
+		var err error
+		if zoneid, _, err = m.cs.Zone.GetZoneID(site.Zone); err != nil {
+			return nil, err
+		}
+	}
+
+	p := m.cs.LoadBalancer.NewListLoadBalancerRulesParams()
+	p.SetName(site.Name)
+	p.SetZoneid(zoneid)
+	p.SetListall(true)
+	if site.PublicIPID != "" {
+		p.SetPublicipid(site.PublicIPID)
+	}
+	if err := common.ApplyOptions(m.cs, p, m.opts...); err != nil {
+		return nil, err
+	}
+
+	l, err := m.cs.LoadBalancer.ListLoadBalancerRules(p)
+	if err != nil {
+		return nil, err
+	}
+
+	var found []*cloudstack.LoadBalancerRule
+	for _, r := range l.LoadBalancerRules {
+		if r.Name == site.Name {
+			found = append(found, r)
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		return nil, fmt.Errorf("No load balancer rule named %s found in zone %s", site.Name, site.Zone)
+	case 1:
+		return found[0], nil
+	default:
+		return nil, fmt.Errorf(
+			"Found %d load balancer rules named %s in zone %s, set the public IP address to pick one", len(found), site.Name, site.Zone)
+	}
+}
+
+// Member is a zone-local load balancer rule to assign to the GSLB
+type Member struct {
+	Site   Site
+	RuleID string
+	ZoneID string
+}
+
+// Plan is the set of changes needed to reconcile a global load balancer rule
+type Plan struct {
+	Desired *Rule
+	Current *cloudstack.GlobalLoadBalancerRule // Nil if the rule does not exist yet
+	Create  bool
+	Update  []string // The rule attributes that will be updated
+	Assign  []Member
+	Remove  []string // The IDs of the load balancer rules to remove
+}
+
+// Empty returns true if the global load balancer rule is already in the
+// desired state
+func (p *Plan) Empty() bool {
+	return !p.Create && len(p.Update) == 0 && len(p.Assign) == 0 && len(p.Remove) == 0
+}
+
+// Plan resolves the sites of the desired rule and compares it with the live
+// global load balancer rule. The domain name and service type of an existing
+// rule cannot be changed, so an error is returned if they differ.
+func (m *Manager) Plan(desired *Rule) (*Plan, error) {
+	if err := validate(desired); err != nil {
+		return nil, err
+	}
+
+	members := make([]Member, 0, len(desired.Sites))
+	zones := make(map[string]string)
+
+	for _, site := range desired.Sites {
+		r, err := m.Resolve(site)
+		if err != nil {
+			return nil, err
+		}
+
+		// CloudStack only allows one load balancer rule per zone
+		if other, ok := zones[r.Zoneid]; ok {
+			return nil, fmt.Errorf("Sites %s and %s are both in zone %s", other, site.Name, r.Zonename)
+		}
+		zones[r.Zoneid] = site.Name
+
+		members = append(members, Member{Site: site, RuleID: r.Id, ZoneID: r.Zoneid})
+	}
+
+	current, err := m.Lookup(desired.Name, desired.RegionID)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &Plan{Desired: desired, Current: current}
+
+	if current == nil {
+		plan.Create = true
+		plan.Assign = members
+		return plan, nil
+	}
+
+	if !strings.EqualFold(desired.DomainName, current.Gslbdomainname) {
+		return nil, fmt.Errorf("Cannot change the domain name of global load balancer rule %s from %s to %s",
+			current.Name, current.Gslbdomainname, desired.DomainName)
+	}
+	if !strings.EqualFold(desired.ServiceType, current.Gslbservicetype) {
+		return nil, fmt.Errorf("Cannot change the service type of global load balancer rule %s from %s to %s",
+			current.Name, current.Gslbservicetype, desired.ServiceType)
+	}
+
+	if desired.Description != current.Description {
+		plan.Update = append(plan.Update, "description")
+	}
+	if desired.Method != "" && !strings.EqualFold(desired.Method, current.Gslblbmethod) {
+		plan.Update = append(plan.Update, "method")
+	}
+	if desired.StickyMethod != "" && !strings.EqualFold(desired.StickyMethod, current.Gslbstickysessionmethodname) {
+		plan.Update = append(plan.Update, "sticky method")
+	}
+
+	live := make(map[string]bool)
+	for _, r := range current.Loadbalancerrule {
+		live[r.Id] = true
+	}
+
+	wanted := make(map[string]bool)
+	for _, mb := range members {
+		wanted[mb.RuleID] = true
+		if !live[mb.RuleID] {
+			plan.Assign = append(plan.Assign, mb)
+		} else if m.reweight {
+			plan.Remove = append(plan.Remove, mb.RuleID)
+			plan.Assign = append(plan.Assign, mb)
+		}
+	}
+	for _, r := range current.Loadbalancerrule {
+		if !wanted[r.Id] {
+			plan.Remove = append(plan.Remove, r.Id)
+		}
+	}
+	sort.Strings(plan.Remove)
+
+	return plan, nil
+}
+
+func validate(r *Rule) error {
+	if r.Name == "" || r.DomainName == "" || r.ServiceType == "" {
+		return fmt.Errorf("A global load balancer rule needs a name, domain name and service type")
+	}
+	for _, s := range r.Sites {
+		if s.Name == "" || s.Zone == "" {
+			return fmt.Errorf("Every site of global load balancer rule %s needs a name and zone", r.Name)
+		}
+		if s.Weight < 0 || s.Weight > MaxWeight {
+			return fmt.Errorf("Weight %d of site %s is not between 1 and %d, or 0 for the CloudStack default", s.Weight, s.Name, MaxWeight)
+		}
+	}
+	return nil
+}
+
+// Apply applies the plan, unless the manager is in dry-run mode, and returns
+// the resulting global load balancer rule. New sites are assigned before old
+// sites are removed. Reweighted sites are removed and assigned again one at a
+// time, so the other sites keep serving traffic.
+func (m *Manager) Apply(ctx context.Context, plan *Plan) (*cloudstack.GlobalLoadBalancerRule, error) {
+	if m.dryRun || plan.Empty() {
+		return plan.Current, nil
+	}
+
+	d := plan.Desired
+
+	var id string
+	if plan.Current != nil {
+		id = plan.Current.Id
+	}
+
+	if plan.Create {
+		var err error
+		if id, err = m.create(ctx, d); err != nil {
+			return nil, fmt.Errorf("Error creating global load balancer rule %s: %v", d.Name, err)
+		}
+	}
+
+	if len(plan.Update) > 0 {
+		if err := m.update(ctx, id, d); err != nil {
+			return nil, fmt.Errorf("Error updating global load balancer rule %s: %v", d.Name, err)
+		}
+	}
+
+	removing := make(map[string]bool)
+	for _, ruleid := range plan.Remove {
+		removing[ruleid] = true
+	}
+
+	var assign []Member
+	for _, mb := range plan.Assign {
+		if !removing[mb.RuleID] {
+			assign = append(assign, mb)
+			continue
+		}
+
+		// The site is reweighted, which means removing and assigning it again
+		delete(removing, mb.RuleID)
+		if err := m.remove(ctx, id, []string{mb.RuleID}); err != nil {
+			return nil, fmt.Errorf("Error removing site %s from global load balancer rule %s: %v", mb.Site.Name, d.Name, err)
+		}
+		if err := m.assign(ctx, id, []Member{mb}); err != nil {
+			return nil, fmt.Errorf("Error assigning site %s to global load balancer rule %s: %v", mb.Site.Name, d.Name, err)
+		}
+	}
+
+	if len(assign) > 0 {
+		if err := m.assign(ctx, id, assign); err != nil {
+			return nil, fmt.Errorf("Error assigning sites to global load balancer rule %s: %v", d.Name, err)
+		}
+	}
+
+	var remove []string
+	for _, ruleid := range plan.Remove {
+		if removing[ruleid] {
+			remove = append(remove, ruleid)
+		}
+	}
+	if len(remove) > 0 {
+		if err := m.remove(ctx, id, remove); err != nil {
+			return nil, fmt.Errorf("Error removing sites from global load balancer rule %s: %v", d.Name, err)
+		}
+	}
+
+	r, _, err := m.cs.LoadBalancer.GetGlobalLoadBalancerRuleByID(id, m.opts...)
+	return r, err
+}
+
+// Ensure plans and applies the desired global load balancer rule in one go
+func (m *Manager) Ensure(ctx context.Context, desired *Rule) (*cloudstack.GlobalLoadBalancerRule, *Plan, error) {
+	plan, err := m.Plan(desired)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r, err := m.Apply(ctx, plan)
+	return r, plan, err
+}
+
+// Delete deletes the global load balancer rule. The zone-local load balancer
+// rules of its sites are left alone.
+func (m *Manager) Delete(ctx context.Context, id string) error {
+	resp, err := m.cs.LoadBalancer.DeleteGlobalLoadBalancerRule(m.cs.LoadBalancer.NewDeleteGlobalLoadBalancerRuleParams(id))
+	if err != nil {
+		return err
+	}
+	return m.waiter.WaitForJob(ctx, resp.JobID)
+}
+
+func (m *Manager) create(ctx context.Context, d *Rule) (string, error) {
+	p := m.cs.LoadBalancer.NewCreateGlobalLoadBalancerRuleParams(d.DomainName, d.ServiceType, d.Name, d.RegionID)
+	if d.Description != "" {
+		p.SetDescription(d.Description)
+	}
+	if d.Method != "" {
+		p.SetGslblbmethod(d.Method)
+	}
+	if d.StickyMethod != "" {
+		p.SetGslbstickysessionmethodname(d.StickyMethod)
+	}
+	if err := common.ApplyOptions(m.cs, p, m.opts...); err != nil {
+		return "", err
+	}
+
+	resp, err := m.cs.LoadBalancer.CreateGlobalLoadBalancerRule(p)
+	if err != nil {
+		return "", err
+	}
+
+	if err := m.waiter.WaitForJobInto(ctx, resp.JobID, resp); err != nil {
+		return "", err
+	}
+
+	return resp.Id, nil
+}
+
+func (m *Manager) update(ctx context.Context, id string, d *Rule) error {
+	p := m.cs.LoadBalancer.NewUpdateGlobalLoadBalancerRuleParams(id)
+	p.SetDescription(d.Description)
+	if d.Method != "" {
+		p.SetGslblbmethod(d.Method)
+	}
+	if d.StickyMethod != "" {
+		p.SetGslbstickysessionmethodname(d.StickyMethod)
+	}
+
+	resp, err := m.cs.LoadBalancer.UpdateGlobalLoadBalancerRule(p)
+	if err != nil {
+		return err
+	}
+	return m.waiter.WaitForJob(ctx, resp.JobID)
+}
+
+func (m *Manager) assign(ctx context.Context, id string, members []Member) error {
+	ruleids := make([]string, 0, len(members))
+	weights := make(map[string]string)
+	for _, mb := range members {
+		ruleids = append(ruleids, mb.RuleID)
+		if mb.Site.Weight > 0 {
+			weights[mb.RuleID] = fmt.Sprint(mb.Site.Weight)
+		}
+	}
+
+	p := m.cs.LoadBalancer.NewAssignToGlobalLoadBalancerRuleParams(id, ruleids)
+	if len(weights) > 0 {
+		p.SetGslblbruleweightsmap(weights)
+	}
+
+	resp, err := m.cs.LoadBalancer.AssignToGlobalLoadBalancerRule(p)
+	if err != nil {
+		return err
+	}
+	return m.waiter.WaitForJob(ctx, resp.JobID)
+}
+
+func (m *Manager) remove(ctx context.Context, id string, ruleids []string) error {
+	resp, err := m.cs.LoadBalancer.RemoveFromGlobalLoadBalancerRule(
+		m.cs.LoadBalancer.NewRemoveFromGlobalLoadBalancerRuleParams(id, ruleids))
+	if err != nil {
+		return err
+	}
+	return m.waiter.WaitForJob(ctx, resp.JobID)
+}
@@ -0,0 +1,193 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package gslb
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/xanzy/go-cloudstack/v2/cloudstack"
+)
+
+const (
+	zoneA = "a0000000-0000-0000-0000-000000000000"
+	zoneB = "b0000000-0000-0000-0000-000000000000"
+)
+
+func TestValidate(t *testing.T) {
+	valid := func() *Rule {
+		return &Rule{Name: "web", DomainName: "web", ServiceType: "http", Sites: []Site{{Name: "a", Zone: zoneA}}}
+	}
+
+	if err := validate(valid()); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, w := range []int{0, 1, MaxWeight} {
+		r := valid()
+		r.Sites[0].Weight = w
+		if err := validate(r); err != nil {
+			t.Errorf("Weight %d: %v", w, err)
+		}
+	}
+
+	cases := map[string]func(r *Rule){
+		"no name":         func(r *Rule) { r.Name = "" },
+		"no domain name":  func(r *Rule) { r.DomainName = "" },
+		"no service type": func(r *Rule) { r.ServiceType = "" },
+		"site no zone":    func(r *Rule) { r.Sites[0].Zone = "" },
+		"negative weight": func(r *Rule) { r.Sites[0].Weight = -1 },
+		"weight too high": func(r *Rule) { r.Sites[0].Weight = MaxWeight + 1 },
+	}
+
+	for name, fn := range cases {
+		r := valid()
+		fn(r)
+		if err := validate(r); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+// newGSLBServer serves one load balancer rule per zone and an existing global
+// load balancer rule web, which has the rule of zone A and an old rule assigned
+func newGSLBServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		switch q.Get("command") {
+		case "listLoadBalancerRules":
+			zone := q.Get("zoneid")
+			fmt.Fprintf(w, `{"listloadbalancerrulesresponse":{"count":2,"loadbalancerrule":[`+
+				`{"id":"lb-%[1]s","name":"%[2]s","zoneid":"%[3]s"},{"id":"lb-%[1]s-2","name":"%[2]s-2","zoneid":"%[3]s"}]}}`,
+				zone[:1], q.Get("name"), zone)
+		case "listGlobalLoadBalancerRules":
+			w.Write([]byte(`{"listgloballoadbalancerrulesresponse":{"count":2,"globalloadbalancerrule":[` +
+				`{"id":"g2","name":"web-old"},` +
+				`{"id":"g1","name":"web","gslbdomainname":"web","gslbservicetype":"http","gslblbmethod":"roundrobin",` +
+				`"loadbalancerrule":[{"id":"lb-a"},{"id":"lb-old"}]}]}}`))
+		default:
+			t.Errorf("Unexpected command: %s", q.Get("command"))
+		}
+	}))
+}
+
+func TestPlan(t *testing.T) {
+	srv := newGSLBServer(t)
+	defer srv.Close()
+
+	cs := cloudstack.NewClient(srv.URL, "key", "secret", false)
+	desired := &Rule{
+		Name:        "web",
+		DomainName:  "WEB",
+		ServiceType: "http",
+		Method:      "leastconn",
+		Sites:       []Site{{Name: "web-a", Zone: zoneA}, {Name: "web-b", Zone: zoneB, Weight: 2}},
+	}
+
+	plan, err := New(cs).Plan(desired)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if plan.Create || plan.Current.Id != "g1" {
+		t.Fatalf("Expected the existing rule g1 to be updated, got %+v", plan.Current)
+	}
+	if !reflect.DeepEqual(plan.Update, []string{"method"}) {
+		t.Fatalf("Expected only the method to be updated, got %v", plan.Update)
+	}
+	if len(plan.Assign) != 1 || plan.Assign[0].RuleID != "lb-b" || plan.Assign[0].Site.Weight != 2 {
+		t.Fatalf("Expected only lb-b to be assigned, got %+v", plan.Assign)
+	}
+	if !reflect.DeepEqual(plan.Remove, []string{"lb-old"}) {
+		t.Fatalf("Expected only lb-old to be removed, got %v", plan.Remove)
+	}
+
+	// Reweighting removes and assigns the sites that are already assigned
+	plan, err = New(cs, WithReweight(true)).Plan(desired)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(plan.Assign) != 2 || !reflect.DeepEqual(plan.Remove, []string{"lb-a", "lb-old"}) {
+		t.Fatalf("Expected lb-a to be reassigned, got %d assignments and removals %v", len(plan.Assign), plan.Remove)
+	}
+}
+
+func TestPlanErrors(t *testing.T) {
+	srv := newGSLBServer(t)
+	defer srv.Close()
+
+	cs := cloudstack.NewClient(srv.URL, "key", "secret", false)
+
+	cases := map[string]*Rule{
+		"same zone": {Name: "web", DomainName: "web", ServiceType: "http",
+			Sites: []Site{{Name: "web-a", Zone: zoneA}, {Name: "web-a2", Zone: zoneA}}},
+		"domain name": {Name: "web", DomainName: "www", ServiceType: "http",
+			Sites: []Site{{Name: "web-a", Zone: zoneA}}},
+		"service type": {Name: "web", DomainName: "web", ServiceType: "tcp",
+			Sites: []Site{{Name: "web-a", Zone: zoneA}}},
+	}
+
+	for name, r := range cases {
+		if _, err := New(cs).Plan(r); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+
+	plan, err := New(cs).Plan(&Rule{Name: "api", DomainName: "api", ServiceType: "tcp", Sites: []Site{{Name: "api-a", Zone: zoneA}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !plan.Create || len(plan.Assign) != 1 {
+		t.Fatalf("Expected a new rule with one site, got %+v", plan)
+	}
+}
+
+func TestSiteStatus(t *testing.T) {
+	s := &SiteStatus{
+		State: "Active",
+		Members: []MemberStatus{
+			{VirtualMachineID: "vm1", State: "Running"},
+			{VirtualMachineID: "vm2", State: "Stopped"},
+			{VirtualMachineID: "vm3", State: "Running"},
+		},
+	}
+	if !s.Healthy() || s.Running() != 2 {
+		t.Fatalf("Expected a healthy site with 2 running members, got %d", s.Running())
+	}
+
+	s.Err = errors.New("failed")
+	if s.Healthy() {
+		t.Fatal("Expected a site with an error not to be healthy")
+	}
+
+	s = &SiteStatus{State: "Add", Members: []MemberStatus{{State: "Running"}}}
+	if s.Healthy() {
+		t.Fatal("Expected a site that is not active not to be healthy")
+	}
+
+	s = &SiteStatus{State: "Active", Members: []MemberStatus{{State: "Stopped"}}}
+	if s.Healthy() {
+		t.Fatal("Expected a site without running members not to be healthy")
+	}
+}
@@ -0,0 +1,139 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package gslb
+
+import (
+	"fmt"
+	"sort"
+)
+
+// MemberStatus is the state of a virtual machine behind a site
+type MemberStatus struct {
+	VirtualMachineID string
+	Name             string
+	State            string
+	IPs              []string
+}
+
+// Running returns true if the virtual machine is running
+func (s MemberStatus) Running() bool {
+	return s.State == "Running"
+}
+
+// SiteStatus is the state of a site of a global load balancer rule
+type SiteStatus struct {
+	RuleID   string
+	Name     string
+	ZoneID   string
+	ZoneName string
+	PublicIP string
+	State    string // The state of the zone-local load balancer rule
+	Members  []MemberStatus
+	Err      error // Set if the members could not be listed
+}
+
+// Healthy returns true if the load balancer rule of the site is active and at
+// least one of its members is running
+func (s *SiteStatus) Healthy() bool {
+	if s.Err != nil || s.State != "Active" {
+		return false
+	}
+	for _, mb := range s.Members {
+		if mb.Running() {
+			return true
+		}
+	}
+	return false
+}
+
+// Running returns the number of running members
+func (s *SiteStatus) Running() int {
+	n := 0
+	for _, mb := range s.Members {
+		if mb.Running() {
+			n++
+		}
+	}
+	return n
+}
+
+// Status returns the state of every site of the global load balancer rule.
+// A site whose members cannot be listed is reported with its error set, so
+// one unreachable zone does not hide the state of the others.
+func (m *Manager) Status(id string) ([]*SiteStatus, error) {
+	r, _, err := m.cs.LoadBalancer.GetGlobalLoadBalancerRuleByID(id, m.opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	sites := make([]*SiteStatus, 0, len(r.Loadbalancerrule))
+	for _, lb := range r.Loadbalancerrule {
+		s := &SiteStatus{
+			RuleID:   lb.Id,
+			Name:     lb.Name,
+			ZoneID:   lb.Zoneid,
+			ZoneName: lb.Zonename,
+			PublicIP: lb.Publicip,
+			State:    lb.State,
+		}
+
+		if s.Members, err = m.members(lb.Id); err != nil {
+			s.Err = fmt.Errorf("Error listing members of load balancer rule %s: %v", lb.Name, err)
+		}
+
+		sites = append(sites, s)
+	}
+
+	sort.Slice(sites, func(i, j int) bool {
+		return sites[i].ZoneName < sites[j].ZoneName
+	})
+
+	return sites, nil
+}
+
+func (m *Manager) members(ruleid string) ([]MemberStatus, error) {
+	p := m.cs.LoadBalancer.NewListLoadBalancerRuleInstancesParams(ruleid)
+	p.SetLbvmips(true)
+
+	l, err := m.cs.LoadBalancer.ListLoadBalancerRuleInstances(p)
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]MemberStatus, 0, len(l.LBRuleVMIDIPs))
+	for _, i := range l.LBRuleVMIDIPs {
+		if i.Loadbalancerruleinstance == nil {
+			continue
+		}
+		vm := i.Loadbalancerruleinstance
+		members = append(members, MemberStatus{
+			VirtualMachineID: vm.Id,
+			Name:             vm.Name,
+			State:            vm.State,
+			IPs:              i.Lbvmipaddresses,
+		})
+	}
+
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].Name < members[j].Name
+	})
+
+	return members, nil
+}
@@ -0,0 +1,129 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package common
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+)
+
+// protocols maps the protocol numbers CloudStack knows by name
+var protocols = map[string]string{
+	"1":  "icmp",
+	"6":  "tcp",
+	"17": "udp",
+}
+
+// NormalizeProtocol returns the protocol in the form CloudStack lists it in.
+// An empty protocol means all protocols.
+func NormalizeProtocol(protocol string) string {
+	protocol = strings.ToLower(strings.TrimSpace(protocol))
+	if name, ok := protocols[protocol]; ok {
+		return name
+	}
+	if protocol == "" {
+		return "all"
+	}
+	return protocol
+}
+
+// NormalizeCIDR returns the CIDR with the host bits cleared. Plain addresses
+// are treated as single host networks.
+func NormalizeCIDR(cidr string) (string, error) {
+	cidr = strings.TrimSpace(cidr)
+	if !strings.Contains(cidr, "/") {
+		ip := net.ParseIP(cidr)
+		if ip == nil {
+			return "", fmt.Errorf("Invalid CIDR: %s", cidr)
+		}
+		if ip.To4() != nil {
+			return ip.String() + "/32", nil
+		}
+		return ip.String() + "/128", nil
+	}
+
+	_, ipnet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return "", fmt.Errorf("Invalid CIDR: %s", cidr)
+	}
+	return ipnet.String(), nil
+}
+
+// NormalizeCIDRs returns the CIDRs normalized, sorted and without duplicates,
+// or the default if there are no CIDRs and the default is not empty
+func NormalizeCIDRs(cidrs []string, def string) ([]string, error) {
+	seen := make(map[string]bool)
+
+	var result []string
+	for _, cidr := range cidrs {
+		if strings.TrimSpace(cidr) == "" {
+			continue
+		}
+		c, err := NormalizeCIDR(cidr)
+		if err != nil {
+			return nil, err
+		}
+		if !seen[c] {
+			seen[c] = true
+			result = append(result, c)
+		}
+	}
+
+	if len(result) == 0 && def != "" {
+		result = []string{def}
+	}
+	sort.Strings(result)
+
+	return result, nil
+}
+
+// SplitCIDRs splits a comma separated CIDR list as returned by CloudStack
+func SplitCIDRs(s string) []string {
+	return strings.FieldsFunc(s, func(r rune) bool {
+		return r == ',' || r == ' '
+	})
+}
+
+// SameCIDRs returns true if both lists contain the same networks. Invalid
+// CIDRs are compared as they are, so they only equal themselves.
+func SameCIDRs(a, b []string) bool {
+	normalize := func(cidrs []string) string {
+		seen := make(map[string]bool)
+		var result []string
+		for _, cidr := range cidrs {
+			cidr = strings.TrimSpace(cidr)
+			if cidr == "" {
+				continue
+			}
+			if c, err := NormalizeCIDR(cidr); err == nil {
+				cidr = c
+			}
+			if !seen[cidr] {
+				seen[cidr] = true
+				result = append(result, cidr)
+			}
+		}
+		sort.Strings(result)
+		return strings.Join(result, ",")
+	}
+	return normalize(a) == normalize(b)
+}
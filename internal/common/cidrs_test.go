@@ -0,0 +1,107 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package common
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNormalizeProtocol(t *testing.T) {
+	cases := map[string]string{
+		"":      "all",
+		" TCP ": "tcp",
+		"6":     "tcp",
+		"17":    "udp",
+		"1":     "icmp",
+		"ALL":   "all",
+		"47":    "47",
+	}
+
+	for in, want := range cases {
+		if got := NormalizeProtocol(in); got != want {
+			t.Errorf("NormalizeProtocol(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestNormalizeCIDRs(t *testing.T) {
+	cases := []struct {
+		name  string
+		cidrs []string
+		def   string
+		want  []string
+		err   bool
+	}{
+		{"default", nil, "0.0.0.0/0", []string{"0.0.0.0/0"}, false},
+		{"no default", []string{" "}, "", nil, false},
+		{"host bits", []string{"10.1.2.3/8"}, "", []string{"10.0.0.0/8"}, false},
+		{"plain addresses", []string{"1.2.3.4", "2001:db8::1"}, "", []string{"1.2.3.4/32", "2001:db8::1/128"}, false},
+		{"sorted without duplicates", []string{"10.0.0.0/8", "1.2.3.4/32", "10.9.9.9/8"}, "", []string{"1.2.3.4/32", "10.0.0.0/8"}, false},
+		{"invalid", []string{"10.0.0.0/33"}, "", nil, true},
+		{"invalid address", []string{"not-an-ip"}, "", nil, true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := NormalizeCIDRs(c.cidrs, c.def)
+			if (err != nil) != c.err {
+				t.Fatalf("Expected error %t, got: %v", c.err, err)
+			}
+			if !reflect.DeepEqual(got, c.want) {
+				t.Fatalf("Expected %v, got %v", c.want, got)
+			}
+		})
+	}
+}
+
+func TestSplitCIDRs(t *testing.T) {
+	got := SplitCIDRs("10.0.0.0/8, 1.2.3.4/32,,192.168.0.0/16")
+	want := []string{"10.0.0.0/8", "1.2.3.4/32", "192.168.0.0/16"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Expected %v, got %v", want, got)
+	}
+
+	if got := SplitCIDRs(""); len(got) != 0 {
+		t.Fatalf("Expected no CIDRs, got %v", got)
+	}
+}
+
+func TestSameCIDRs(t *testing.T) {
+	cases := []struct {
+		a, b []string
+		want bool
+	}{
+		{nil, nil, true},
+		{[]string{""}, nil, true},
+		{[]string{"10.1.2.3/8", "1.2.3.4"}, []string{"1.2.3.4/32", "10.0.0.0/8"}, true},
+		{[]string{"10.0.0.0/8", "10.0.0.0/8"}, []string{"10.0.0.0/8"}, true},
+		{[]string{"10.0.0.0/8"}, []string{"10.0.0.0/16"}, false},
+		{[]string{"10.0.0.0/8"}, nil, false},
+		{[]string{"bogus"}, []string{"bogus"}, true},
+		{[]string{"bogus"}, []string{"other"}, false},
+	}
+
+	for _, c := range cases {
+		if got := SameCIDRs(c.a, c.b); got != c.want {
+			t.Errorf("SameCIDRs(%v, %v) = %t, want %t", c.a, c.b, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+// Package common contains helpers shared by the packages built on top of the
+// CloudStack client. It is internal, so it can change with its users.
+package common
+
+import "github.com/xanzy/go-cloudstack/v2/cloudstack"
+
+// ApplyOptions applies the option functions (e.g. cloudstack.WithProject) to
+// the params p. Options that don't apply to p are no-ops.
+func ApplyOptions(cs *cloudstack.CloudStackClient, p interface{}, opts ...cloudstack.OptionFunc) error {
+	for _, fn := range opts {
+		if err := fn(cs, p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
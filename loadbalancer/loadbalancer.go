@@ -0,0 +1,367 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+// Package loadbalancer manages a public load balancer as a single object: the
+// load balancer rule itself, its members, its health check and stickiness
+// policies and its SSL certificate. A desired load balancer is compared with
+// the live one, and only the parts that differ are changed.
+//
+// CloudStack allows only one certificate per rule, so a new certificate is
+// assigned with the forced flag, which replaces the live certificate in place
+// without interrupting HTTPS traffic. CloudStack versions without the forced
+// flag keep the old certificate, unless WithInterruptingRotation is used.
+package loadbalancer
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/xanzy/go-cloudstack/v2/cloudstack"
+	"github.com/xanzy/go-cloudstack/v2/internal/common"
+	"github.com/xanzy/go-cloudstack/v2/waiter"
+)
+
+// DefaultTimeout is the default time to wait for a single change
+const DefaultTimeout = 5 * time.Minute
+
+// Member is a virtual machine receiving traffic from the load balancer. If no
+// IP address is set, the primary IP address of the virtual machine is used. A
+// virtual machine with multiple IP addresses is added once per IP address.
+type Member struct {
+	VirtualMachineID string `json:"virtualmachineid" yaml:"virtualmachineid"`
+	IP               string `json:"ip,omitempty" yaml:"ip,omitempty"`
+}
+
+func (m Member) String() string {
+	if m.IP == "" {
+		return m.VirtualMachineID
+	}
+	return m.VirtualMachineID + "/" + m.IP
+}
+
+// HealthCheck is the health check policy of a load balancer. Fields that are
+// not set use the CloudStack defaults and are not compared with the live policy.
+type HealthCheck struct {
+	PingPath           string `json:"pingpath,omitempty" yaml:"pingpath,omitempty"`
+	Interval           int    `json:"interval,omitempty" yaml:"interval,omitempty"`                 // In seconds
+	ResponseTimeout    int    `json:"responsetimeout,omitempty" yaml:"responsetimeout,omitempty"`   // In seconds
+	HealthyThreshold   int    `json:"healthythreshold,omitempty" yaml:"healthythreshold,omitempty"` // Consecutive successes
+	UnhealthyThreshold int    `json:"unhealthythreshold,omitempty" yaml:"unhealthythreshold,omitempty"`
+	Description        string `json:"description,omitempty" yaml:"description,omitempty"`
+
+	id string
+}
+
+// Stickiness is the stickiness policy of a load balancer
+type Stickiness struct {
+	Name        string            `json:"name" yaml:"name"`
+	Method      string            `json:"method" yaml:"method"` // LbCookie, AppCookie or SourceBased
+	Params      map[string]string `json:"params,omitempty" yaml:"params,omitempty"`
+	Description string            `json:"description,omitempty" yaml:"description,omitempty"`
+
+	id string
+}
+
+// Certificate is the SSL certificate of a load balancer using the ssl protocol
+type Certificate struct {
+	Name        string `json:"name" yaml:"name"`
+	Certificate string `json:"certificate" yaml:"certificate"` // PEM encoded
+	PrivateKey  string `json:"privatekey,omitempty" yaml:"privatekey,omitempty"`
+	Chain       string `json:"chain,omitempty" yaml:"chain,omitempty"`
+	Password    string `json:"password,omitempty" yaml:"password,omitempty"`
+
+	id string
+}
+
+// LoadBalancer is a load balancer rule with everything attached to it. When
+// reading a load balancer, the private key of the certificate is never set.
+type LoadBalancer struct {
+	ID           string       `json:"id,omitempty" yaml:"id,omitempty"`
+	Name         string       `json:"name" yaml:"name"`
+	Description  string       `json:"description,omitempty" yaml:"description,omitempty"`
+	Algorithm    string       `json:"algorithm" yaml:"algorithm"` // roundrobin, leastconn or source
+	Protocol     string       `json:"protocol,omitempty" yaml:"protocol,omitempty"`
+	PublicIPID   string       `json:"publicipid" yaml:"publicipid"`
+	NetworkID    string       `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+	PublicPort   int          `json:"publicport" yaml:"publicport"`
+	PrivatePort  int          `json:"privateport" yaml:"privateport"`
+	CIDRs        []string     `json:"cidrs,omitempty" yaml:"cidrs,omitempty"`
+	OpenFirewall bool         `json:"openfirewall,omitempty" yaml:"openfirewall,omitempty"` // Only used when creating
+	Members      []Member     `json:"members,omitempty" yaml:"members,omitempty"`
+	HealthCheck  *HealthCheck `json:"healthcheck,omitempty" yaml:"healthcheck,omitempty"`
+	Stickiness   *Stickiness  `json:"stickiness,omitempty" yaml:"stickiness,omitempty"`
+	Certificate  *Certificate `json:"certificate,omitempty" yaml:"certificate,omitempty"`
+	State        string       `json:"state,omitempty" yaml:"state,omitempty"` // Only set when reading
+}
+
+// Option can be passed to New to set custom options
+type Option func(*Manager)
+
+// WithDryRun only plans the changes without applying them
+func WithDryRun(dryRun bool) Option {
+	return func(m *Manager) {
+		m.dryRun = dryRun
+	}
+}
+
+// WithDeleteReplacedCertificates deletes a certificate after it was replaced
+// by a new one, if no other load balancer uses it
+func WithDeleteReplacedCertificates(del bool) Option {
+	return func(m *Manager) {
+		m.deleteCerts = del
+	}
+}
+
+// WithInterruptingRotation replaces a certificate that cannot be replaced in
+// place, e.g. because CloudStack does not support the forced flag, by removing
+// the old certificate before assigning the new one. This interrupts HTTPS
+// traffic until the new certificate is assigned.
+func WithInterruptingRotation(interrupt bool) Option {
+	return func(m *Manager) {
+		m.interruptRotation = interrupt
+	}
+}
+
+// WithTimeout sets the time to wait for a single change
+func WithTimeout(timeout time.Duration) Option {
+	return func(m *Manager) {
+		if timeout != 0 {
+			m.timeout = timeout
+		}
+	}
+}
+
+// WithOptions sets option functions (e.g. cloudstack.WithProject) that are
+// applied when looking up, listing and creating load balancer resources
+func WithOptions(opts ...cloudstack.OptionFunc) Option {
+	return func(m *Manager) {
+		m.opts = append(m.opts, opts...)
+	}
+}
+
+// Manager creates, reads and updates load balancers
+type Manager struct {
+	cs                *cloudstack.CloudStackClient
+	dryRun            bool
+	deleteCerts       bool
+	interruptRotation bool
+	timeout           time.Duration
+	opts              []cloudstack.OptionFunc
+	waiter            *waiter.Waiter
+}
+
+// New returns a new manager using the given client
+func New(cs *cloudstack.CloudStackClient, options ...Option) *Manager {
+	m := &Manager{
+		cs:      cs,
+		timeout: DefaultTimeout,
+	}
+
+	for _, fn := range options {
+		fn(m)
+	}
+	m.waiter = waiter.New(cs, waiter.WithTimeout(m.timeout))
+
+	return m
+}
+
+// Lookup returns the load balancer with the given name on the public IP
+// address, or nil if there is no such load balancer
+func (m *Manager) Lookup(name, publicipid string) (*LoadBalancer, error) {
+	p := m.cs.LoadBalancer.NewListLoadBalancerRulesParams()
+	p.SetName(name)
+	p.SetPublicipid(publicipid)
+	p.SetListall(true)
+	if err := common.ApplyOptions(m.cs, p, m.opts...); err != nil {
+		return nil, err
+	}
+
+	l, err := m.cs.LoadBalancer.ListLoadBalancerRules(p)
+	if err != nil {
+		return nil, err
+	}
+
+	// The name filter also matches partial names
+	for _, r := range l.LoadBalancerRules {
+		if r.Name == name {
+			return m.read(r)
+		}
+	}
+
+	return nil, nil
+}
+
+// Read returns the load balancer with the given rule ID
+func (m *Manager) Read(id string) (*LoadBalancer, error) {
+	r, _, err := m.cs.LoadBalancer.GetLoadBalancerRuleByID(id, m.opts...)
+	if err != nil {
+		return nil, err
+	}
+	return m.read(r)
+}
+
+func (m *Manager) read(r *cloudstack.LoadBalancerRule) (*LoadBalancer, error) {
+	lb := &LoadBalancer{
+		ID:          r.Id,
+		Name:        r.Name,
+		Description: r.Description,
+		Algorithm:   r.Algorithm,
+		Protocol:    r.Protocol,
+		PublicIPID:  r.Publicipid,
+		NetworkID:   r.Networkid,
+		CIDRs:       common.SplitCIDRs(r.Cidrlist),
+		State:       r.State,
+	}
+	lb.PublicPort, _ = strconv.Atoi(r.Publicport)
+	lb.PrivatePort, _ = strconv.Atoi(r.Privateport)
+
+	var err error
+	if lb.Members, err = m.members(r.Id); err != nil {
+		return nil, fmt.Errorf("Error listing members of load balancer %s: %v", r.Name, err)
+	}
+	if lb.HealthCheck, err = m.healthCheck(r.Id); err != nil {
+		return nil, fmt.Errorf("Error listing health check policies of load balancer %s: %v", r.Name, err)
+	}
+	if lb.Stickiness, err = m.stickiness(r.Id); err != nil {
+		return nil, fmt.Errorf("Error listing stickiness policies of load balancer %s: %v", r.Name, err)
+	}
+	if lb.Certificate, err = m.certificate(r.Id); err != nil {
+		return nil, fmt.Errorf("Error listing certificates of load balancer %s: %v", r.Name, err)
+	}
+
+	return lb, nil
+}
+
+// members lists the virtual machines and IP addresses assigned to the rule
+func (m *Manager) members(id string) ([]Member, error) {
+	p := m.cs.LoadBalancer.NewListLoadBalancerRuleInstancesParams(id)
+	p.SetLbvmips(true)
+
+	l, err := m.cs.LoadBalancer.ListLoadBalancerRuleInstances(p)
+	if err != nil {
+		return nil, err
+	}
+
+	var members []Member
+	for _, i := range l.LBRuleVMIDIPs {
+		if i.Loadbalancerruleinstance == nil {
+			continue
+		}
+		for _, ip := range i.Lbvmipaddresses {
+			members = append(members, Member{VirtualMachineID: i.Loadbalancerruleinstance.Id, IP: ip})
+		}
+	}
+	sortMembers(members)
+
+	return members, nil
+}
+
+func (m *Manager) healthCheck(id string) (*HealthCheck, error) {
+	p := m.cs.LoadBalancer.NewListLBHealthCheckPoliciesParams()
+	p.SetLbruleid(id)
+
+	l, err := m.cs.LoadBalancer.ListLBHealthCheckPolicies(p)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, policies := range l.LBHealthCheckPolicies {
+		for _, hc := range policies.Healthcheckpolicy {
+			if hc.State == "Revoke" {
+				continue
+			}
+			return &HealthCheck{
+				PingPath:           hc.Pingpath,
+				Interval:           hc.Healthcheckinterval,
+				ResponseTimeout:    hc.Responsetime,
+				HealthyThreshold:   hc.Healthcheckthresshold,
+				UnhealthyThreshold: hc.Unhealthcheckthresshold,
+				Description:        hc.Description,
+				id:                 hc.Id,
+			}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (m *Manager) stickiness(id string) (*Stickiness, error) {
+	p := m.cs.LoadBalancer.NewListLBStickinessPoliciesParams()
+	p.SetLbruleid(id)
+
+	l, err := m.cs.LoadBalancer.ListLBStickinessPolicies(p)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, policies := range l.LBStickinessPolicies {
+		for _, sp := range policies.Stickinesspolicy {
+			if sp.State == "Revoke" {
+				continue
+			}
+			return &Stickiness{
+				Name:        sp.Name,
+				Method:      sp.Methodname,
+				Params:      sp.Params,
+				Description: sp.Description,
+				id:          sp.Id,
+			}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (m *Manager) certificate(id string) (*Certificate, error) {
+	p := m.cs.LoadBalancer.NewListSslCertsParams()
+	p.SetLbruleid(id)
+
+	l, err := m.cs.LoadBalancer.ListSslCerts(p)
+	if err != nil {
+		return nil, err
+	}
+	if len(l.SslCerts) == 0 {
+		return nil, nil
+	}
+
+	c := l.SslCerts[0]
+	return &Certificate{
+		Name:        c.Name,
+		Certificate: c.Certificate,
+		Chain:       c.Certchain,
+		id:          c.Id,
+	}, nil
+}
+
+// CertificateID returns the ID of the certificate, which is only known for
+// certificates that were read or uploaded
+func (c *Certificate) CertificateID() string {
+	return c.id
+}
+
+func sortMembers(members []Member) {
+	sort.Slice(members, func(i, j int) bool {
+		if members[i].VirtualMachineID != members[j].VirtualMachineID {
+			return members[i].VirtualMachineID < members[j].VirtualMachineID
+		}
+		return members[i].IP < members[j].IP
+	})
+}
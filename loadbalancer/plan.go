@@ -0,0 +1,647 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package loadbalancer
+
+import (
+	"bytes"
+	"context"
+	"encoding/pem"
+	"fmt"
+	"strings"
+
+	"github.com/xanzy/go-cloudstack/v2/cloudstack"
+	"github.com/xanzy/go-cloudstack/v2/internal/common"
+)
+
+// Action is the change made to a policy or certificate
+type Action string
+
+const (
+	// None leaves the policy or certificate as it is
+	None Action = ""
+
+	// Create creates the policy or assigns the certificate
+	Create Action = "create"
+
+	// Replace replaces the live policy or certificate
+	Replace Action = "replace"
+
+	// Delete deletes the policy or removes the certificate
+	Delete Action = "delete"
+)
+
+// Plan is the set of changes needed to reconcile a load balancer
+type Plan struct {
+	Desired       *LoadBalancer
+	Current       *LoadBalancer // Nil if the load balancer does not exist yet
+	Create        bool
+	Update        []string // The rule attributes that will be updated
+	AddMembers    []Member
+	RemoveMembers []Member
+	HealthCheck   Action
+	Stickiness    Action
+	Certificate   Action
+}
+
+// Empty returns true if the load balancer is already in the desired state
+func (p *Plan) Empty() bool {
+	return !p.Create && len(p.Update) == 0 && len(p.AddMembers) == 0 && len(p.RemoveMembers) == 0 &&
+		p.HealthCheck == None && p.Stickiness == None && p.Certificate == None
+}
+
+// Plan compares the desired load balancer with the live one. The live load
+// balancer is found by the ID of the desired load balancer, or by its name and
+// public IP address. The public IP address, ports and CIDRs of an existing rule
+// cannot be changed without recreating it, so an error is returned if they
+// differ.
+func (m *Manager) Plan(desired *LoadBalancer) (*Plan, error) {
+	if desired.Protocol == "ssl" && desired.Certificate == nil {
+		return nil, fmt.Errorf("Load balancer %s uses the ssl protocol but has no certificate", desired.Name)
+	}
+
+	var current *LoadBalancer
+	var err error
+	if desired.ID != "" {
+		current, err = m.Read(desired.ID)
+	} else {
+		current, err = m.Lookup(desired.Name, desired.PublicIPID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &Plan{Desired: desired, Current: current}
+
+	if current == nil {
+		plan.Create = true
+		plan.AddMembers = desired.Members
+		if desired.HealthCheck != nil {
+			plan.HealthCheck = Create
+		}
+		if desired.Stickiness != nil {
+			plan.Stickiness = Create
+		}
+		if desired.Certificate != nil {
+			plan.Certificate = Create
+		}
+		return plan, nil
+	}
+
+	if err := immutable(desired, current); err != nil {
+		return nil, err
+	}
+
+	if desired.Name != current.Name {
+		plan.Update = append(plan.Update, "name")
+	}
+	if desired.Description != current.Description {
+		plan.Update = append(plan.Update, "description")
+	}
+	if !strings.EqualFold(desired.Algorithm, current.Algorithm) {
+		plan.Update = append(plan.Update, "algorithm")
+	}
+	if desired.Protocol != "" && !strings.EqualFold(desired.Protocol, current.Protocol) {
+		plan.Update = append(plan.Update, "protocol")
+	}
+
+	plan.AddMembers, plan.RemoveMembers = diffMembers(desired.Members, current.Members)
+	plan.HealthCheck = compare(desired.HealthCheck != nil, current.HealthCheck != nil,
+		desired.HealthCheck != nil && current.HealthCheck != nil && desired.HealthCheck.matches(current.HealthCheck))
+	plan.Stickiness = compare(desired.Stickiness != nil, current.Stickiness != nil,
+		desired.Stickiness != nil && current.Stickiness != nil && desired.Stickiness.matches(current.Stickiness))
+	plan.Certificate = compare(desired.Certificate != nil, current.Certificate != nil,
+		desired.Certificate != nil && current.Certificate != nil && desired.Certificate.matches(current.Certificate))
+
+	return plan, nil
+}
+
+// immutable returns an error if attributes differ that cannot be updated
+func immutable(desired, current *LoadBalancer) error {
+	var changed []string
+	if desired.PublicIPID != "" && desired.PublicIPID != current.PublicIPID {
+		changed = append(changed, "public IP address")
+	}
+	if desired.NetworkID != "" && desired.NetworkID != current.NetworkID {
+		changed = append(changed, "network")
+	}
+	if desired.PublicPort != current.PublicPort {
+		changed = append(changed, "public port")
+	}
+	if desired.PrivatePort != current.PrivatePort {
+		changed = append(changed, "private port")
+	}
+	if len(desired.CIDRs) > 0 && !common.SameCIDRs(desired.CIDRs, current.CIDRs) {
+		changed = append(changed, "CIDRs")
+	}
+
+	if len(changed) > 0 {
+		return fmt.Errorf("Cannot change the %s of load balancer %s without recreating it", strings.Join(changed, ", "), current.Name)
+	}
+	return nil
+}
+
+func compare(desired, current, equal bool) Action {
+	switch {
+	case desired && !current:
+		return Create
+	case !desired && current:
+		return Delete
+	case desired && !equal:
+		return Replace
+	default:
+		return None
+	}
+}
+
+// matches compares the fields of the desired policy that are set
+func (hc *HealthCheck) matches(live *HealthCheck) bool {
+	return (hc.PingPath == "" || hc.PingPath == live.PingPath) &&
+		(hc.Interval == 0 || hc.Interval == live.Interval) &&
+		(hc.ResponseTimeout == 0 || hc.ResponseTimeout == live.ResponseTimeout) &&
+		(hc.HealthyThreshold == 0 || hc.HealthyThreshold == live.HealthyThreshold) &&
+		(hc.UnhealthyThreshold == 0 || hc.UnhealthyThreshold == live.UnhealthyThreshold)
+}
+
+// matches compares the method, name and desired params of the policy
+func (s *Stickiness) matches(live *Stickiness) bool {
+	if !strings.EqualFold(s.Method, live.Method) || s.Name != live.Name {
+		return false
+	}
+	for k, v := range s.Params {
+		if live.Params[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// matches compares the certificates themselves, ignoring PEM formatting
+func (c *Certificate) matches(live *Certificate) bool {
+	a, _ := pem.Decode([]byte(c.Certificate))
+	b, _ := pem.Decode([]byte(live.Certificate))
+	if a == nil || b == nil {
+		return strings.TrimSpace(c.Certificate) == strings.TrimSpace(live.Certificate)
+	}
+	return bytes.Equal(a.Bytes, b.Bytes)
+}
+
+// diffMembers returns the members to add and remove. A desired member without
+// an IP address matches any IP address of the virtual machine.
+func diffMembers(desired, live []Member) ([]Member, []Member) {
+	matched := make([]bool, len(live))
+	pending := make([]Member, 0, len(desired))
+
+	for _, d := range desired {
+		found := false
+		for n, l := range live {
+			if !matched[n] && d.IP != "" && d == l {
+				matched[n], found = true, true
+				break
+			}
+		}
+		if !found {
+			pending = append(pending, d)
+		}
+	}
+
+	var add []Member
+	for _, d := range pending {
+		found := false
+		for n, l := range live {
+			if !matched[n] && d.IP == "" && d.VirtualMachineID == l.VirtualMachineID {
+				matched[n], found = true, true
+				break
+			}
+		}
+		if !found {
+			add = append(add, d)
+		}
+	}
+
+	var remove []Member
+	for n, l := range live {
+		if !matched[n] {
+			remove = append(remove, l)
+		}
+	}
+
+	sortMembers(add)
+	sortMembers(remove)
+
+	return add, remove
+}
+
+// Apply applies the plan, unless the manager is in dry-run mode, and returns
+// the resulting load balancer. To keep the load balancer serving traffic, new
+// members are added before old members are removed, and a new certificate is
+// uploaded (and so validated by CloudStack) before it replaces the old one in
+// place. See WithInterruptingRotation for CloudStack versions that cannot
+// replace a certificate in place.
+func (m *Manager) Apply(ctx context.Context, plan *Plan) (*LoadBalancer, error) {
+	if m.dryRun {
+		return plan.Current, nil
+	}
+	if plan.Empty() {
+		return plan.Current, nil
+	}
+
+	d := plan.Desired
+
+	id := d.ID
+	if plan.Current != nil {
+		id = plan.Current.ID
+	}
+
+	if plan.Create {
+		var err error
+		if id, err = m.createRule(ctx, d); err != nil {
+			return nil, fmt.Errorf("Error creating load balancer %s: %v", d.Name, err)
+		}
+	}
+
+	if len(plan.Update) > 0 {
+		if err := m.updateRule(ctx, id, d); err != nil {
+			return nil, fmt.Errorf("Error updating load balancer %s: %v", d.Name, err)
+		}
+	}
+
+	switch plan.Certificate {
+	case Create, Replace:
+		if err := m.rotateCertificate(ctx, id, d.Certificate, plan.Current); err != nil {
+			return nil, fmt.Errorf("Error assigning certificate %s to load balancer %s: %v", d.Certificate.Name, d.Name, err)
+		}
+	}
+
+	if err := m.assignMembers(ctx, id, plan.AddMembers, true); err != nil {
+		return nil, fmt.Errorf("Error adding members to load balancer %s: %v", d.Name, err)
+	}
+
+	if err := m.applyHealthCheck(ctx, id, plan); err != nil {
+		return nil, fmt.Errorf("Error updating health check of load balancer %s: %v", d.Name, err)
+	}
+
+	if err := m.applyStickiness(ctx, id, plan); err != nil {
+		return nil, fmt.Errorf("Error updating stickiness of load balancer %s: %v", d.Name, err)
+	}
+
+	if err := m.assignMembers(ctx, id, plan.RemoveMembers, false); err != nil {
+		return nil, fmt.Errorf("Error removing members from load balancer %s: %v", d.Name, err)
+	}
+
+	if plan.Certificate == Delete {
+		resp, err := m.cs.LoadBalancer.RemoveCertFromLoadBalancer(m.cs.LoadBalancer.NewRemoveCertFromLoadBalancerParams(id))
+		if err == nil {
+			err = m.waiter.WaitForJob(ctx, resp.JobID)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("Error removing certificate from load balancer %s: %v", d.Name, err)
+		}
+		m.deleteCertificate(plan.Current.Certificate)
+	}
+
+	return m.Read(id)
+}
+
+// Ensure plans and applies the desired load balancer in one go
+func (m *Manager) Ensure(ctx context.Context, desired *LoadBalancer) (*LoadBalancer, *Plan, error) {
+	plan, err := m.Plan(desired)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	lb, err := m.Apply(ctx, plan)
+	return lb, plan, err
+}
+
+// Delete deletes the load balancer rule, which also deletes its policies and
+// removes its members and certificate
+func (m *Manager) Delete(ctx context.Context, id string) error {
+	resp, err := m.cs.LoadBalancer.DeleteLoadBalancerRule(m.cs.LoadBalancer.NewDeleteLoadBalancerRuleParams(id))
+	if err != nil {
+		return err
+	}
+	return m.waiter.WaitForJob(ctx, resp.JobID)
+}
+
+func (m *Manager) createRule(ctx context.Context, d *LoadBalancer) (string, error) {
+	p := m.cs.LoadBalancer.NewCreateLoadBalancerRuleParams(d.Algorithm, d.Name, d.PrivatePort, d.PublicPort)
+	p.SetPublicipid(d.PublicIPID)
+	p.SetOpenfirewall(d.OpenFirewall)
+	if d.Description != "" {
+		p.SetDescription(d.Description)
+	}
+	if d.Protocol != "" {
+		p.SetProtocol(d.Protocol)
+	}
+	if d.NetworkID != "" {
+		p.SetNetworkid(d.NetworkID)
+	}
+	if len(d.CIDRs) > 0 {
+		p.SetCidrlist(d.CIDRs)
+	}
+	if err := common.ApplyOptions(m.cs, p, m.opts...); err != nil {
+		return "", err
+	}
+
+	resp, err := m.cs.LoadBalancer.CreateLoadBalancerRule(p)
+	if err != nil {
+		return "", err
+	}
+
+	if err := m.waiter.WaitForJobInto(ctx, resp.JobID, resp); err != nil {
+		return "", err
+	}
+
+	return resp.Id, nil
+}
+
+func (m *Manager) updateRule(ctx context.Context, id string, d *LoadBalancer) error {
+	p := m.cs.LoadBalancer.NewUpdateLoadBalancerRuleParams(id)
+	p.SetName(d.Name)
+	p.SetDescription(d.Description)
+	p.SetAlgorithm(d.Algorithm)
+	if d.Protocol != "" {
+		p.SetProtocol(d.Protocol)
+	}
+
+	resp, err := m.cs.LoadBalancer.UpdateLoadBalancerRule(p)
+	if err != nil {
+		return err
+	}
+	return m.waiter.WaitForJob(ctx, resp.JobID)
+}
+
+// assignMembers assigns or removes the members. The VM ID to IP map can hold a
+// single IP address per virtual machine, so one call is made per round of IP
+// addresses; members without an IP address are passed as plain VM IDs.
+func (m *Manager) assignMembers(ctx context.Context, id string, members []Member, assign bool) error {
+	var vmids []string
+	var rounds []map[string]string
+
+	for _, mb := range members {
+		if mb.IP == "" {
+			vmids = append(vmids, mb.VirtualMachineID)
+			continue
+		}
+
+		placed := false
+		for _, r := range rounds {
+			if _, ok := r[mb.VirtualMachineID]; !ok {
+				r[mb.VirtualMachineID] = mb.IP
+				placed = true
+				break
+			}
+		}
+		if !placed {
+			rounds = append(rounds, map[string]string{mb.VirtualMachineID: mb.IP})
+		}
+	}
+
+	if len(vmids) > 0 {
+		if err := m.assign(ctx, id, vmids, nil, assign); err != nil {
+			return err
+		}
+	}
+	for _, r := range rounds {
+		if err := m.assign(ctx, id, nil, r, assign); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Manager) assign(ctx context.Context, id string, vmids []string, vmidipmap map[string]string, assign bool) error {
+	var jobid string
+
+	if assign {
+		p := m.cs.LoadBalancer.NewAssignToLoadBalancerRuleParams(id)
+		if len(vmids) > 0 {
+			p.SetVirtualmachineids(vmids)
+		}
+		if len(vmidipmap) > 0 {
+			p.SetVmidipmap(vmidipmap)
+		}
+
+		resp, err := m.cs.LoadBalancer.AssignToLoadBalancerRule(p)
+		if err != nil {
+			return err
+		}
+		jobid = resp.JobID
+	} else {
+		p := m.cs.LoadBalancer.NewRemoveFromLoadBalancerRuleParams(id)
+		if len(vmids) > 0 {
+			p.SetVirtualmachineids(vmids)
+		}
+		if len(vmidipmap) > 0 {
+			p.SetVmidipmap(vmidipmap)
+		}
+
+		resp, err := m.cs.LoadBalancer.RemoveFromLoadBalancerRule(p)
+		if err != nil {
+			return err
+		}
+		jobid = resp.JobID
+	}
+
+	return m.waiter.WaitForJob(ctx, jobid)
+}
+
+// applyHealthCheck creates or replaces the health check policy. A rule can only
+// have one health check policy, so the old policy is deleted first.
+func (m *Manager) applyHealthCheck(ctx context.Context, id string, plan *Plan) error {
+	if plan.HealthCheck == Replace || plan.HealthCheck == Delete {
+		resp, err := m.cs.LoadBalancer.DeleteLBHealthCheckPolicy(
+			m.cs.LoadBalancer.NewDeleteLBHealthCheckPolicyParams(plan.Current.HealthCheck.id))
+		if err != nil {
+			return err
+		}
+		if err := m.waiter.WaitForJob(ctx, resp.JobID); err != nil {
+			return err
+		}
+	}
+
+	if plan.HealthCheck != Create && plan.HealthCheck != Replace {
+		return nil
+	}
+
+	hc := plan.Desired.HealthCheck
+	p := m.cs.LoadBalancer.NewCreateLBHealthCheckPolicyParams(id)
+	if hc.PingPath != "" {
+		p.SetPingpath(hc.PingPath)
+	}
+	if hc.Interval > 0 {
+		p.SetIntervaltime(hc.Interval)
+	}
+	if hc.ResponseTimeout > 0 {
+		p.SetResponsetimeout(hc.ResponseTimeout)
+	}
+	if hc.HealthyThreshold > 0 {
+		p.SetHealthythreshold(hc.HealthyThreshold)
+	}
+	if hc.UnhealthyThreshold > 0 {
+		p.SetUnhealthythreshold(hc.UnhealthyThreshold)
+	}
+	if hc.Description != "" {
+		p.SetDescription(hc.Description)
+	}
+
+	resp, err := m.cs.LoadBalancer.CreateLBHealthCheckPolicy(p)
+	if err != nil {
+		return err
+	}
+	return m.waiter.WaitForJob(ctx, resp.JobID)
+}
+
+// applyStickiness creates or replaces the stickiness policy. A rule can only
+// have one stickiness policy, so the old policy is deleted first.
+func (m *Manager) applyStickiness(ctx context.Context, id string, plan *Plan) error {
+	if plan.Stickiness == Replace || plan.Stickiness == Delete {
+		resp, err := m.cs.LoadBalancer.DeleteLBStickinessPolicy(
+			m.cs.LoadBalancer.NewDeleteLBStickinessPolicyParams(plan.Current.Stickiness.id))
+		if err != nil {
+			return err
+		}
+		if err := m.waiter.WaitForJob(ctx, resp.JobID); err != nil {
+			return err
+		}
+	}
+
+	if plan.Stickiness != Create && plan.Stickiness != Replace {
+		return nil
+	}
+
+	s := plan.Desired.Stickiness
+	p := m.cs.LoadBalancer.NewCreateLBStickinessPolicyParams(id, s.Method, s.Name)
+	if len(s.Params) > 0 {
+		p.SetParam(s.Params)
+	}
+	if s.Description != "" {
+		p.SetDescription(s.Description)
+	}
+
+	resp, err := m.cs.LoadBalancer.CreateLBStickinessPolicy(p)
+	if err != nil {
+		return err
+	}
+	return m.waiter.WaitForJob(ctx, resp.JobID)
+}
+
+// rotateCertificate uploads the new certificate and swaps it in. A live
+// certificate is replaced in place with a forced assignment, so the rule keeps
+// serving HTTPS traffic. If that fails, the old certificate stays assigned,
+// unless interrupting rotations are allowed: then the old certificate is
+// removed before the new one is assigned, and assigned again if the new one
+// cannot be.
+func (m *Manager) rotateCertificate(ctx context.Context, id string, c *Certificate, current *LoadBalancer) error {
+	certid := c.id
+	if certid == "" {
+		up := m.cs.LoadBalancer.NewUploadSslCertParams(c.Certificate, c.Name, c.PrivateKey)
+		if c.Chain != "" {
+			up.SetCertchain(c.Chain)
+		}
+		if c.Password != "" {
+			up.SetPassword(c.Password)
+		}
+		if err := common.ApplyOptions(m.cs, up, m.opts...); err != nil {
+			return err
+		}
+
+		resp, err := m.cs.LoadBalancer.UploadSslCert(up)
+		if err != nil {
+			return fmt.Errorf("Error uploading certificate: %v", err)
+		}
+		certid = resp.Id
+		c.id = certid
+	}
+
+	if current == nil || current.Certificate == nil {
+		return m.assignCertificate(ctx, id, certid)
+	}
+	old := current.Certificate
+
+	err := m.replaceCertificate(ctx, id, certid)
+	if err != nil && !m.interruptRotation {
+		return fmt.Errorf("Error replacing the old certificate, which is still assigned: %v", err)
+	}
+
+	if err != nil {
+		resp, err := m.cs.LoadBalancer.RemoveCertFromLoadBalancer(m.cs.LoadBalancer.NewRemoveCertFromLoadBalancerParams(id))
+		if err == nil {
+			err = m.waiter.WaitForJob(ctx, resp.JobID)
+		}
+		if err != nil {
+			return fmt.Errorf("Error removing the old certificate: %v", err)
+		}
+
+		if err := m.assignCertificate(ctx, id, certid); err != nil {
+			if rerr := m.assignCertificate(ctx, id, old.id); rerr != nil {
+				return fmt.Errorf("%v, and restoring the old certificate failed, so the load balancer has no certificate: %v", err, rerr)
+			}
+			return err
+		}
+	}
+
+	m.deleteCertificate(old)
+
+	return nil
+}
+
+func (m *Manager) assignCertificate(ctx context.Context, id, certid string) error {
+	resp, err := m.cs.LoadBalancer.AssignCertToLoadBalancer(m.cs.LoadBalancer.NewAssignCertToLoadBalancerParams(certid, id))
+	if err != nil {
+		return err
+	}
+	return m.waiter.WaitForJob(ctx, resp.JobID)
+}
+
+// replaceCertificate assigns the certificate in place of the live one. This is
+// called as a custom request, as the forced flag is newer than the generated
+// AssignCertToLoadBalancerParams.
+func (m *Manager) replaceCertificate(ctx context.Context, id, certid string) error {
+	p := &cloudstack.CustomServiceParams{}
+	p.SetParam("certid", certid)
+	p.SetParam("lbruleid", id)
+	p.SetParam("forced", true)
+
+	var r cloudstack.AssignCertToLoadBalancerResponse
+	if err := m.cs.Custom.CustomRequest("assignCertToLoadBalancer", p, &r); err != nil {
+		return err
+	}
+	return m.waiter.WaitForJob(ctx, r.JobID)
+}
+
+// deleteCertificate deletes a replaced certificate if configured, and if no
+// other load balancer uses it. Failing to delete it is not an error.
+func (m *Manager) deleteCertificate(c *Certificate) {
+	if !m.deleteCerts || c == nil || c.id == "" {
+		return
+	}
+
+	p := m.cs.LoadBalancer.NewListSslCertsParams()
+	p.SetCertid(c.id)
+
+	l, err := m.cs.LoadBalancer.ListSslCerts(p)
+	if err != nil || l.Count == 0 || len(l.SslCerts[0].Loadbalancerrulelist) > 0 {
+		return
+	}
+
+	m.cs.LoadBalancer.DeleteSslCert(m.cs.LoadBalancer.NewDeleteSslCertParams(c.id))
+}
@@ -0,0 +1,245 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package loadbalancer
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/xanzy/go-cloudstack/v2/cloudstack"
+)
+
+func TestDiffMembers(t *testing.T) {
+	desired := []Member{
+		{VirtualMachineID: "vm1"},
+		{VirtualMachineID: "vm2", IP: "10.0.0.2"},
+		{VirtualMachineID: "vm2", IP: "10.0.0.22"},
+		{VirtualMachineID: "vm3"},
+		{VirtualMachineID: "vm4", IP: "10.0.0.4"},
+	}
+	live := []Member{
+		{VirtualMachineID: "vm1", IP: "10.0.0.1"},
+		{VirtualMachineID: "vm1", IP: "10.0.0.11"},
+		{VirtualMachineID: "vm2", IP: "10.0.0.2"},
+		{VirtualMachineID: "vm4", IP: "10.0.0.44"},
+		{VirtualMachineID: "vm5", IP: "10.0.0.5"},
+	}
+
+	add, remove := diffMembers(desired, live)
+
+	// A member without an IP address matches a single IP address of its VM,
+	// and members with an IP address are matched before those without
+	wantAdd := []Member{{VirtualMachineID: "vm2", IP: "10.0.0.22"}, {VirtualMachineID: "vm3"}, {VirtualMachineID: "vm4", IP: "10.0.0.4"}}
+	wantRemove := []Member{{VirtualMachineID: "vm1", IP: "10.0.0.11"}, {VirtualMachineID: "vm4", IP: "10.0.0.44"}, {VirtualMachineID: "vm5", IP: "10.0.0.5"}}
+
+	if !reflect.DeepEqual(add, wantAdd) {
+		t.Fatalf("Expected to add %v, got %v", wantAdd, add)
+	}
+	if !reflect.DeepEqual(remove, wantRemove) {
+		t.Fatalf("Expected to remove %v, got %v", wantRemove, remove)
+	}
+}
+
+func TestImmutable(t *testing.T) {
+	current := &LoadBalancer{Name: "web", PublicIPID: "ip1", NetworkID: "n1", PublicPort: 80, PrivatePort: 8080, CIDRs: []string{"10.0.0.0/8"}}
+
+	ok := []*LoadBalancer{
+		{PublicPort: 80, PrivatePort: 8080},
+		{PublicIPID: "ip1", NetworkID: "n1", PublicPort: 80, PrivatePort: 8080, CIDRs: []string{"10.1.2.3/8"}},
+	}
+	for i, d := range ok {
+		if err := immutable(d, current); err != nil {
+			t.Errorf("%d: %v", i, err)
+		}
+	}
+
+	d := &LoadBalancer{PublicIPID: "ip2", PublicPort: 443, PrivatePort: 8080, CIDRs: []string{"0.0.0.0/0"}}
+	err := immutable(d, current)
+	if err == nil || !strings.Contains(err.Error(), "public IP address, public port, CIDRs") {
+		t.Fatalf("Expected an error listing the changed attributes, got: %v", err)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	cases := []struct {
+		desired, current, equal bool
+		want                    Action
+	}{
+		{false, false, false, None},
+		{true, false, false, Create},
+		{false, true, false, Delete},
+		{true, true, false, Replace},
+		{true, true, true, None},
+	}
+
+	for _, c := range cases {
+		if got := compare(c.desired, c.current, c.equal); got != c.want {
+			t.Errorf("compare(%v, %v, %v) = %q, want %q", c.desired, c.current, c.equal, got, c.want)
+		}
+	}
+}
+
+func TestPolicyMatches(t *testing.T) {
+	live := &HealthCheck{PingPath: "/health", Interval: 5, ResponseTimeout: 2, HealthyThreshold: 2, UnhealthyThreshold: 10}
+	if !(&HealthCheck{PingPath: "/health"}).matches(live) {
+		t.Fatal("Expected fields that are not set to be ignored")
+	}
+	if (&HealthCheck{PingPath: "/health", Interval: 10}).matches(live) {
+		t.Fatal("Expected a different interval not to match")
+	}
+
+	sticky := &Stickiness{Name: "s", Method: "LbCookie", Params: map[string]string{"cookie-name": "a", "mode": "insert"}}
+	if !(&Stickiness{Name: "s", Method: "lbcookie", Params: map[string]string{"cookie-name": "a"}}).matches(sticky) {
+		t.Fatal("Expected the method to be compared case insensitively and extra live params to be ignored")
+	}
+	if (&Stickiness{Name: "s", Method: "LbCookie", Params: map[string]string{"cookie-name": "b"}}).matches(sticky) {
+		t.Fatal("Expected a different param not to match")
+	}
+
+	pem := "-----BEGIN CERTIFICATE-----\nAAECAwQFBgcICQ==\n-----END CERTIFICATE-----\n"
+	reformatted := "\n-----BEGIN CERTIFICATE-----\r\nAAEC\r\nAwQFBgcICQ==\r\n-----END CERTIFICATE-----"
+	if !(&Certificate{Certificate: pem}).matches(&Certificate{Certificate: reformatted}) {
+		t.Fatal("Expected certificates to be compared ignoring PEM formatting")
+	}
+}
+
+// newJobServer returns a client for a server that records the API calls and
+// starts a job for each of them. The jobs of the rejected calls fail.
+func newJobServer(rejected ...string) (*cloudstack.CloudStackClient, *httptest.Server, func() []string) {
+	var mu sync.Mutex
+	var calls []string
+	failed := make(map[string]bool)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		command := q.Get("command")
+
+		if command == "queryAsyncJobResult" {
+			mu.Lock()
+			status := 1
+			if failed[q.Get("jobid")] {
+				status = 2
+			}
+			mu.Unlock()
+			fmt.Fprintf(w, `{"queryasyncjobresultresponse":{"jobstatus":%d,"jobresult":{}}}`, status)
+			return
+		}
+
+		call := strings.TrimSpace(command + " " + q.Get("certid"))
+		if q.Get("forced") == "true" {
+			call += " forced"
+		}
+
+		mu.Lock()
+		calls = append(calls, call)
+		jobid := fmt.Sprintf("job%d", len(calls))
+		for _, rj := range rejected {
+			if rj == call {
+				failed[jobid] = true
+			}
+		}
+		mu.Unlock()
+
+		fmt.Fprintf(w, `{"%sresponse":{"jobid":"%s"}}`, strings.ToLower(command), jobid)
+	}))
+
+	return cloudstack.NewClient(srv.URL, "key", "secret", false), srv, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string(nil), calls...)
+	}
+}
+
+func TestRotateCertificate(t *testing.T) {
+	live := &LoadBalancer{Certificate: &Certificate{id: "old"}}
+
+	cases := map[string]struct {
+		current   *LoadBalancer
+		interrupt bool
+		rejected  []string
+		want      []string
+		err       string
+	}{
+		"assigned": {
+			want: []string{"assignCertToLoadBalancer new"},
+		},
+		"replaced in place": {
+			current: live,
+			want:    []string{"assignCertToLoadBalancer new forced"},
+		},
+		"not replaced": {
+			current:  live,
+			rejected: []string{"assignCertToLoadBalancer new forced"},
+			want:     []string{"assignCertToLoadBalancer new forced"},
+			err:      "which is still assigned",
+		},
+		"rotated": {
+			current:   live,
+			interrupt: true,
+			rejected:  []string{"assignCertToLoadBalancer new forced"},
+			want:      []string{"assignCertToLoadBalancer new forced", "removeCertFromLoadBalancer", "assignCertToLoadBalancer new"},
+		},
+		"restored": {
+			current:   live,
+			interrupt: true,
+			rejected:  []string{"assignCertToLoadBalancer new forced", "assignCertToLoadBalancer new"},
+			want:      []string{"assignCertToLoadBalancer new forced", "removeCertFromLoadBalancer", "assignCertToLoadBalancer new", "assignCertToLoadBalancer old"},
+			err:       "Job job3 failed",
+		},
+		"not restored": {
+			current:   live,
+			interrupt: true,
+			rejected:  []string{"assignCertToLoadBalancer new forced", "assignCertToLoadBalancer new", "assignCertToLoadBalancer old"},
+			want:      []string{"assignCertToLoadBalancer new forced", "removeCertFromLoadBalancer", "assignCertToLoadBalancer new", "assignCertToLoadBalancer old"},
+			err:       "the load balancer has no certificate",
+		},
+		"not removed": {
+			current:   live,
+			interrupt: true,
+			rejected:  []string{"assignCertToLoadBalancer new forced", "removeCertFromLoadBalancer"},
+			want:      []string{"assignCertToLoadBalancer new forced", "removeCertFromLoadBalancer"},
+			err:       "Error removing the old certificate",
+		},
+	}
+
+	for name, c := range cases {
+		cs, srv, calls := newJobServer(c.rejected...)
+
+		m := New(cs, WithInterruptingRotation(c.interrupt))
+		err := m.rotateCertificate(context.Background(), "lb1", &Certificate{id: "new"}, c.current)
+		srv.Close()
+
+		if got := calls(); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("%s: expected calls %v, got %v", name, c.want, got)
+		}
+		switch {
+		case c.err == "" && err != nil:
+			t.Errorf("%s: unexpected error: %v", name, err)
+		case c.err != "" && (err == nil || !strings.Contains(err.Error(), c.err)):
+			t.Errorf("%s: expected an error containing %q, got: %v", name, c.err, err)
+		}
+	}
+}
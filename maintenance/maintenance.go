@@ -0,0 +1,380 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+// Package maintenance drains hosts before putting them into maintenance, by
+// planning and executing the migration of all their virtual machines, and
+// optionally restores the virtual machines after the maintenance is cancelled.
+// The Roller uses this to walk all hosts of a cluster in a resumable way.
+package maintenance
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/xanzy/go-cloudstack/v2/bulk"
+	"github.com/xanzy/go-cloudstack/v2/cloudstack"
+	"github.com/xanzy/go-cloudstack/v2/waiter"
+)
+
+// DefaultParallelism is the number of migrations that run at the same time
+// when no other parallelism is configured
+const DefaultParallelism = 3
+
+// DefaultTimeout is the default time to wait for a single migration, and for
+// the host to reach the Maintenance or Enabled resource state
+const DefaultTimeout = 30 * time.Minute
+
+// Placement is the planned migration of a single virtual machine
+type Placement struct {
+	VirtualMachine *cloudstack.VirtualMachine
+	TargetHostID   string
+	TargetHostName string
+	StorageMotion  bool // True if the volumes need to be migrated as well
+}
+
+// Plan describes how the virtual machines on a host will be migrated
+type Plan struct {
+	Host        *cloudstack.Host
+	Placements  []*Placement
+	Unplaceable []*cloudstack.VirtualMachine // Virtual machines without a suitable target host
+}
+
+// Migration is the result of migrating a single virtual machine
+type Migration struct {
+	*Placement
+	JobID string
+	Err   error
+}
+
+// Report is the result of draining (or restoring) a host
+type Report struct {
+	Plan       *Plan
+	Migrations []*Migration
+}
+
+// Failed returns the migrations that failed
+func (r *Report) Failed() []*Migration {
+	var failed []*Migration
+	for _, m := range r.Migrations {
+		if m.Err != nil {
+			failed = append(failed, m)
+		}
+	}
+	return failed
+}
+
+// Option can be passed to New to set custom options
+type Option func(*Drainer)
+
+// WithParallelism sets the maximum number of migrations running at the same time
+func WithParallelism(n int) Option {
+	return func(d *Drainer) {
+		if n > 0 {
+			d.parallelism = n
+		}
+	}
+}
+
+// WithTimeout sets the time to wait for a single migration, and for the host to
+// reach the Maintenance or Enabled resource state
+func WithTimeout(timeout time.Duration) Option {
+	return func(d *Drainer) {
+		if timeout != 0 {
+			d.timeout = timeout
+		}
+	}
+}
+
+// WithExcludedHosts prevents virtual machines from being migrated to the given
+// hosts (e.g. hosts that will be drained next)
+func WithExcludedHosts(ids ...string) Option {
+	return func(d *Drainer) {
+		for _, id := range ids {
+			d.excluded[id] = true
+		}
+	}
+}
+
+// WithOptions sets option functions (e.g. cloudstack.WithProject) that are
+// applied when listing virtual machines and looking up hosts
+func WithOptions(opts ...cloudstack.OptionFunc) Option {
+	return func(d *Drainer) {
+		d.opts = append(d.opts, opts...)
+	}
+}
+
+// Drainer drains hosts before putting them into maintenance
+type Drainer struct {
+	cs          *cloudstack.CloudStackClient
+	parallelism int
+	timeout     time.Duration
+	excluded    map[string]bool
+	opts        []cloudstack.OptionFunc
+}
+
+// New returns a new drainer using the given client
+func New(cs *cloudstack.CloudStackClient, options ...Option) *Drainer {
+	d := &Drainer{
+		cs:          cs,
+		parallelism: DefaultParallelism,
+		timeout:     DefaultTimeout,
+		excluded:    make(map[string]bool),
+	}
+
+	for _, fn := range options {
+		fn(d)
+	}
+
+	return d
+}
+
+// hostForMigration is a single entry of the findHostsForMigration response
+type hostForMigration = cloudstack.FindHostsForMigrationResponse
+
+// findHostsForMigration is called as a custom request, as the generated
+// FindHostsForMigrationResponse only holds a single host
+func (d *Drainer) findHostsForMigration(vmid string) ([]*hostForMigration, error) {
+	p := &cloudstack.CustomServiceParams{}
+	p.SetParam("virtualmachineid", vmid)
+
+	var r struct {
+		Count int                 `json:"count"`
+		Host  []*hostForMigration `json:"host"`
+	}
+	if err := d.cs.Custom.CustomRequest("findHostsForMigration", p, &r); err != nil {
+		return nil, err
+	}
+
+	return r.Host, nil
+}
+
+// Plan determines a target host for every running virtual machine on the host.
+// Target hosts not requiring storage motion are preferred, after which the host
+// with the most free memory (taking earlier placements into account) is chosen.
+func (d *Drainer) Plan(hostid string) (*Plan, error) {
+	host, _, err := d.cs.Host.GetHostByID(hostid, d.opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	p := d.cs.VirtualMachine.NewListVirtualMachinesParams()
+	p.SetHostid(hostid)
+	p.SetListall(true)
+	p.SetState(waiter.Running)
+
+	vms, err := bulk.New(d.cs, bulk.WithOptions(d.opts...)).Targets(bulk.Filter{Params: p})
+	if err != nil {
+		return nil, err
+	}
+
+	// Place the largest virtual machines first, while most memory is free
+	sort.SliceStable(vms, func(i, j int) bool { return vms[i].Memory > vms[j].Memory })
+
+	plan := &Plan{Host: host}
+	planned := make(map[string]int64)
+
+	for _, vm := range vms {
+		candidates, err := d.findHostsForMigration(vm.Id)
+		if err != nil {
+			return nil, fmt.Errorf("Error finding hosts for virtual machine %s: %v", vm.Name, err)
+		}
+
+		var best *hostForMigration
+		for _, c := range candidates {
+			if !c.Suitableformigration || c.Id == hostid || d.excluded[c.Id] {
+				continue
+			}
+			if best == nil || better(c, best, planned) {
+				best = c
+			}
+		}
+
+		if best == nil {
+			plan.Unplaceable = append(plan.Unplaceable, vm)
+			continue
+		}
+
+		planned[best.Id] += int64(vm.Memory) * 1024 * 1024
+		plan.Placements = append(plan.Placements, &Placement{
+			VirtualMachine: vm,
+			TargetHostID:   best.Id,
+			TargetHostName: best.Name,
+			StorageMotion:  best.RequiresStorageMotion,
+		})
+	}
+
+	return plan, nil
+}
+
+// better returns true if host a is a better target than host b
+func better(a, b *hostForMigration, planned map[string]int64) bool {
+	if a.RequiresStorageMotion != b.RequiresStorageMotion {
+		return !a.RequiresStorageMotion
+	}
+	return a.Memorytotal-a.Memoryused-planned[a.Id] > b.Memorytotal-b.Memoryused-planned[b.Id]
+}
+
+// Drain migrates all virtual machines according to the plan, after which the
+// host is prepared for maintenance and Drain waits until the host reaches the
+// Maintenance resource state. If the plan contains unplaceable virtual machines
+// or if any migration fails, the host is not put into maintenance.
+func (d *Drainer) Drain(ctx context.Context, plan *Plan) (*Report, error) {
+	if len(plan.Unplaceable) > 0 {
+		names := make([]string, len(plan.Unplaceable))
+		for i, vm := range plan.Unplaceable {
+			names[i] = vm.Name
+		}
+		return nil, fmt.Errorf("No suitable host found for virtual machines: %s", strings.Join(names, ", "))
+	}
+
+	report := &Report{Plan: plan, Migrations: d.migrate(ctx, plan.Placements)}
+	if failed := report.Failed(); len(failed) > 0 {
+		return report, fmt.Errorf("%d of %d migrations from host %s failed", len(failed), len(report.Migrations), plan.Host.Name)
+	}
+
+	r, err := d.cs.Host.PrepareHostForMaintenance(d.cs.Host.NewPrepareHostForMaintenanceParams(plan.Host.Id))
+	if err != nil {
+		return report, err
+	}
+
+	if err := d.waitForResourceState(ctx, r.JobID, plan.Host.Id, "Maintenance"); err != nil {
+		return report, err
+	}
+
+	return report, nil
+}
+
+// DrainHost plans and drains the host in one go
+func (d *Drainer) DrainHost(ctx context.Context, hostid string) (*Report, error) {
+	plan, err := d.Plan(hostid)
+	if err != nil {
+		return nil, err
+	}
+	return d.Drain(ctx, plan)
+}
+
+// Cancel cancels the maintenance of the drained host and waits until it is
+// enabled again. When restore is true, the virtual machines that were migrated
+// successfully are migrated back to the host, provided they are still running
+// on the host they were migrated to. The returned report only contains the
+// migrations back to the host.
+func (d *Drainer) Cancel(ctx context.Context, report *Report, restore bool) (*Report, error) {
+	host := report.Plan.Host
+
+	r, err := d.cs.Host.CancelHostMaintenance(d.cs.Host.NewCancelHostMaintenanceParams(host.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.waitForResourceState(ctx, r.JobID, host.Id, "Enabled"); err != nil {
+		return nil, err
+	}
+
+	plan := &Plan{Host: host}
+	if !restore {
+		return &Report{Plan: plan}, nil
+	}
+
+	for _, m := range report.Migrations {
+		if m.Err != nil {
+			continue
+		}
+
+		vm, _, err := d.cs.VirtualMachine.GetVirtualMachineByID(m.VirtualMachine.Id, d.opts...)
+		if err != nil || vm.State != waiter.Running || vm.Hostid != m.TargetHostID {
+			continue
+		}
+
+		plan.Placements = append(plan.Placements, &Placement{
+			VirtualMachine: vm,
+			TargetHostID:   host.Id,
+			TargetHostName: host.Name,
+			StorageMotion:  m.StorageMotion,
+		})
+	}
+
+	restored := &Report{Plan: plan, Migrations: d.migrate(ctx, plan.Placements)}
+	if failed := restored.Failed(); len(failed) > 0 {
+		return restored, fmt.Errorf("%d of %d migrations back to host %s failed", len(failed), len(restored.Migrations), host.Name)
+	}
+
+	return restored, nil
+}
+
+// migrate runs the migrations with the configured parallelism
+func (d *Drainer) migrate(ctx context.Context, placements []*Placement) []*Migration {
+	byVM := make(map[string]*Placement, len(placements))
+	vms := make([]*cloudstack.VirtualMachine, len(placements))
+	for i, p := range placements {
+		byVM[p.VirtualMachine.Id] = p
+		vms[i] = p.VirtualMachine
+	}
+
+	op := func(cs *cloudstack.CloudStackClient, vm *cloudstack.VirtualMachine) (string, error) {
+		p := byVM[vm.Id]
+
+		if p.StorageMotion {
+			r, err := cs.VirtualMachine.MigrateVirtualMachineWithVolume(
+				cs.VirtualMachine.NewMigrateVirtualMachineWithVolumeParams(p.TargetHostID, vm.Id))
+			if err != nil {
+				return "", err
+			}
+			return r.JobID, nil
+		}
+
+		mp := cs.VirtualMachine.NewMigrateVirtualMachineParams(vm.Id)
+		mp.SetHostid(p.TargetHostID)
+
+		r, err := cs.VirtualMachine.MigrateVirtualMachine(mp)
+		if err != nil {
+			return "", err
+		}
+		return r.JobID, nil
+	}
+
+	e := bulk.New(d.cs, bulk.WithParallelism(d.parallelism), bulk.WithTimeout(d.timeout))
+
+	var migrations []*Migration
+	for _, r := range e.RunOn(ctx, vms, op) {
+		migrations = append(migrations, &Migration{
+			Placement: byVM[r.VirtualMachine.Id],
+			JobID:     r.JobID,
+			Err:       r.Err,
+		})
+	}
+
+	return migrations
+}
+
+// waitForResourceState waits for the job and then for the host to reach the state
+func (d *Drainer) waitForResourceState(ctx context.Context, jobid, hostid, state string) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	w := waiter.New(d.cs, waiter.WithOptions(d.opts...))
+	if err := w.WaitForJob(ctx, jobid); err != nil {
+		return err
+	}
+
+	_, err := w.WaitForHostResourceState(ctx, hostid, state)
+	return err
+}
@@ -0,0 +1,113 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package maintenance
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/xanzy/go-cloudstack/v2/cloudstack"
+)
+
+const gib = 1024 * 1024 * 1024
+
+func TestBetter(t *testing.T) {
+	a := &hostForMigration{Id: "a", Memorytotal: 8 * gib, Memoryused: 2 * gib}
+	b := &hostForMigration{Id: "b", Memorytotal: 8 * gib, Memoryused: 4 * gib}
+	motion := &hostForMigration{Id: "m", Memorytotal: 64 * gib, RequiresStorageMotion: true}
+
+	if !better(a, b, nil) || better(b, a, nil) {
+		t.Fatal("Expected the host with the most free memory to be better")
+	}
+	if !better(b, motion, nil) || better(motion, b, nil) {
+		t.Fatal("Expected a host without storage motion to be better")
+	}
+	if better(a, b, map[string]int64{"a": 3 * gib}) {
+		t.Fatal("Expected planned placements to count as used memory")
+	}
+}
+
+func TestPlan(t *testing.T) {
+	candidates := `[` +
+		`{"id":"h0","name":"h0","suitableformigration":true,"memorytotal":%[1]d},` +
+		`{"id":"h1","name":"h1","suitableformigration":true,"memorytotal":%[2]d},` +
+		`{"id":"h2","name":"h2","suitableformigration":true,"memorytotal":%[1]d,"requiresStorageMotion":true},` +
+		`{"id":"h3","name":"h3","suitableformigration":false,"memorytotal":%[1]d},` +
+		`{"id":"h4","name":"h4","suitableformigration":true,"memorytotal":%[3]d},` +
+		`{"id":"h5","name":"h5","suitableformigration":true,"memorytotal":%[1]d}]`
+	candidates = fmt.Sprintf(candidates, 64*gib, 8*gib, 5*gib)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		switch q.Get("command") {
+		case "listHosts":
+			w.Write([]byte(`{"listhostsresponse":{"count":1,"host":[{"id":"h0","name":"h0"}]}}`))
+		case "listVirtualMachines":
+			w.Write([]byte(`{"listvirtualmachinesresponse":{"count":4,"virtualmachine":[` +
+				`{"id":"vm3","memory":1024},{"id":"vm1","memory":4096},` +
+				`{"id":"vm4","memory":512},{"id":"vm2","memory":2048}]}}`))
+		case "findHostsForMigration":
+			if q.Get("virtualmachineid") == "vm4" {
+				w.Write([]byte(`{"findhostsformigrationresponse":{"count":1,"host":[{"id":"h3","suitableformigration":false}]}}`))
+				return
+			}
+			fmt.Fprintf(w, `{"findhostsformigrationresponse":{"count":6,"host":%s}}`, candidates)
+		default:
+			t.Errorf("Unexpected command: %s", q.Get("command"))
+		}
+	}))
+	defer srv.Close()
+
+	// The source host and excluded hosts are never a target
+	d := New(cloudstack.NewClient(srv.URL, "key", "secret", false), WithExcludedHosts("h5"))
+
+	plan, err := d.Plan("h0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The largest virtual machines are placed first, on the host with the
+	// most free memory left that does not require storage motion
+	want := []string{"vm1 -> h1", "vm2 -> h4", "vm3 -> h1"}
+	if len(plan.Placements) != len(want) {
+		t.Fatalf("Expected %d placements, got %d", len(want), len(plan.Placements))
+	}
+	for i, p := range plan.Placements {
+		if got := p.VirtualMachine.Id + " -> " + p.TargetHostID; got != want[i] {
+			t.Fatalf("Expected placement %d to be %s, got %s", i, want[i], got)
+		}
+		if p.StorageMotion {
+			t.Fatalf("Expected placement %d not to require storage motion", i)
+		}
+	}
+
+	if len(plan.Unplaceable) != 1 || plan.Unplaceable[0].Id != "vm4" {
+		t.Fatalf("Expected vm4 to be unplaceable, got %d unplaceable", len(plan.Unplaceable))
+	}
+}
+
+func TestReportFailed(t *testing.T) {
+	report := &Report{Migrations: []*Migration{{}, {Err: fmt.Errorf("failed")}, {}}}
+	if failed := report.Failed(); len(failed) != 1 || failed[0] != report.Migrations[1] {
+		t.Fatalf("Expected only the second migration to fail, got %d", len(failed))
+	}
+}
@@ -0,0 +1,525 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package maintenance
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/xanzy/go-cloudstack/v2/cloudstack"
+	"github.com/xanzy/go-cloudstack/v2/waiter"
+)
+
+// The capacity types that can be used with WithCapacityFloor
+const (
+	CapacityTypeMemory = 0
+	CapacityTypeCPU    = 1
+)
+
+// maintenanceStates are the resource states of a host that is (going) in maintenance
+var maintenanceStates = map[string]bool{
+	"PrepareForMaintenance":        true,
+	"Maintenance":                  true,
+	"ErrorInMaintenance":           true,
+	"ErrorInPrepareForMaintenance": true,
+}
+
+// Hook is run for each host while it is in maintenance
+type Hook func(ctx context.Context, cs *cloudstack.CloudStackClient, host *cloudstack.Host) error
+
+// PowerAction returns a hook that issues an out-of-band management power action
+// (e.g. RESET or CYCLE) and waits until the agent of the host is Up again
+func PowerAction(action string) Hook {
+	return func(ctx context.Context, cs *cloudstack.CloudStackClient, host *cloudstack.Host) error {
+		r, err := cs.OutofbandManagement.IssueOutOfBandManagementPowerAction(
+			cs.OutofbandManagement.NewIssueOutOfBandManagementPowerActionParams(action, host.Id))
+		if err != nil {
+			return err
+		}
+
+		w := waiter.New(cs)
+		if err := w.WaitForJob(ctx, r.JobID); err != nil {
+			return err
+		}
+
+		// Give the host some time to actually go down, before waiting for it to be Up
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(waiter.DefaultBackoff.Max):
+		}
+
+		return waiter.Poll(ctx, waiter.DefaultBackoff, func() (bool, error) {
+			h, _, err := cs.Host.GetHostByID(host.Id)
+			if err != nil {
+				return false, err
+			}
+			return h.State == "Up", nil
+		})
+	}
+}
+
+// MigratedVM records a virtual machine that was migrated away from a host
+type MigratedVM struct {
+	VirtualMachineID string `json:"virtualmachineid"`
+	TargetHostID     string `json:"targethostid"`
+	StorageMotion    bool   `json:"storagemotion"`
+}
+
+// State is the progress of a rolling run, as saved in a checkpoint
+type State struct {
+	ClusterID  string                  `json:"clusterid"`
+	Completed  []string                `json:"completed"`
+	InProgress map[string][]MigratedVM `json:"inprogress"`
+}
+
+// Checkpoint persists the state of a rolling run, so it can be resumed
+type Checkpoint interface {
+	Load() (*State, error)
+	Save(*State) error
+}
+
+// FileCheckpoint is a checkpoint stored as a JSON file
+type FileCheckpoint string
+
+// Load reads the state from the file, returning an empty state if the file does not exist
+func (f FileCheckpoint) Load() (*State, error) {
+	b, err := ioutil.ReadFile(string(f))
+	if os.IsNotExist(err) {
+		return &State{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	s := &State{}
+	if err := json.Unmarshal(b, s); err != nil {
+		return nil, fmt.Errorf("Error parsing checkpoint %s: %v", f, err)
+	}
+
+	return s, nil
+}
+
+// Save writes the state to a temporary file and renames it, so an interrupted
+// save never leaves a corrupt checkpoint behind
+func (f FileCheckpoint) Save(s *State) error {
+	b, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp := string(f) + ".tmp"
+	if err := ioutil.WriteFile(tmp, b, 0600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, string(f))
+}
+
+// RollingOption can be passed to NewRoller to set custom options
+type RollingOption func(*Roller)
+
+// WithMaxInMaintenance sets the maximum number of hosts in the cluster that may
+// be in maintenance at the same time, including hosts put into maintenance by
+// others. This is also the number of hosts processed at the same time.
+func WithMaxInMaintenance(n int) RollingOption {
+	return func(r *Roller) {
+		if n > 0 {
+			r.maxInMaintenance = n
+		}
+	}
+}
+
+// WithCapacityFloor only puts a host into maintenance if, without the host, at
+// least minFreePercent of the capacity of the given type (CapacityTypeMemory or
+// CapacityTypeCPU) remains free in the cluster
+func WithCapacityFloor(capacityType int, minFreePercent float64) RollingOption {
+	return func(r *Roller) {
+		r.floors[capacityType] = minFreePercent
+	}
+}
+
+// WithCheckpoint sets the checkpoint used to save and resume progress
+func WithCheckpoint(c Checkpoint) RollingOption {
+	return func(r *Roller) {
+		r.checkpoint = c
+	}
+}
+
+// WithRestore migrates the virtual machines back to each host after its maintenance
+func WithRestore(restore bool) RollingOption {
+	return func(r *Roller) {
+		r.restore = restore
+	}
+}
+
+// WithDrainerOptions sets the options used for the drainer of each host
+func WithDrainerOptions(options ...Option) RollingOption {
+	return func(r *Roller) {
+		r.drainerOptions = append(r.drainerOptions, options...)
+	}
+}
+
+// Roller runs a hook on every host of a cluster, one (or a few) at a time
+type Roller struct {
+	cs             *cloudstack.CloudStackClient
+	clusterID      string
+	hook           Hook
+	drainerOptions []Option
+
+	maxInMaintenance int
+	floors           map[int]float64
+	checkpoint       Checkpoint
+	restore          bool
+
+	mu       sync.Mutex
+	state    *State
+	inFlight map[string]*cloudstack.Host
+}
+
+// NewRoller returns a new roller for the given cluster
+func NewRoller(cs *cloudstack.CloudStackClient, clusterID string, hook Hook, options ...RollingOption) *Roller {
+	r := &Roller{
+		cs:               cs,
+		clusterID:        clusterID,
+		hook:             hook,
+		maxInMaintenance: 1,
+		floors:           make(map[int]float64),
+		inFlight:         make(map[string]*cloudstack.Host),
+	}
+
+	for _, fn := range options {
+		fn(r)
+	}
+
+	return r
+}
+
+// Run drains every host of the cluster, runs the hook and cancels the
+// maintenance again. Hosts completed in an earlier (interrupted) run are
+// skipped, and hosts that were left in maintenance are resumed without
+// draining them again. Run stops at the first error.
+func (r *Roller) Run(ctx context.Context) error {
+	if err := r.load(); err != nil {
+		return err
+	}
+
+	hosts, err := r.listHosts()
+	if err != nil {
+		return err
+	}
+
+	completed := make(map[string]bool)
+	for _, id := range r.state.Completed {
+		completed[id] = true
+	}
+
+	// Resume hosts that were in progress first
+	sort.SliceStable(hosts, func(i, j int) bool {
+		_, a := r.state.InProgress[hosts[i].Id]
+		_, b := r.state.InProgress[hosts[j].Id]
+		return a && !b
+	})
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	queue := make(chan *cloudstack.Host)
+	errs := make(chan error, r.maxInMaintenance)
+
+	var wg sync.WaitGroup
+	for i := 0; i < r.maxInMaintenance; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for host := range queue {
+				if err := r.process(ctx, host); err != nil {
+					errs <- fmt.Errorf("Error processing host %s: %w", host.Name, err)
+					cancel()
+					return
+				}
+			}
+		}()
+	}
+
+	for _, host := range hosts {
+		if completed[host.Id] {
+			continue
+		}
+		select {
+		case queue <- host:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	close(queue)
+	wg.Wait()
+
+	select {
+	case err := <-errs:
+		return err
+	default:
+		return ctx.Err()
+	}
+}
+
+func (r *Roller) load() error {
+	r.state = &State{}
+	if r.checkpoint != nil {
+		s, err := r.checkpoint.Load()
+		if err != nil {
+			return err
+		}
+		if s.ClusterID != "" && s.ClusterID != r.clusterID {
+			return fmt.Errorf("Checkpoint belongs to cluster %s, not to cluster %s", s.ClusterID, r.clusterID)
+		}
+		r.state = s
+	}
+
+	r.state.ClusterID = r.clusterID
+	if r.state.InProgress == nil {
+		r.state.InProgress = make(map[string][]MigratedVM)
+	}
+
+	return nil
+}
+
+// save must be called while holding the lock
+func (r *Roller) save() error {
+	if r.checkpoint == nil {
+		return nil
+	}
+	return r.checkpoint.Save(r.state)
+}
+
+func (r *Roller) listHosts() ([]*cloudstack.Host, error) {
+	p := r.cs.Host.NewListHostsParams()
+	p.SetClusterid(r.clusterID)
+	p.SetType("Routing")
+
+	l, err := r.cs.Host.ListHosts(p)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(l.Hosts, func(i, j int) bool { return l.Hosts[i].Name < l.Hosts[j].Name })
+
+	return l.Hosts, nil
+}
+
+// process runs all steps for a single host
+func (r *Roller) process(ctx context.Context, host *cloudstack.Host) error {
+	if err := r.reserve(ctx, host); err != nil {
+		return err
+	}
+	defer r.release(host)
+
+	r.mu.Lock()
+	migrated, resumed := r.state.InProgress[host.Id]
+	var excluded []string
+	for id := range r.inFlight {
+		if id != host.Id {
+			excluded = append(excluded, id)
+		}
+	}
+	r.mu.Unlock()
+
+	d := New(r.cs, append(r.drainerOptions, WithExcludedHosts(excluded...))...)
+
+	report := &Report{Plan: &Plan{Host: host}}
+	if !resumed || host.Resourcestate != "Maintenance" {
+		drained, err := d.DrainHost(ctx, host.Id)
+		if drained != nil {
+			migrated = append(migrated, migratedVMs(drained)...)
+		}
+		if serr := r.update(func() { r.state.InProgress[host.Id] = migrated }); serr != nil {
+			return serr
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, m := range migrated {
+		report.Migrations = append(report.Migrations, &Migration{
+			Placement: &Placement{
+				VirtualMachine: &cloudstack.VirtualMachine{Id: m.VirtualMachineID},
+				TargetHostID:   m.TargetHostID,
+				StorageMotion:  m.StorageMotion,
+			},
+		})
+	}
+
+	if r.hook != nil {
+		if err := r.hook(ctx, r.cs, host); err != nil {
+			return err
+		}
+	}
+
+	if _, err := d.Cancel(ctx, report, r.restore); err != nil {
+		return err
+	}
+
+	return r.update(func() {
+		delete(r.state.InProgress, host.Id)
+		r.state.Completed = append(r.state.Completed, host.Id)
+	})
+}
+
+// update changes the state while holding the lock and saves it
+func (r *Roller) update(fn func()) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	fn()
+	return r.save()
+}
+
+func migratedVMs(report *Report) []MigratedVM {
+	var vms []MigratedVM
+	for _, m := range report.Migrations {
+		if m.Err == nil {
+			vms = append(vms, MigratedVM{
+				VirtualMachineID: m.VirtualMachine.Id,
+				TargetHostID:     m.TargetHostID,
+				StorageMotion:    m.StorageMotion,
+			})
+		}
+	}
+	return vms
+}
+
+// reserve waits until the host may be put into maintenance without exceeding
+// the maximum number of hosts in maintenance or any of the capacity floors
+func (r *Roller) reserve(ctx context.Context, host *cloudstack.Host) error {
+	return waiter.Poll(ctx, waiter.DefaultBackoff, func() (bool, error) {
+		// Fetch the hosts and capacity before locking, so other hosts are not
+		// blocked while waiting for the API
+		hosts, err := r.listHosts()
+		if err != nil {
+			return false, err
+		}
+		capacity, err := r.listCapacity()
+		if err != nil {
+			return false, err
+		}
+
+		r.mu.Lock()
+		defer r.mu.Unlock()
+
+		// A host that was left in maintenance by an earlier run is already counted
+		if _, ok := r.state.InProgress[host.Id]; ok {
+			r.inFlight[host.Id] = host
+			return true, nil
+		}
+
+		count := len(r.inFlight)
+		for _, h := range hosts {
+			if _, ok := r.inFlight[h.Id]; !ok && maintenanceStates[h.Resourcestate] {
+				count++
+			}
+		}
+		if count >= r.maxInMaintenance {
+			return false, nil
+		}
+
+		if !r.aboveFloors(host, capacity) {
+			return false, nil
+		}
+
+		r.inFlight[host.Id] = host
+		return true, nil
+	})
+}
+
+func (r *Roller) release(host *cloudstack.Host) {
+	r.mu.Lock()
+	delete(r.inFlight, host.Id)
+	r.mu.Unlock()
+}
+
+// aboveFloors checks if enough capacity remains free without the host and the
+// hosts that are already in flight. This is conservative, as the capacity of
+// hosts already in maintenance may not be part of the cluster total anymore.
+// It must be called while holding the lock.
+// listCapacity returns the capacity of the cluster for each type with a floor
+func (r *Roller) listCapacity() (map[int]*cloudstack.Capacity, error) {
+	capacity := make(map[int]*cloudstack.Capacity, len(r.floors))
+	for capacityType := range r.floors {
+		p := r.cs.SystemCapacity.NewListCapacityParams()
+		p.SetClusterid(r.clusterID)
+		p.SetType(capacityType)
+		p.SetFetchlatest(true)
+
+		l, err := r.cs.SystemCapacity.ListCapacity(p)
+		if err != nil {
+			return nil, err
+		}
+		if l.Count == 0 {
+			return nil, fmt.Errorf("No capacity of type %d found for cluster %s", capacityType, r.clusterID)
+		}
+		capacity[capacityType] = l.Capacity[0]
+	}
+
+	return capacity, nil
+}
+
+// aboveFloors returns true if the free capacity stays above all floors when the
+// host and all hosts in flight are put into maintenance. It must be called with
+// the lock held.
+func (r *Roller) aboveFloors(host *cloudstack.Host, capacity map[int]*cloudstack.Capacity) bool {
+	for capacityType, floor := range r.floors {
+		c := capacity[capacityType]
+
+		total := c.Capacitytotal - hostCapacity(host, capacityType)
+		for _, h := range r.inFlight {
+			total -= hostCapacity(h, capacityType)
+		}
+		if total <= 0 {
+			return false
+		}
+
+		free := float64(total-c.Capacityused) / float64(total) * 100
+		if free < floor {
+			return false
+		}
+	}
+
+	return true
+}
+
+func hostCapacity(h *cloudstack.Host, capacityType int) int64 {
+	switch capacityType {
+	case CapacityTypeMemory:
+		return h.Memorytotal
+	case CapacityTypeCPU:
+		return int64(h.Cpunumber) * h.Cpuspeed
+	default:
+		return 0
+	}
+}
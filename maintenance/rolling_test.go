@@ -0,0 +1,110 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package maintenance
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/xanzy/go-cloudstack/v2/cloudstack"
+)
+
+func TestFileCheckpoint(t *testing.T) {
+	dir, err := ioutil.TempDir("", "maintenance")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	f := FileCheckpoint(filepath.Join(dir, "state.json"))
+
+	s, err := f.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(s, &State{}) {
+		t.Fatalf("Expected an empty state for a missing checkpoint, got %+v", s)
+	}
+
+	want := &State{
+		ClusterID: "c1",
+		Completed: []string{"h1", "h2"},
+		InProgress: map[string][]MigratedVM{
+			"h3": {{VirtualMachineID: "vm1", TargetHostID: "h1", StorageMotion: true}},
+		},
+	}
+	if err := f.Save(want); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(string(f) + ".tmp"); !os.IsNotExist(err) {
+		t.Fatalf("Expected the temporary file to be renamed, got: %v", err)
+	}
+
+	s, err = f.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(s, want) {
+		t.Fatalf("Expected %+v, got %+v", want, s)
+	}
+
+	if err := ioutil.WriteFile(string(f), []byte("{"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.Load(); err == nil {
+		t.Fatal("Expected an error for a corrupt checkpoint")
+	}
+}
+
+func TestHostCapacity(t *testing.T) {
+	h := &cloudstack.Host{Memorytotal: 8 * gib, Cpunumber: 4, Cpuspeed: 2000}
+
+	if got := hostCapacity(h, CapacityTypeMemory); got != 8*gib {
+		t.Fatalf("Expected a memory capacity of %d, got %d", int64(8*gib), got)
+	}
+	if got := hostCapacity(h, CapacityTypeCPU); got != 8000 {
+		t.Fatalf("Expected a CPU capacity of 8000, got %d", got)
+	}
+	if got := hostCapacity(h, 99); got != 0 {
+		t.Fatalf("Expected no capacity for an unknown type, got %d", got)
+	}
+}
+
+func TestAboveFloors(t *testing.T) {
+	r := NewRoller(nil, "cluster", nil, WithCapacityFloor(CapacityTypeMemory, 25))
+	capacity := map[int]*cloudstack.Capacity{
+		CapacityTypeMemory: {Capacitytotal: 32 * gib, Capacityused: 16 * gib},
+	}
+	host := &cloudstack.Host{Id: "h1", Memorytotal: 8 * gib}
+
+	// 24 GiB remains, of which a third is free
+	if !r.aboveFloors(host, capacity) {
+		t.Fatal("Expected the free capacity to stay above the floor")
+	}
+
+	// With another host in flight only 16 GiB remains, which is fully used
+	r.inFlight["h2"] = &cloudstack.Host{Id: "h2", Memorytotal: 8 * gib}
+	if r.aboveFloors(host, capacity) {
+		t.Fatal("Expected the free capacity to drop below the floor")
+	}
+}
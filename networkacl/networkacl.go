@@ -0,0 +1,652 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+// Package networkacl reconciles VPC network ACL lists with an ordered list of
+// desired rules. Changes are either made in place, in an order that never
+// allows traffic that is allowed neither before nor after reconciling, or by
+// building a new list and swapping it in with ReplaceNetworkACLList.
+package networkacl
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/xanzy/go-cloudstack/v2/cloudstack"
+	"github.com/xanzy/go-cloudstack/v2/internal/common"
+	"github.com/xanzy/go-cloudstack/v2/waiter"
+)
+
+// DefaultStep is the default difference between the numbers of two rules, which
+// leaves room to insert rules later without renumbering
+const DefaultStep = 10
+
+// DefaultTimeout is the default time to wait for a single change
+const DefaultTimeout = 5 * time.Minute
+
+// StepKind is the kind of change made by a step
+type StepKind string
+
+const (
+	// Create creates a new item
+	Create StepKind = "create"
+
+	// Update changes the number or reason of a live item
+	Update StepKind = "update"
+
+	// Delete deletes a live item
+	Delete StepKind = "delete"
+)
+
+// Step is a single change. Steps must be applied in order.
+type Step struct {
+	Kind   StepKind
+	Item   Item // The desired item for creates, the live item otherwise
+	Number int  // The number of the item after the step
+	Reason string
+}
+
+func (s Step) String() string {
+	switch s.Kind {
+	case Create:
+		return fmt.Sprintf("create #%d %s", s.Number, s.Item)
+	case Update:
+		return fmt.Sprintf("update #%d -> #%d %s", s.Item.Number, s.Number, s.Item)
+	default:
+		return fmt.Sprintf("delete #%d %s", s.Item.Number, s.Item)
+	}
+}
+
+// Plan is the ordered list of steps needed to reconcile an ACL list
+type Plan struct {
+	ACLID      string
+	Renumbered bool // True if the live items had to be renumbered
+	Steps      []Step
+}
+
+// Empty returns true if the ACL list is already in the desired state
+func (p *Plan) Empty() bool {
+	return len(p.Steps) == 0
+}
+
+// Result is the result of applying a plan
+type Result struct {
+	*Plan
+	DryRun bool
+	Done   []Step
+	Err    error // The error of the step that failed; later steps are not applied
+}
+
+// Failed returns true if a step failed
+func (r *Result) Failed() bool {
+	return r.Err != nil
+}
+
+// SwapResult is the result of swapping in a new ACL list
+type SwapResult struct {
+	OldACLID   string
+	NewACLID   string
+	Networks   []string // The networks now using, or in a dry run to use, the new list
+	Gateways   []string // The private gateways now using, or in a dry run to use, the new list
+	DeletedOld bool
+	Errs       []error
+}
+
+// Failed returns true if any network or gateway could not be switched
+func (r *SwapResult) Failed() bool {
+	return len(r.Errs) > 0
+}
+
+// Option can be passed to New to set custom options
+type Option func(*Reconciler)
+
+// WithStep sets the difference between the numbers of two rules
+func WithStep(step int) Option {
+	return func(r *Reconciler) {
+		if step > 0 {
+			r.step = step
+		}
+	}
+}
+
+// WithDryRun only plans the changes without applying them
+func WithDryRun(dryRun bool) Option {
+	return func(r *Reconciler) {
+		r.dryRun = dryRun
+	}
+}
+
+// WithDeleteReplaced deletes the old list after it was swapped out everywhere
+func WithDeleteReplaced(del bool) Option {
+	return func(r *Reconciler) {
+		r.deleteReplaced = del
+	}
+}
+
+// WithTimeout sets the time to wait for a single change
+func WithTimeout(timeout time.Duration) Option {
+	return func(r *Reconciler) {
+		if timeout != 0 {
+			r.timeout = timeout
+		}
+	}
+}
+
+// WithOptions sets option functions (e.g. cloudstack.WithProject) that are
+// applied when listing ACL items, networks and private gateways
+func WithOptions(opts ...cloudstack.OptionFunc) Option {
+	return func(r *Reconciler) {
+		r.opts = append(r.opts, opts...)
+	}
+}
+
+// Reconciler reconciles network ACL lists
+type Reconciler struct {
+	cs             *cloudstack.CloudStackClient
+	step           int
+	dryRun         bool
+	deleteReplaced bool
+	timeout        time.Duration
+	opts           []cloudstack.OptionFunc
+	waiter         *waiter.Waiter
+}
+
+// New returns a new reconciler using the given client
+func New(cs *cloudstack.CloudStackClient, options ...Option) *Reconciler {
+	r := &Reconciler{
+		cs:      cs,
+		step:    DefaultStep,
+		timeout: DefaultTimeout,
+	}
+
+	for _, fn := range options {
+		fn(r)
+	}
+	r.waiter = waiter.New(cs, waiter.WithTimeout(r.timeout))
+
+	return r
+}
+
+// Live returns the live items of the ACL list, ordered by number
+func (r *Reconciler) Live(aclid string) ([]Item, error) {
+	p := r.cs.NetworkACL.NewListNetworkACLsParams()
+	p.SetAclid(aclid)
+	p.SetListall(true)
+	if err := common.ApplyOptions(r.cs, p, r.opts...); err != nil {
+		return nil, err
+	}
+
+	l, err := r.cs.NetworkACL.ListNetworkACLs(p)
+	if err != nil {
+		return nil, err
+	}
+
+	var live []Item
+	for _, acl := range l.NetworkACLs {
+		i, err := liveItem(acl)
+		if err != nil {
+			return nil, fmt.Errorf("Error in live item %s: %v", acl.Id, err)
+		}
+		live = append(live, i)
+	}
+
+	sort.SliceStable(live, func(a, b int) bool {
+		return live[a].Number < live[b].Number
+	})
+
+	return live, nil
+}
+
+// Plan computes the steps needed to give the ACL list exactly the desired rules
+// in the desired order.
+//
+// Live items that match a desired rule are kept. If their numbers are already
+// in the desired order, new items are inserted in the gaps between them;
+// otherwise all items are renumbered into a range above the current numbers.
+// The steps are ordered so that every intermediate state only allows traffic
+// that is allowed before or after reconciling:
+//
+//  1. new deny rules are created, which can only restrict traffic;
+//  2. obsolete allow rules are deleted, which can only restrict traffic;
+//  3. kept rules are renumbered, last rule first, so every rule that was moved
+//     is in its final position relative to all other moved rules, and after
+//     all rules that were not moved yet;
+//  4. new allow rules are created;
+//  5. obsolete deny rules are deleted.
+func (r *Reconciler) Plan(aclid string, rules []Rule) (*Plan, error) {
+	want, err := items(rules)
+	if err != nil {
+		return nil, err
+	}
+
+	live, err := r.Live(aclid)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.plan(aclid, want, live), nil
+}
+
+func (r *Reconciler) plan(aclid string, want, live []Item) *Plan {
+	plan := &Plan{ACLID: aclid}
+
+	// Match the desired items with the live items, in order of their numbers
+	byKey := make(map[string][]int)
+	for n, i := range live {
+		byKey[i.key()] = append(byKey[i.key()], n)
+	}
+
+	matched := make([]int, len(want))
+	kept := make(map[int]bool)
+	for n, i := range want {
+		matched[n] = -1
+		if q := byKey[i.key()]; len(q) > 0 {
+			matched[n] = q[0]
+			byKey[i.key()] = q[1:]
+			kept[q[0]] = true
+		}
+	}
+
+	used := make(map[int]bool, len(live))
+	for _, i := range live {
+		used[i.Number] = true
+	}
+
+	numbers, ok := r.keepNumbers(want, live, matched, used)
+	if !ok {
+		numbers = r.renumber(want, live)
+		plan.Renumbered = true
+	}
+
+	var obsolete []Item
+	for n, i := range live {
+		if !kept[n] {
+			obsolete = append(obsolete, i)
+		}
+	}
+
+	// 1. Create new deny rules
+	for n, i := range want {
+		if matched[n] < 0 && !i.Allow() {
+			plan.Steps = append(plan.Steps, Step{Kind: Create, Item: i, Number: numbers[n], Reason: i.Reason})
+		}
+	}
+
+	// 2. Delete obsolete allow rules
+	for _, i := range obsolete {
+		if i.Allow() {
+			plan.Steps = append(plan.Steps, Step{Kind: Delete, Item: i, Number: i.Number})
+		}
+	}
+
+	// 3. Renumber kept rules, last rule first
+	for n := len(want) - 1; n >= 0; n-- {
+		if matched[n] < 0 {
+			continue
+		}
+		l := live[matched[n]]
+		if l.Number != numbers[n] || l.Reason != want[n].Reason {
+			plan.Steps = append(plan.Steps, Step{Kind: Update, Item: l, Number: numbers[n], Reason: want[n].Reason})
+		}
+	}
+
+	// 4. Create new allow rules
+	for n, i := range want {
+		if matched[n] < 0 && i.Allow() {
+			plan.Steps = append(plan.Steps, Step{Kind: Create, Item: i, Number: numbers[n], Reason: i.Reason})
+		}
+	}
+
+	// 5. Delete obsolete deny rules
+	for _, i := range obsolete {
+		if !i.Allow() {
+			plan.Steps = append(plan.Steps, Step{Kind: Delete, Item: i, Number: i.Number})
+		}
+	}
+
+	return plan
+}
+
+// keepNumbers numbers the desired items while keeping the numbers of the kept
+// live items. New items are spread over the unused numbers between the kept
+// items. It returns false if the kept items are not in the desired order, or if
+// there is not enough room between them.
+func (r *Reconciler) keepNumbers(want, live []Item, matched []int, used map[int]bool) ([]int, bool) {
+	numbers := make([]int, len(want))
+
+	last := 0
+	var pending []int
+	for n := range want {
+		if matched[n] < 0 {
+			pending = append(pending, n)
+			continue
+		}
+
+		next := live[matched[n]].Number
+		if next <= last {
+			return nil, false
+		}
+
+		free := freeNumbers(last, next, used)
+		if len(free) < len(pending) {
+			return nil, false
+		}
+		for j, p := range pending {
+			numbers[p] = free[(j+1)*len(free)/(len(pending)+1)]
+		}
+
+		numbers[n] = next
+		last = next
+		pending = nil
+	}
+
+	// Append the remaining new items after the last kept item
+	for _, p := range pending {
+		next := last + r.step
+		for used[next] {
+			next++
+		}
+		numbers[p] = next
+		last = next
+	}
+
+	return numbers, true
+}
+
+// freeNumbers returns the unused numbers between from and to (both exclusive)
+func freeNumbers(from, to int, used map[int]bool) []int {
+	var free []int
+	for n := from + 1; n < to; n++ {
+		if !used[n] {
+			free = append(free, n)
+		}
+	}
+	return free
+}
+
+// renumber numbers the desired items in a range above all live numbers, so
+// moving an item never conflicts with the number of another item
+func (r *Reconciler) renumber(want, live []Item) []int {
+	base := 0
+	for _, i := range live {
+		if i.Number > base {
+			base = i.Number
+		}
+	}
+	base = (base/r.step + 1) * r.step
+
+	numbers := make([]int, len(want))
+	for n := range want {
+		numbers[n] = base + n*r.step
+	}
+
+	return numbers
+}
+
+// Apply applies the steps of the plan in order, unless the reconciler is in
+// dry-run mode. Applying stops at the first step that fails, as the safety of
+// the following steps depends on it.
+func (r *Reconciler) Apply(ctx context.Context, plan *Plan) *Result {
+	res := &Result{Plan: plan, DryRun: r.dryRun}
+	if r.dryRun {
+		return res
+	}
+
+	for _, s := range plan.Steps {
+		if err := ctx.Err(); err != nil {
+			res.Err = err
+			return res
+		}
+		if err := r.apply(ctx, plan.ACLID, s); err != nil {
+			res.Err = fmt.Errorf("Error applying step %q: %v", s, err)
+			return res
+		}
+		res.Done = append(res.Done, s)
+	}
+
+	return res
+}
+
+// Reconcile plans and applies the desired rules of the ACL list in one go
+func (r *Reconciler) Reconcile(ctx context.Context, aclid string, rules []Rule) (*Result, error) {
+	plan, err := r.Plan(aclid, rules)
+	if err != nil {
+		return nil, err
+	}
+	return r.Apply(ctx, plan), nil
+}
+
+func (r *Reconciler) apply(ctx context.Context, aclid string, s Step) error {
+	var jobid string
+
+	switch s.Kind {
+	case Create:
+		resp, err := r.create(aclid, s.Item, s.Number, s.Reason)
+		if err != nil {
+			return err
+		}
+		jobid = resp.JobID
+	case Update:
+		p := r.cs.NetworkACL.NewUpdateNetworkACLItemParams(s.Item.ID)
+		p.SetPartialupgrade(true)
+		p.SetNumber(s.Number)
+		p.SetReason(s.Reason)
+
+		resp, err := r.cs.NetworkACL.UpdateNetworkACLItem(p)
+		if err != nil {
+			return err
+		}
+		jobid = resp.JobID
+	case Delete:
+		resp, err := r.cs.NetworkACL.DeleteNetworkACL(r.cs.NetworkACL.NewDeleteNetworkACLParams(s.Item.ID))
+		if err != nil {
+			return err
+		}
+		jobid = resp.JobID
+	default:
+		return fmt.Errorf("Unknown step kind: %s", s.Kind)
+	}
+
+	return r.waiter.WaitForJob(ctx, jobid)
+}
+
+func (r *Reconciler) create(aclid string, i Item, number int, reason string) (*cloudstack.CreateNetworkACLResponse, error) {
+	p := r.cs.NetworkACL.NewCreateNetworkACLParams(i.Protocol)
+	p.SetAclid(aclid)
+	p.SetNumber(number)
+	p.SetAction(strings.Title(i.Action))
+	p.SetTraffictype(strings.Title(i.TrafficType))
+	p.SetCidrlist(i.CIDRs)
+	switch i.Protocol {
+	case "tcp", "udp":
+		p.SetStartport(i.StartPort)
+		p.SetEndport(i.EndPort)
+	case "icmp":
+		p.SetIcmptype(i.ICMPType)
+		p.SetIcmpcode(i.ICMPCode)
+	}
+	if reason != "" {
+		p.SetReason(reason)
+	}
+
+	return r.cs.NetworkACL.CreateNetworkACL(p)
+}
+
+// Swap creates a new ACL list with the desired rules in the VPC of the given
+// list, and then replaces the given list with the new list on every network
+// and private gateway using it. Each replacement is atomic, so a network goes
+// from the complete old rule set to the complete new rule set at once. The old
+// list is deleted afterwards if configured, and if it was replaced everywhere.
+// In a dry run nothing is created and the result lists the networks and
+// private gateways that would be switched.
+func (r *Reconciler) Swap(ctx context.Context, aclid, name string, rules []Rule) (*SwapResult, error) {
+	want, err := items(rules)
+	if err != nil {
+		return nil, err
+	}
+
+	old, _, err := r.cs.NetworkACL.GetNetworkACLListByID(aclid, r.opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	networks, gateways, err := r.users(old)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &SwapResult{OldACLID: aclid}
+	if r.dryRun {
+		res.Networks = networks
+		res.Gateways = gateways
+		return res, nil
+	}
+
+	newid, err := r.createList(ctx, old.Vpcid, name, old.Description, want)
+	if err != nil {
+		return nil, err
+	}
+	res.NewACLID = newid
+
+	for _, id := range networks {
+		p := r.cs.NetworkACL.NewReplaceNetworkACLListParams(newid)
+		p.SetNetworkid(id)
+		if err := r.replace(ctx, p); err != nil {
+			res.Errs = append(res.Errs, fmt.Errorf("Error replacing ACL list of network %s: %v", id, err))
+			continue
+		}
+		res.Networks = append(res.Networks, id)
+	}
+
+	for _, id := range gateways {
+		p := r.cs.NetworkACL.NewReplaceNetworkACLListParams(newid)
+		p.SetGatewayid(id)
+		if err := r.replace(ctx, p); err != nil {
+			res.Errs = append(res.Errs, fmt.Errorf("Error replacing ACL list of private gateway %s: %v", id, err))
+			continue
+		}
+		res.Gateways = append(res.Gateways, id)
+	}
+
+	if r.deleteReplaced && !res.Failed() {
+		resp, err := r.cs.NetworkACL.DeleteNetworkACLList(r.cs.NetworkACL.NewDeleteNetworkACLListParams(aclid))
+		if err == nil {
+			err = r.waiter.WaitForJob(ctx, resp.JobID)
+		}
+		if err != nil {
+			res.Errs = append(res.Errs, fmt.Errorf("Error deleting ACL list %s: %v", aclid, err))
+		} else {
+			res.DeletedOld = true
+		}
+	}
+
+	return res, nil
+}
+
+// users returns the networks and private gateways using the ACL list
+func (r *Reconciler) users(acl *cloudstack.NetworkACLList) ([]string, []string, error) {
+	np := r.cs.Network.NewListNetworksParams()
+	np.SetVpcid(acl.Vpcid)
+	np.SetListall(true)
+	if err := common.ApplyOptions(r.cs, np, r.opts...); err != nil {
+		return nil, nil, err
+	}
+
+	nl, err := r.cs.Network.ListNetworks(np)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var networks []string
+	for _, n := range nl.Networks {
+		if n.Aclid == acl.Id {
+			networks = append(networks, n.Id)
+		}
+	}
+
+	gp := r.cs.VPC.NewListPrivateGatewaysParams()
+	gp.SetVpcid(acl.Vpcid)
+	gp.SetListall(true)
+	if err := common.ApplyOptions(r.cs, gp, r.opts...); err != nil {
+		return nil, nil, err
+	}
+
+	gl, err := r.cs.VPC.ListPrivateGateways(gp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var gateways []string
+	for _, g := range gl.PrivateGateways {
+		if g.Aclid == acl.Id {
+			gateways = append(gateways, g.Id)
+		}
+	}
+
+	return networks, gateways, nil
+}
+
+// createList creates a new ACL list with the items. The list is deleted again
+// if one of the items cannot be created.
+func (r *Reconciler) createList(ctx context.Context, vpcid, name, description string, want []Item) (string, error) {
+	p := r.cs.NetworkACL.NewCreateNetworkACLListParams(name, vpcid)
+	if description != "" {
+		p.SetDescription(description)
+	}
+
+	resp, err := r.cs.NetworkACL.CreateNetworkACLList(p)
+	if err != nil {
+		return "", fmt.Errorf("Error creating ACL list %s: %v", name, err)
+	}
+
+	if err := r.waiter.WaitForJobInto(ctx, resp.JobID, resp); err != nil {
+		return "", fmt.Errorf("Error creating ACL list %s: %v", name, err)
+	}
+
+	for n, i := range want {
+		cr, err := r.create(resp.Id, i, (n+1)*r.step, i.Reason)
+		if err == nil {
+			err = r.waiter.WaitForJob(ctx, cr.JobID)
+		}
+		if err != nil {
+			err = fmt.Errorf("Error creating rule %d (%s) in ACL list %s: %v", n+1, i, name, err)
+
+			dr, derr := r.cs.NetworkACL.DeleteNetworkACLList(r.cs.NetworkACL.NewDeleteNetworkACLListParams(resp.Id))
+			if derr == nil {
+				derr = r.waiter.WaitForJob(ctx, dr.JobID)
+			}
+			if derr != nil {
+				err = fmt.Errorf("%v (error deleting ACL list %s: %v)", err, resp.Id, derr)
+			}
+			return "", err
+		}
+	}
+
+	return resp.Id, nil
+}
+
+func (r *Reconciler) replace(ctx context.Context, p *cloudstack.ReplaceNetworkACLListParams) error {
+	resp, err := r.cs.NetworkACL.ReplaceNetworkACLList(p)
+	if err != nil {
+		return err
+	}
+	return r.waiter.WaitForJob(ctx, resp.JobID)
+}
@@ -0,0 +1,326 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package networkacl
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/xanzy/go-cloudstack/v2/cloudstack"
+)
+
+func allow(port int) Rule {
+	return Rule{Action: "Allow", TrafficType: "Ingress", Protocol: "tcp", StartPort: port}
+}
+
+func deny(port int) Rule {
+	return Rule{Action: "Deny", TrafficType: "Ingress", Protocol: "tcp", StartPort: port}
+}
+
+func withReason(r Rule, reason string) Rule {
+	r.Reason = reason
+	return r
+}
+
+// live returns the rules as live items with the given numbers
+func live(t *testing.T, rules []Rule, numbers ...int) []Item {
+	t.Helper()
+
+	l, err := items(rules)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for n := range l {
+		l[n].ID = fmt.Sprintf("live-%d", n)
+		l[n].Number = numbers[n]
+	}
+	return l
+}
+
+// simulate applies the steps to the live items like CloudStack would, failing
+// if two items ever have the same number, and returns the resulting items
+// ordered by number
+func simulate(t *testing.T, l []Item, steps []Step) []Item {
+	t.Helper()
+
+	byID := make(map[string]Item)
+	for _, i := range l {
+		byID[i.ID] = i
+	}
+
+	for n, s := range steps {
+		switch s.Kind {
+		case Create:
+			i := s.Item
+			i.ID = fmt.Sprintf("new-%d", n)
+			i.Number = s.Number
+			byID[i.ID] = i
+		case Update:
+			i := byID[s.Item.ID]
+			i.Number = s.Number
+			i.Reason = s.Reason
+			byID[i.ID] = i
+		case Delete:
+			delete(byID, s.Item.ID)
+		}
+
+		numbers := make(map[int]bool)
+		for _, i := range byID {
+			if numbers[i.Number] {
+				t.Fatalf("Step %q results in two items with number %d", s, i.Number)
+			}
+			numbers[i.Number] = true
+		}
+	}
+
+	var result []Item
+	for _, i := range byID {
+		result = append(result, i)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Number < result[j].Number
+	})
+	return result
+}
+
+// phase returns the position of the step in the order documented on Plan
+func phase(s Step) int {
+	switch {
+	case s.Kind == Create && !s.Item.Allow():
+		return 1
+	case s.Kind == Delete && s.Item.Allow():
+		return 2
+	case s.Kind == Update:
+		return 3
+	case s.Kind == Create:
+		return 4
+	default:
+		return 5
+	}
+}
+
+func TestPlan(t *testing.T) {
+	cases := []struct {
+		name       string
+		live       []Rule
+		numbers    []int
+		want       []Rule
+		steps      []string
+		renumbered bool
+	}{
+		{
+			name:    "unchanged",
+			live:    []Rule{allow(22), allow(80)},
+			numbers: []int{10, 20},
+			want:    []Rule{allow(22), allow(80)},
+		},
+		{
+			name:    "insert between kept rules",
+			live:    []Rule{allow(22), allow(443)},
+			numbers: []int{10, 20},
+			want:    []Rule{allow(22), allow(80), allow(443)},
+			steps:   []string{"create #15 allow ingress tcp/80 0.0.0.0/0"},
+		},
+		{
+			name:    "insert before the first rule",
+			live:    []Rule{allow(22)},
+			numbers: []int{10},
+			want:    []Rule{allow(80), allow(22)},
+			steps:   []string{"create #5 allow ingress tcp/80 0.0.0.0/0"},
+		},
+		{
+			name:    "append after the last rule",
+			live:    []Rule{allow(22)},
+			numbers: []int{10},
+			want:    []Rule{allow(22), allow(80)},
+			steps:   []string{"create #20 allow ingress tcp/80 0.0.0.0/0"},
+		},
+		{
+			name:    "duplicate rules",
+			live:    []Rule{allow(22)},
+			numbers: []int{10},
+			want:    []Rule{allow(22), allow(22)},
+			steps:   []string{"create #20 allow ingress tcp/22 0.0.0.0/0"},
+		},
+		{
+			name:    "reorder",
+			live:    []Rule{allow(22), allow(80)},
+			numbers: []int{10, 20},
+			want:    []Rule{allow(80), allow(22)},
+			steps: []string{
+				"update #10 -> #40 allow ingress tcp/22 0.0.0.0/0",
+				"update #20 -> #30 allow ingress tcp/80 0.0.0.0/0",
+			},
+			renumbered: true,
+		},
+		{
+			name:    "no room to insert",
+			live:    []Rule{allow(22), allow(443)},
+			numbers: []int{1, 2},
+			want:    []Rule{allow(22), allow(80), allow(443)},
+			steps: []string{
+				"update #2 -> #30 allow ingress tcp/443 0.0.0.0/0",
+				"update #1 -> #10 allow ingress tcp/22 0.0.0.0/0",
+				"create #20 allow ingress tcp/80 0.0.0.0/0",
+			},
+			renumbered: true,
+		},
+		{
+			name:    "deny rules first, allow rules last",
+			live:    []Rule{allow(22), deny(23)},
+			numbers: []int{10, 20},
+			want:    []Rule{deny(3389), allow(80)},
+			steps: []string{
+				"create #11 deny ingress tcp/3389 0.0.0.0/0",
+				"delete #10 allow ingress tcp/22 0.0.0.0/0",
+				"create #21 allow ingress tcp/80 0.0.0.0/0",
+				"delete #20 deny ingress tcp/23 0.0.0.0/0",
+			},
+		},
+		{
+			name:    "replace and reorder",
+			live:    []Rule{deny(23), allow(22), allow(80)},
+			numbers: []int{10, 20, 30},
+			want:    []Rule{allow(80), deny(25), allow(22)},
+			steps: []string{
+				"create #50 deny ingress tcp/25 0.0.0.0/0",
+				"update #20 -> #60 allow ingress tcp/22 0.0.0.0/0",
+				"update #30 -> #40 allow ingress tcp/80 0.0.0.0/0",
+				"delete #10 deny ingress tcp/23 0.0.0.0/0",
+			},
+			renumbered: true,
+		},
+		{
+			name:    "changed reason",
+			live:    []Rule{withReason(allow(22), "old")},
+			numbers: []int{10},
+			want:    []Rule{withReason(allow(22), "new")},
+			steps:   []string{"update #10 -> #10 allow ingress tcp/22 0.0.0.0/0"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			want, err := items(c.want)
+			if err != nil {
+				t.Fatal(err)
+			}
+			l := live(t, c.live, c.numbers...)
+
+			plan := New(nil).plan("acl", want, l)
+
+			var steps []string
+			for _, s := range plan.Steps {
+				steps = append(steps, s.String())
+			}
+			if !reflect.DeepEqual(steps, c.steps) {
+				t.Fatalf("Expected steps:\n%q\ngot:\n%q", c.steps, steps)
+			}
+			if plan.Renumbered != c.renumbered {
+				t.Fatalf("Expected renumbered %t, got %t", c.renumbered, plan.Renumbered)
+			}
+
+			for n := 1; n < len(plan.Steps); n++ {
+				if phase(plan.Steps[n]) < phase(plan.Steps[n-1]) {
+					t.Fatalf("Step %q comes after step %q", plan.Steps[n], plan.Steps[n-1])
+				}
+			}
+
+			result := simulate(t, l, plan.Steps)
+			if len(result) != len(want) {
+				t.Fatalf("Expected %d items after applying the plan, got %d", len(want), len(result))
+			}
+			for n := range want {
+				if result[n].key() != want[n].key() || result[n].Reason != want[n].Reason {
+					t.Fatalf("Expected item %d to be %s (%q), got %s (%q)", n+1, want[n], want[n].Reason, result[n], result[n].Reason)
+				}
+			}
+		})
+	}
+}
+
+func TestPlanWithStep(t *testing.T) {
+	want, err := items([]Rule{allow(22), allow(80), allow(443)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	plan := New(nil, WithStep(100)).plan("acl", want, nil)
+
+	var numbers []int
+	for _, s := range plan.Steps {
+		numbers = append(numbers, s.Number)
+	}
+	if !reflect.DeepEqual(numbers, []int{100, 200, 300}) {
+		t.Fatalf("Expected numbers [100 200 300], got %v", numbers)
+	}
+}
+
+func TestLiveItemNormalization(t *testing.T) {
+	i, err := liveItem(&cloudstack.NetworkACL{
+		Id:          "live",
+		Number:      10,
+		Action:      "Allow",
+		Traffictype: "Ingress",
+		Protocol:    "6",
+		Startport:   "22",
+		Endport:     "22",
+		Cidrlist:    "10.1.2.3/8, 1.2.3.4",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want, err := items([]Rule{{
+		Action:      "allow",
+		TrafficType: "ingress",
+		Protocol:    "TCP",
+		StartPort:   22,
+		CIDRs:       []string{"1.2.3.4/32", "10.0.0.0/8"},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if plan := New(nil).plan("acl", want, []Item{i}); !plan.Empty() {
+		t.Fatalf("Expected an empty plan, got: %v", plan.Steps)
+	}
+}
+
+func TestItemsValidation(t *testing.T) {
+	cases := []struct {
+		name string
+		rule Rule
+	}{
+		{"invalid action", Rule{Action: "Drop", TrafficType: "Ingress", Protocol: "all"}},
+		{"invalid traffic type", Rule{Action: "Allow", TrafficType: "Inbound", Protocol: "all"}},
+		{"missing port", Rule{Action: "Allow", TrafficType: "Ingress", Protocol: "tcp"}},
+		{"reversed ports", Rule{Action: "Allow", TrafficType: "Ingress", Protocol: "udp", StartPort: 90, EndPort: 80}},
+		{"invalid CIDR", Rule{Action: "Allow", TrafficType: "Ingress", Protocol: "all", CIDRs: []string{"10.0.0.0/33"}}},
+	}
+
+	for _, c := range cases {
+		if _, err := items([]Rule{c.rule}); err == nil {
+			t.Errorf("%s: expected an error", c.name)
+		}
+	}
+}
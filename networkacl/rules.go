@@ -0,0 +1,226 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package networkacl
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/xanzy/go-cloudstack/v2/cloudstack"
+	"github.com/xanzy/go-cloudstack/v2/internal/common"
+)
+
+// Rule is a desired rule of an ACL list. The rules of a list are ordered and
+// the first rule matching a packet decides whether it is allowed or denied.
+type Rule struct {
+	Action      string   `json:"action" yaml:"action"`           // Allow or Deny
+	TrafficType string   `json:"traffictype" yaml:"traffictype"` // Ingress or Egress
+	Protocol    string   `json:"protocol" yaml:"protocol"`       // tcp, udp, icmp, all or a protocol number
+	StartPort   int      `json:"startport,omitempty" yaml:"startport,omitempty"`
+	EndPort     int      `json:"endport,omitempty" yaml:"endport,omitempty"`   // Defaults to the start port
+	ICMPType    *int     `json:"icmptype,omitempty" yaml:"icmptype,omitempty"` // Defaults to any (-1)
+	ICMPCode    *int     `json:"icmpcode,omitempty" yaml:"icmpcode,omitempty"` // Defaults to any (-1)
+	CIDRs       []string `json:"cidrs,omitempty" yaml:"cidrs,omitempty"`       // Defaults to 0.0.0.0/0
+	Reason      string   `json:"reason,omitempty" yaml:"reason,omitempty"`
+}
+
+// Item is a normalized rule, either desired or live. Items are compared after
+// normalizing them, so e.g. "10.1.2.3/8" equals "10.0.0.0/8" and protocol "6"
+// equals "tcp". The number and reason are not part of the comparison.
+type Item struct {
+	ID          string // Only set for live items
+	Number      int
+	Action      string
+	TrafficType string
+	Protocol    string
+	StartPort   int
+	EndPort     int
+	ICMPType    int
+	ICMPCode    int
+	CIDRs       []string
+	Reason      string
+}
+
+// Allow returns true if the item allows traffic
+func (i Item) Allow() bool {
+	return i.Action == "allow"
+}
+
+// key returns a string identifying the item, ignoring its ID, number and reason
+func (i Item) key() string {
+	return fmt.Sprintf("%s|%s|%s|%d|%d|%d|%d|%s", i.Action, i.TrafficType, i.Protocol,
+		i.StartPort, i.EndPort, i.ICMPType, i.ICMPCode, strings.Join(i.CIDRs, ","))
+}
+
+func (i Item) String() string {
+	var s string
+	switch i.Protocol {
+	case "tcp", "udp":
+		if i.StartPort == i.EndPort {
+			s = fmt.Sprintf("%s %s %s/%d", i.Action, i.TrafficType, i.Protocol, i.StartPort)
+		} else {
+			s = fmt.Sprintf("%s %s %s/%d-%d", i.Action, i.TrafficType, i.Protocol, i.StartPort, i.EndPort)
+		}
+	case "icmp":
+		s = fmt.Sprintf("%s %s icmp type %d code %d", i.Action, i.TrafficType, i.ICMPType, i.ICMPCode)
+	default:
+		s = fmt.Sprintf("%s %s %s", i.Action, i.TrafficType, i.Protocol)
+	}
+	return fmt.Sprintf("%s %s", s, strings.Join(i.CIDRs, ","))
+}
+
+// Rule returns the item as a desired rule, for example to export a live ACL
+// list. Fields that do not apply to the protocol of the item are left empty.
+func (i Item) Rule() Rule {
+	r := Rule{
+		Action:      i.Action,
+		TrafficType: i.TrafficType,
+		Protocol:    i.Protocol,
+		CIDRs:       i.CIDRs,
+		Reason:      i.Reason,
+	}
+
+	switch i.Protocol {
+	case "tcp", "udp":
+		r.StartPort = i.StartPort
+		if i.EndPort != i.StartPort {
+			r.EndPort = i.EndPort
+		}
+	case "icmp":
+		if i.ICMPType != -1 {
+			t := i.ICMPType
+			r.ICMPType = &t
+		}
+		if i.ICMPCode != -1 {
+			c := i.ICMPCode
+			r.ICMPCode = &c
+		}
+	}
+
+	return r
+}
+
+// normalize cleans up the item, so it can be compared with other items
+func (i Item) normalize() (Item, error) {
+	i.Action = strings.ToLower(strings.TrimSpace(i.Action))
+	i.TrafficType = strings.ToLower(strings.TrimSpace(i.TrafficType))
+	i.Protocol = common.NormalizeProtocol(i.Protocol)
+
+	switch i.Action {
+	case "allow", "deny":
+	default:
+		return i, fmt.Errorf("Invalid action %q, must be Allow or Deny", i.Action)
+	}
+
+	switch i.TrafficType {
+	case "ingress", "egress":
+	default:
+		return i, fmt.Errorf("Invalid traffic type %q, must be Ingress or Egress", i.TrafficType)
+	}
+
+	switch i.Protocol {
+	case "tcp", "udp":
+		if i.EndPort == 0 {
+			i.EndPort = i.StartPort
+		}
+		i.ICMPType, i.ICMPCode = 0, 0
+	case "icmp":
+		i.StartPort, i.EndPort = 0, 0
+	default:
+		i.StartPort, i.EndPort = 0, 0
+		i.ICMPType, i.ICMPCode = 0, 0
+	}
+
+	cidrs, err := common.NormalizeCIDRs(i.CIDRs, "0.0.0.0/0")
+	if err != nil {
+		return i, err
+	}
+	i.CIDRs = cidrs
+
+	return i, nil
+}
+
+// Validate checks the desired rules without making any API calls
+func Validate(rules []Rule) error {
+	_, err := items(rules)
+	return err
+}
+
+// items normalizes and validates the desired rules
+func items(rules []Rule) ([]Item, error) {
+	var result []Item
+
+	for n, r := range rules {
+		i := Item{
+			Action:      r.Action,
+			TrafficType: r.TrafficType,
+			Protocol:    r.Protocol,
+			StartPort:   r.StartPort,
+			EndPort:     r.EndPort,
+			ICMPType:    -1,
+			ICMPCode:    -1,
+			CIDRs:       r.CIDRs,
+			Reason:      r.Reason,
+		}
+		if r.ICMPType != nil {
+			i.ICMPType = *r.ICMPType
+		}
+		if r.ICMPCode != nil {
+			i.ICMPCode = *r.ICMPCode
+		}
+
+		i, err := i.normalize()
+		if err != nil {
+			return nil, fmt.Errorf("Error in rule %d: %v", n+1, err)
+		}
+
+		switch i.Protocol {
+		case "tcp", "udp":
+			if i.StartPort < 1 || i.EndPort > 65535 || i.StartPort > i.EndPort {
+				return nil, fmt.Errorf("Error in rule %d: invalid port range %d-%d", n+1, i.StartPort, i.EndPort)
+			}
+		}
+
+		result = append(result, i)
+	}
+
+	return result, nil
+}
+
+// liveItem normalizes a live ACL item. CloudStack returns the ports as strings
+// and the CIDRs as a comma separated list.
+func liveItem(acl *cloudstack.NetworkACL) (Item, error) {
+	i := Item{
+		ID:          acl.Id,
+		Number:      acl.Number,
+		Action:      acl.Action,
+		TrafficType: acl.Traffictype,
+		Protocol:    acl.Protocol,
+		ICMPType:    acl.Icmptype,
+		ICMPCode:    acl.Icmpcode,
+		CIDRs:       common.SplitCIDRs(acl.Cidrlist),
+		Reason:      acl.Reason,
+	}
+	i.StartPort, _ = strconv.Atoi(acl.Startport)
+	i.EndPort, _ = strconv.Atoi(acl.Endport)
+
+	return i.normalize()
+}
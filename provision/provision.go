@@ -0,0 +1,547 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+// Package provision deploys virtual machines (including their data volumes, tags
+// and static NAT) from a declarative spec, and rolls back all created resources
+// when any of the steps fail.
+package provision
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/xanzy/go-cloudstack/v2/cloudstack"
+	"github.com/xanzy/go-cloudstack/v2/internal/common"
+	"github.com/xanzy/go-cloudstack/v2/waiter"
+)
+
+// DefaultTimeout is the default time to wait for each async job and for the
+// virtual machine to become Running
+const DefaultTimeout = 10 * time.Minute
+
+// VMSpec describes a virtual machine and the resources that should be created
+// with it. All references (zone, offerings, template and networks) can be either
+// a name or an ID.
+type VMSpec struct {
+	Name            string
+	DisplayName     string
+	Zone            string
+	ServiceOffering string
+	Template        string
+	TemplateFilter  string // The filter used to find the template; defaults to "executable"
+	Networks        []NetworkSpec
+	SSHKeyPair      string
+	Userdata        string // The base64 encoded userdata
+	DataVolumes     []VolumeSpec
+	Tags            map[string]string
+
+	// StaticNAT acquires a public IP address in the first network and
+	// enables static NAT from that address to the virtual machine
+	StaticNAT bool
+}
+
+// NetworkSpec describes a network the virtual machine should be connected to
+type NetworkSpec struct {
+	Network string // The name or ID of the network
+	IP      string // An optional fixed IPv4 address
+	IPv6    string // An optional fixed IPv6 address
+}
+
+// VolumeSpec describes a data volume that should be created and attached
+type VolumeSpec struct {
+	Name         string
+	DiskOffering string // The name or ID of the disk offering
+	Size         int64  // The size in GB, only used with custom disk offerings
+}
+
+// Result contains the resources created for a virtual machine
+type Result struct {
+	VirtualMachine *cloudstack.VirtualMachine
+	VolumeIDs      []string
+	PublicIPID     string
+	PublicIP       string
+}
+
+// Error is returned when provisioning a virtual machine failed
+type Error struct {
+	Step         string  // The step that failed
+	Err          error   // The error returned by the failed step
+	RollbackErrs []error // Errors returned while rolling back, meaning resources may have leaked
+}
+
+func (e *Error) Error() string {
+	msg := fmt.Sprintf("Failed to %s: %v", e.Step, e.Err)
+	if len(e.RollbackErrs) > 0 {
+		var errs []string
+		for _, err := range e.RollbackErrs {
+			errs = append(errs, err.Error())
+		}
+		msg += fmt.Sprintf(" (rollback failed: %s)", strings.Join(errs, "; "))
+	}
+	return msg
+}
+
+// Unwrap returns the error returned by the failed step
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Option can be passed to New to set custom options
+type Option func(*Provisioner)
+
+// WithTimeout sets the time to wait for each async job and for the virtual machine to become Running
+func WithTimeout(timeout time.Duration) Option {
+	return func(p *Provisioner) {
+		if timeout != 0 {
+			p.timeout = timeout
+		}
+	}
+}
+
+// WithExpunge sets if virtual machines that are destroyed while rolling back are
+// expunged immediately. By default they are only destroyed, so they can still
+// be recovered until CloudStack expunges them.
+func WithExpunge(expunge bool) Option {
+	return func(p *Provisioner) {
+		p.expunge = expunge
+	}
+}
+
+// WithOptions sets option functions (e.g. cloudstack.WithProject) that are
+// applied to all lookups and API calls made by the provisioner
+func WithOptions(opts ...cloudstack.OptionFunc) Option {
+	return func(p *Provisioner) {
+		p.opts = append(p.opts, opts...)
+	}
+}
+
+// Provisioner deploys virtual machines from a VMSpec
+type Provisioner struct {
+	cs      *cloudstack.CloudStackClient
+	timeout time.Duration
+	expunge bool
+	opts    []cloudstack.OptionFunc
+	waiter  *waiter.Waiter
+}
+
+// New returns a new provisioner using the given client
+func New(cs *cloudstack.CloudStackClient, options ...Option) *Provisioner {
+	p := &Provisioner{
+		cs:      cs,
+		timeout: DefaultTimeout,
+	}
+
+	for _, fn := range options {
+		fn(p)
+	}
+	p.waiter = waiter.New(cs, waiter.WithTimeout(p.timeout), waiter.WithOptions(p.opts...))
+
+	return p
+}
+
+// run keeps track of a single provisioning run, so it can be rolled back
+type run struct {
+	*Provisioner
+
+	spec   *VMSpec
+	result *Result
+	undo   []func() error
+
+	zoneID          string
+	serviceOffering string
+	template        string
+	networks        []string
+	diskOfferings   []string
+}
+
+// Provision resolves all references in the spec, deploys the virtual machine,
+// waits until it is Running and then creates and attaches the data volumes,
+// the tags and the static NAT. If any of these steps fail, all resources that
+// were created until then are removed again and an *Error is returned. When
+// using an async client, a virtual machine whose deploy job failed can only be
+// found and removed if the spec has a name.
+func (p *Provisioner) Provision(spec *VMSpec) (*Result, error) {
+	r := &run{Provisioner: p, spec: spec, result: &Result{}}
+
+	steps := []struct {
+		name string
+		fn   func() error
+	}{
+		{"resolve references", r.resolve},
+		{"deploy virtual machine", r.deploy},
+		{"create data volumes", r.createVolumes},
+		{"create tags", r.createTags},
+		{"enable static NAT", r.enableStaticNAT},
+	}
+
+	for _, step := range steps {
+		if err := step.fn(); err != nil {
+			return nil, &Error{Step: step.name, Err: err, RollbackErrs: r.rollback()}
+		}
+	}
+
+	// Refresh the virtual machine so the result contains all changes
+	vm, _, err := p.cs.VirtualMachine.GetVirtualMachineByID(r.result.VirtualMachine.Id, p.opts...)
+	if err == nil {
+		r.result.VirtualMachine = vm
+	}
+
+	return r.result, nil
+}
+
+func (r *run) rollback() []error {
+	var errs []error
+	for i := len(r.undo) - 1; i >= 0; i-- {
+		if err := r.undo[i](); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+func (r *run) resolve() error {
+	var err error
+
+	if r.zoneID, err = r.lookup(r.spec.Zone, func(name string) (string, int, error) {
+		return r.cs.Zone.GetZoneID(name, r.opts...)
+	}); err != nil {
+		return err
+	}
+
+	// Resources are looked up in the zone of the virtual machine
+	opts := append([]cloudstack.OptionFunc{cloudstack.WithZone(r.zoneID)}, r.opts...)
+
+	if r.serviceOffering, err = r.lookup(r.spec.ServiceOffering, func(name string) (string, int, error) {
+		return r.cs.ServiceOffering.GetServiceOfferingID(name, opts...)
+	}); err != nil {
+		return err
+	}
+
+	filter := r.spec.TemplateFilter
+	if filter == "" {
+		filter = "executable"
+	}
+	if r.template, err = r.lookup(r.spec.Template, func(name string) (string, int, error) {
+		return r.cs.Template.GetTemplateID(name, filter, r.zoneID, r.opts...)
+	}); err != nil {
+		return err
+	}
+
+	for _, n := range r.spec.Networks {
+		id, err := r.lookup(n.Network, func(name string) (string, int, error) {
+			return r.cs.Network.GetNetworkID(name, opts...)
+		})
+		if err != nil {
+			return err
+		}
+		r.networks = append(r.networks, id)
+	}
+
+	for _, v := range r.spec.DataVolumes {
+		id, err := r.lookup(v.DiskOffering, func(name string) (string, int, error) {
+			return r.cs.DiskOffering.GetDiskOfferingID(name, opts...)
+		})
+		if err != nil {
+			return err
+		}
+		r.diskOfferings = append(r.diskOfferings, id)
+	}
+
+	if r.spec.StaticNAT && len(r.networks) == 0 {
+		return fmt.Errorf("Static NAT requires at least one network")
+	}
+
+	return nil
+}
+
+// lookup returns the ID of a name by calling fn, unless the name already is an ID
+func (r *run) lookup(name string, fn func(string) (string, int, error)) (string, error) {
+	if name == "" || cloudstack.IsID(name) {
+		return name, nil
+	}
+	id, _, err := fn(name)
+	return id, err
+}
+
+func (r *run) deploy() error {
+	p := r.cs.VirtualMachine.NewDeployVirtualMachineParams(r.serviceOffering, r.template, r.zoneID)
+	if r.spec.Name != "" {
+		p.SetName(r.spec.Name)
+	}
+	if r.spec.DisplayName != "" {
+		p.SetDisplayname(r.spec.DisplayName)
+	}
+	if r.spec.SSHKeyPair != "" {
+		p.SetKeypair(r.spec.SSHKeyPair)
+	}
+	if r.spec.Userdata != "" {
+		p.SetUserdata(r.spec.Userdata)
+	}
+	if err := r.setNetworks(p); err != nil {
+		return err
+	}
+	if err := common.ApplyOptions(r.cs, p, r.opts...); err != nil {
+		return err
+	}
+
+	// The virtual machine is allocated before the deploy job runs, so it has
+	// to be destroyed even if the job fails. An async client only returns its
+	// ID if the job times out, so to find it after a failed job remember the
+	// virtual machines that already have the same name.
+	existing, err := r.virtualMachinesNamed(r.spec.Name)
+	if err != nil {
+		return err
+	}
+
+	vm, err := r.cs.VirtualMachine.DeployVirtualMachine(p)
+	switch {
+	case vm != nil && vm.Id != "":
+		id := vm.Id
+		r.undo = append(r.undo, func() error {
+			return r.destroyVirtualMachine(id)
+		})
+	case err != nil && existing != nil:
+		if created, lerr := r.virtualMachinesNamed(r.spec.Name); lerr == nil {
+			for id := range created {
+				if existing[id] {
+					continue
+				}
+				id := id
+				r.undo = append(r.undo, func() error {
+					return r.destroyVirtualMachine(id)
+				})
+			}
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := r.waiter.WaitForJob(context.Background(), vm.JobID); err != nil {
+		return err
+	}
+
+	return r.waitForRunning(vm.Id)
+}
+
+// virtualMachinesNamed returns the IDs of the virtual machines in the zone
+// with the given name, or nil if the name is empty
+func (r *run) virtualMachinesNamed(name string) (map[string]bool, error) {
+	if name == "" {
+		return nil, nil
+	}
+
+	p := r.cs.VirtualMachine.NewListVirtualMachinesParams()
+	p.SetName(name)
+	p.SetZoneid(r.zoneID)
+	p.SetListall(true)
+	if err := common.ApplyOptions(r.cs, p, r.opts...); err != nil {
+		return nil, err
+	}
+
+	l, err := r.cs.VirtualMachine.ListVirtualMachines(p)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list virtual machines named %s: %v", name, err)
+	}
+
+	ids := make(map[string]bool)
+	for _, vm := range l.VirtualMachines {
+		// The name filter also matches names containing the name
+		if vm.Name == name {
+			ids[vm.Id] = true
+		}
+	}
+	return ids, nil
+}
+
+func (r *run) setNetworks(p *cloudstack.DeployVirtualMachineParams) error {
+	fixed := false
+	for _, n := range r.spec.Networks {
+		if n.IP != "" || n.IPv6 != "" {
+			fixed = true
+		}
+	}
+
+	if !fixed {
+		if len(r.networks) > 0 {
+			p.SetNetworkids(r.networks)
+		}
+		return nil
+	}
+
+	var l []cloudstack.IPToNetwork
+	for i, n := range r.spec.Networks {
+		l = append(l, cloudstack.IPToNetwork{NetworkID: r.networks[i], IP: n.IP, IPv6: n.IPv6})
+	}
+	p.SetIptonetworklist(l)
+
+	return nil
+}
+
+func (r *run) destroyVirtualMachine(id string) error {
+	p := r.cs.VirtualMachine.NewDestroyVirtualMachineParams(id)
+	if r.expunge {
+		p.SetExpunge(true)
+	}
+
+	resp, err := r.cs.VirtualMachine.DestroyVirtualMachine(p)
+	if err != nil {
+		return fmt.Errorf("Failed to destroy virtual machine %s: %v", id, err)
+	}
+	return r.waiter.WaitForJob(context.Background(), resp.JobID)
+}
+
+func (r *run) waitForRunning(id string) error {
+	vm, err := r.waiter.WaitForVirtualMachineState(context.Background(), id, waiter.Running)
+	if err != nil {
+		return err
+	}
+	r.result.VirtualMachine = vm
+
+	return nil
+}
+
+func (r *run) createVolumes() error {
+	vmID := r.result.VirtualMachine.Id
+
+	for i, v := range r.spec.DataVolumes {
+		p := r.cs.Volume.NewCreateVolumeParams()
+		p.SetZoneid(r.zoneID)
+		p.SetDiskofferingid(r.diskOfferings[i])
+		if v.Name != "" {
+			p.SetName(v.Name)
+		}
+		if v.Size > 0 {
+			p.SetSize(v.Size)
+		}
+		if err := common.ApplyOptions(r.cs, p, r.opts...); err != nil {
+			return err
+		}
+
+		vol, err := r.cs.Volume.CreateVolume(p)
+		if err != nil {
+			return err
+		}
+
+		volID := vol.Id
+		if volID != "" {
+			r.undo = append(r.undo, func() error {
+				return r.deleteVolume(volID)
+			})
+		}
+		if err := r.waiter.WaitForJob(context.Background(), vol.JobID); err != nil {
+			return err
+		}
+		r.result.VolumeIDs = append(r.result.VolumeIDs, volID)
+
+		resp, err := r.cs.Volume.AttachVolume(r.cs.Volume.NewAttachVolumeParams(volID, vmID))
+		if err != nil {
+			return err
+		}
+		if err := r.waiter.WaitForJob(context.Background(), resp.JobID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *run) deleteVolume(id string) error {
+	// The volume might not be attached (yet), so ignore any errors here
+	p := r.cs.Volume.NewDetachVolumeParams()
+	p.SetId(id)
+	if resp, err := r.cs.Volume.DetachVolume(p); err == nil {
+		r.waiter.WaitForJob(context.Background(), resp.JobID)
+	}
+
+	if _, err := r.cs.Volume.DeleteVolume(r.cs.Volume.NewDeleteVolumeParams(id)); err != nil {
+		return fmt.Errorf("Failed to delete volume %s: %v", id, err)
+	}
+	return nil
+}
+
+func (r *run) createTags() error {
+	if len(r.spec.Tags) == 0 {
+		return nil
+	}
+
+	p := r.cs.Resourcetags.NewCreateTagsParams([]string{r.result.VirtualMachine.Id}, "UserVm", r.spec.Tags)
+	if err := common.ApplyOptions(r.cs, p, r.opts...); err != nil {
+		return err
+	}
+
+	resp, err := r.cs.Resourcetags.CreateTags(p)
+	if err != nil {
+		return err
+	}
+	return r.waiter.WaitForJob(context.Background(), resp.JobID)
+}
+
+func (r *run) enableStaticNAT() error {
+	if !r.spec.StaticNAT {
+		return nil
+	}
+
+	p := r.cs.Address.NewAssociateIpAddressParams()
+	p.SetNetworkid(r.networks[0])
+	if err := common.ApplyOptions(r.cs, p, r.opts...); err != nil {
+		return err
+	}
+
+	ip, err := r.cs.Address.AssociateIpAddress(p)
+	if err != nil {
+		return err
+	}
+
+	ipID := ip.Id
+	if ipID != "" {
+		r.undo = append(r.undo, func() error {
+			resp, err := r.cs.Address.DisassociateIpAddress(r.cs.Address.NewDisassociateIpAddressParams(ipID))
+			if err != nil {
+				return fmt.Errorf("Failed to release public IP address %s: %v", ipID, err)
+			}
+			return r.waiter.WaitForJob(context.Background(), resp.JobID)
+		})
+	}
+	if err := r.waiter.WaitForJob(context.Background(), ip.JobID); err != nil {
+		return err
+	}
+
+	nat := r.cs.NAT.NewEnableStaticNatParams(ipID, r.result.VirtualMachine.Id)
+	nat.SetNetworkid(r.networks[0])
+	if _, err := r.cs.NAT.EnableStaticNat(nat); err != nil {
+		return err
+	}
+	r.undo = append(r.undo, func() error {
+		resp, err := r.cs.NAT.DisableStaticNat(r.cs.NAT.NewDisableStaticNatParams(ipID))
+		if err != nil {
+			return fmt.Errorf("Failed to disable static NAT for %s: %v", ipID, err)
+		}
+		return r.waiter.WaitForJob(context.Background(), resp.JobID)
+	})
+
+	r.result.PublicIPID = ipID
+	if addr, _, err := r.cs.Address.GetPublicIpAddressByID(ipID, r.opts...); err == nil {
+		r.result.PublicIP = addr.Ipaddress
+	}
+
+	return nil
+}
@@ -0,0 +1,152 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package provision
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/xanzy/go-cloudstack/v2/cloudstack"
+)
+
+const (
+	zoneID     = "10000000-0000-0000-0000-000000000000"
+	offeringID = "20000000-0000-0000-0000-000000000000"
+	templateID = "30000000-0000-0000-0000-000000000000"
+)
+
+// newDeployServer returns a server on which a virtual machine named web already
+// exists, and on which deploying allocates virtual machine vm-new but fails
+// its deploy job. It returns the IDs of the destroyed virtual machines, with a
+// suffix for the ones that were expunged.
+func newDeployServer(t *testing.T) (*httptest.Server, func() []string) {
+	var mu sync.Mutex
+	var deployed bool
+	var destroyed []string
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		// Deploy requests are sent as a POST, as they may contain large userdata
+		r.ParseForm()
+		q := r.Form
+		switch q.Get("command") {
+		case "listVirtualMachines":
+			// The name filter also matches web-2
+			vms := []string{`{"id":"vm-old","name":"web"}`, `{"id":"vm-other","name":"web-2"}`}
+			if deployed {
+				vms = append(vms, `{"id":"vm-new","name":"web"}`)
+			}
+			fmt.Fprintf(w, `{"listvirtualmachinesresponse":{"count":%d,"virtualmachine":[%s]}}`, len(vms), strings.Join(vms, ","))
+		case "deployVirtualMachine":
+			deployed = true
+			w.Write([]byte(`{"deployvirtualmachineresponse":{"id":"vm-new","jobid":"deploy"}}`))
+		case "destroyVirtualMachine":
+			id := q.Get("id")
+			if q.Get("expunge") == "true" {
+				id += " (expunged)"
+			}
+			destroyed = append(destroyed, id)
+			fmt.Fprintf(w, `{"destroyvirtualmachineresponse":{"jobid":"destroy-%s"}}`, q.Get("id"))
+		case "queryAsyncJobResult":
+			if q.Get("jobid") == "deploy" {
+				w.Write([]byte(`{"queryasyncjobresultresponse":{"jobstatus":2,"jobresulttype":"text","jobresult":"Insufficient capacity"}}`))
+				return
+			}
+			id := strings.TrimPrefix(q.Get("jobid"), "destroy-")
+			fmt.Fprintf(w, `{"queryasyncjobresultresponse":{"jobstatus":1,"jobresult":{"virtualmachine":{"id":"%s"}}}}`, id)
+		default:
+			t.Errorf("Unexpected command: %s", q.Get("command"))
+		}
+	}))
+
+	return srv, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string(nil), destroyed...)
+	}
+}
+
+func TestProvisionRollsBackFailedDeploy(t *testing.T) {
+	syncClient := func(url string) *cloudstack.CloudStackClient {
+		return cloudstack.NewClient(url, "key", "secret", false)
+	}
+	// An async client does not return the ID of a virtual machine whose
+	// deploy job failed, so it is found by its name
+	asyncClient := func(url string) *cloudstack.CloudStackClient {
+		return cloudstack.NewAsyncClient(url, "key", "secret", false)
+	}
+
+	cases := []struct {
+		name    string
+		client  func(url string) *cloudstack.CloudStackClient
+		options []Option
+		want    string
+	}{
+		{"sync", syncClient, nil, "vm-new"},
+		{"async", asyncClient, nil, "vm-new"},
+		{"expunge", syncClient, []Option{WithExpunge(true)}, "vm-new (expunged)"},
+	}
+
+	for _, c := range cases {
+		srv, destroyed := newDeployServer(t)
+
+		_, err := New(c.client(srv.URL), c.options...).Provision(&VMSpec{
+			Name:            "web",
+			Zone:            zoneID,
+			ServiceOffering: offeringID,
+			Template:        templateID,
+		})
+		srv.Close()
+
+		var perr *Error
+		if !errors.As(err, &perr) || perr.Step != "deploy virtual machine" {
+			t.Fatalf("%s: expected the deploy step to fail, got: %v", c.name, err)
+		}
+		if len(perr.RollbackErrs) > 0 {
+			t.Fatalf("%s: unexpected rollback errors: %v", c.name, perr.RollbackErrs)
+		}
+		if got := destroyed(); !reflect.DeepEqual(got, []string{c.want}) {
+			t.Fatalf("%s: expected only %s to be destroyed, got %v", c.name, c.want, got)
+		}
+	}
+}
+
+func TestError(t *testing.T) {
+	err := &Error{
+		Step:         "create tags",
+		Err:          errors.New("failed"),
+		RollbackErrs: []error{errors.New("a"), errors.New("b")},
+	}
+
+	if got := err.Error(); got != "Failed to create tags: failed (rollback failed: a; b)" {
+		t.Fatalf("Unexpected error message: %s", got)
+	}
+	if !errors.Is(err, err.Err) {
+		t.Fatal("Expected the error to unwrap to the error of the step")
+	}
+}
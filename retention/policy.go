@@ -0,0 +1,172 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package retention
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Status is the status of a snapshot, as far as retention is concerned
+type Status int
+
+const (
+	// Good snapshots can be restored and count towards the policy
+	Good Status = iota
+
+	// Pending snapshots are still being created or removed and are never touched
+	Pending
+
+	// Failed snapshots cannot be restored and always expire
+	Failed
+)
+
+// Item is a single (volume or VM) snapshot
+type Item struct {
+	ID      string
+	Name    string
+	Created time.Time
+	State   string
+	Status  Status
+}
+
+// Decision is the result of evaluating a policy for a single snapshot
+type Decision struct {
+	Item
+	Keep    bool
+	Reasons []string // Why the snapshot is kept (e.g. "daily") or why it expires
+}
+
+// Policy is a grandfather-father-son retention policy. Each field is the number
+// of periods for which the newest good snapshot in that period is kept.
+type Policy struct {
+	Hourly  int
+	Daily   int
+	Weekly  int
+	Monthly int
+	Yearly  int
+
+	// Location is used to determine the boundaries of the periods (default: UTC)
+	Location *time.Location
+}
+
+func (p Policy) String() string {
+	return fmt.Sprintf("%d hourly, %d daily, %d weekly, %d monthly, %d yearly",
+		p.Hourly, p.Daily, p.Weekly, p.Monthly, p.Yearly)
+}
+
+// period returns a key that is the same for all times in the same period
+type period func(t time.Time) string
+
+func (p Policy) periods() []struct {
+	name  string
+	count int
+	key   period
+} {
+	return []struct {
+		name  string
+		count int
+		key   period
+	}{
+		{"hourly", p.Hourly, func(t time.Time) string { return t.Format("2006-01-02T15") }},
+		{"daily", p.Daily, func(t time.Time) string { return t.Format("2006-01-02") }},
+		{"weekly", p.Weekly, func(t time.Time) string {
+			y, w := t.ISOWeek()
+			return fmt.Sprintf("%d-W%02d", y, w)
+		}},
+		{"monthly", p.Monthly, func(t time.Time) string { return t.Format("2006-01") }},
+		{"yearly", p.Yearly, func(t time.Time) string { return t.Format("2006") }},
+	}
+}
+
+// Evaluate decides which snapshots to keep, returning the decisions ordered
+// from newest to oldest. Apart from the snapshots selected by the policy, the
+// newest good snapshot, pending snapshots and snapshots without a known creation
+// time are always kept, unless they failed.
+func (p Policy) Evaluate(items []Item) []Decision {
+	loc := p.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	decisions := make([]Decision, len(items))
+	for i, item := range items {
+		decisions[i] = Decision{Item: item}
+	}
+	sort.SliceStable(decisions, func(i, j int) bool {
+		return decisions[i].Created.After(decisions[j].Created)
+	})
+
+	keep := func(d *Decision, reason string) {
+		d.Keep = true
+		d.Reasons = append(d.Reasons, reason)
+	}
+
+	lastGood := -1
+	for i := range decisions {
+		d := &decisions[i]
+		switch {
+		case d.Status == Pending:
+			keep(d, "pending")
+		case d.Status == Failed:
+			// Failed snapshots expire, even without a creation time
+		case d.Created.IsZero():
+			keep(d, "unknown creation time")
+		case d.Status == Good && lastGood == -1:
+			lastGood = i
+			keep(d, "last good")
+		}
+	}
+
+	for _, per := range p.periods() {
+		seen := make(map[string]bool)
+		for i := range decisions {
+			d := &decisions[i]
+			if len(seen) >= per.count {
+				break
+			}
+			if d.Status != Good || d.Created.IsZero() {
+				continue
+			}
+
+			key := per.key(d.Created.In(loc))
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			keep(d, per.name)
+		}
+	}
+
+	for i := range decisions {
+		d := &decisions[i]
+		if d.Keep {
+			continue
+		}
+		if d.Status == Failed {
+			d.Reasons = append(d.Reasons, "failed")
+		} else {
+			d.Reasons = append(d.Reasons, "expired")
+		}
+	}
+
+	return decisions
+}
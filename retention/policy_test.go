@@ -0,0 +1,249 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package retention
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+// item returns a good snapshot created at the given RFC 3339 time
+func item(name, created string) Item {
+	t, err := time.Parse(time.RFC3339, created)
+	if err != nil {
+		panic(err)
+	}
+	return Item{ID: name, Name: name, Created: t}
+}
+
+func withStatus(i Item, s Status) Item {
+	i.Status = s
+	return i
+}
+
+// daily returns a good snapshot at noon for each of the n days before and
+// including the given date, newest first
+func daily(date string, n int) []Item {
+	day, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		panic(err)
+	}
+
+	var items []Item
+	for i := 0; i < n; i++ {
+		t := day.AddDate(0, 0, -i).Add(12 * time.Hour)
+		items = append(items, Item{ID: t.Format("01-02"), Name: t.Format("01-02"), Created: t})
+	}
+	return items
+}
+
+func TestEvaluate(t *testing.T) {
+	cases := []struct {
+		name    string
+		policy  Policy
+		items   []Item
+		keep    []string            // The names of the kept snapshots, newest first
+		reasons map[string][]string // The reasons of some of the snapshots
+	}{
+		{
+			name:   "daily",
+			policy: Policy{Daily: 3},
+			items:  daily("2024-03-15", 6),
+			keep:   []string{"03-15", "03-14", "03-13"},
+			reasons: map[string][]string{
+				"03-15": {"last good", "daily"},
+				"03-14": {"daily"},
+				"03-12": {"expired"},
+			},
+		},
+		{
+			name:   "newest snapshot of each day",
+			policy: Policy{Daily: 2},
+			items: []Item{
+				item("a", "2024-03-15T08:00:00Z"),
+				item("b", "2024-03-15T20:00:00Z"),
+				item("c", "2024-03-14T08:00:00Z"),
+				item("d", "2024-03-14T20:00:00Z"),
+				item("e", "2024-03-13T20:00:00Z"),
+			},
+			keep: []string{"b", "d"},
+		},
+		{
+			// 2024-03-17 is a Sunday, so the ISO weeks start on the 11th and 4th
+			name:   "weekly",
+			policy: Policy{Weekly: 2},
+			items:  daily("2024-03-17", 21),
+			keep:   []string{"03-17", "03-10"},
+			reasons: map[string][]string{
+				"03-17": {"last good", "weekly"},
+				"03-10": {"weekly"},
+				"03-03": {"expired"},
+			},
+		},
+		{
+			name:   "daily and weekly overlap",
+			policy: Policy{Daily: 2, Weekly: 2},
+			items:  daily("2024-03-17", 14),
+			keep:   []string{"03-17", "03-16", "03-10"},
+			reasons: map[string][]string{
+				"03-17": {"last good", "daily", "weekly"},
+				"03-16": {"daily"},
+			},
+		},
+		{
+			name:   "monthly",
+			policy: Policy{Monthly: 3},
+			items: []Item{
+				item("mar", "2024-03-02T00:00:00Z"),
+				item("feb-late", "2024-02-28T00:00:00Z"),
+				item("feb-early", "2024-02-10T00:00:00Z"),
+				item("jan", "2024-01-31T23:59:59Z"),
+				item("dec", "2023-12-31T00:00:00Z"),
+			},
+			keep: []string{"mar", "feb-late", "jan"},
+		},
+		{
+			name:   "yearly and hourly",
+			policy: Policy{Hourly: 2, Yearly: 2},
+			items: []Item{
+				item("now", "2024-03-15T12:30:00Z"),
+				item("now-early", "2024-03-15T12:05:00Z"),
+				item("hour-ago", "2024-03-15T11:30:00Z"),
+				item("two-hours-ago", "2024-03-15T10:30:00Z"),
+				item("last-year", "2023-06-01T00:00:00Z"),
+				item("two-years-ago", "2022-06-01T00:00:00Z"),
+			},
+			keep: []string{"now", "hour-ago", "last-year"},
+		},
+		{
+			name:   "last good is always kept",
+			policy: Policy{},
+			items: []Item{
+				withStatus(item("failed", "2024-03-15T00:00:00Z"), Failed),
+				item("good", "2024-03-14T00:00:00Z"),
+				item("older", "2024-03-13T00:00:00Z"),
+			},
+			keep: []string{"good"},
+			reasons: map[string][]string{
+				"failed": {"failed"},
+				"good":   {"last good"},
+				"older":  {"expired"},
+			},
+		},
+		{
+			name:   "failed snapshots do not count",
+			policy: Policy{Daily: 2},
+			items: []Item{
+				withStatus(item("failed", "2024-03-15T20:00:00Z"), Failed),
+				item("good", "2024-03-15T08:00:00Z"),
+				item("yesterday", "2024-03-14T08:00:00Z"),
+				item("older", "2024-03-13T08:00:00Z"),
+			},
+			keep: []string{"good", "yesterday"},
+		},
+		{
+			name:   "pending snapshots are kept and do not count",
+			policy: Policy{Daily: 1},
+			items: []Item{
+				withStatus(item("pending", "2024-03-15T20:00:00Z"), Pending),
+				item("good", "2024-03-15T08:00:00Z"),
+				item("older", "2024-03-14T08:00:00Z"),
+				withStatus(item("old-pending", "2024-03-01T08:00:00Z"), Pending),
+			},
+			keep: []string{"pending", "good", "old-pending"},
+			reasons: map[string][]string{
+				"pending":     {"pending"},
+				"good":        {"last good", "daily"},
+				"old-pending": {"pending"},
+			},
+		},
+		{
+			name:   "unknown creation time",
+			policy: Policy{Daily: 1},
+			items: []Item{
+				item("good", "2024-03-15T08:00:00Z"),
+				{ID: "unknown", Name: "unknown"},
+				withStatus(Item{ID: "unknown-failed", Name: "unknown-failed"}, Failed),
+			},
+			keep: []string{"good", "unknown"},
+			reasons: map[string][]string{
+				"unknown":        {"unknown creation time"},
+				"unknown-failed": {"failed"},
+			},
+		},
+		{
+			name:   "periods in UTC",
+			policy: Policy{Daily: 2},
+			items: []Item{
+				item("a", "2024-03-15T23:30:00Z"),
+				item("b", "2024-03-15T20:30:00Z"),
+				item("c", "2024-03-14T12:00:00Z"),
+			},
+			keep: []string{"a", "c"},
+		},
+		{
+			name:   "periods in location",
+			policy: Policy{Daily: 2, Location: time.FixedZone("UTC+3", 3*60*60)},
+			items: []Item{
+				item("a", "2024-03-15T23:30:00Z"),
+				item("b", "2024-03-15T20:30:00Z"),
+				item("c", "2024-03-14T12:00:00Z"),
+			},
+			keep: []string{"a", "b"},
+		},
+		{
+			name:   "nothing to evaluate",
+			policy: Policy{Daily: 7},
+			items:  nil,
+			keep:   nil,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			decisions := c.policy.Evaluate(c.items)
+			if len(decisions) != len(c.items) {
+				t.Fatalf("Expected %d decisions, got %d", len(c.items), len(decisions))
+			}
+
+			var keep []string
+			reasons := make(map[string][]string)
+			for i, d := range decisions {
+				if i > 0 && !d.Created.IsZero() && d.Created.After(decisions[i-1].Created) {
+					t.Fatalf("Decisions are not ordered from newest to oldest: %s after %s", d.Name, decisions[i-1].Name)
+				}
+				if d.Keep {
+					keep = append(keep, d.Name)
+				}
+				reasons[d.Name] = d.Reasons
+			}
+
+			if !reflect.DeepEqual(keep, c.keep) {
+				t.Fatalf("Expected to keep %v, got %v", c.keep, keep)
+			}
+			for name, want := range c.reasons {
+				if !reflect.DeepEqual(reasons[name], want) {
+					t.Errorf("Expected reasons %v for %s, got %v", want, name, reasons[name])
+				}
+			}
+		})
+	}
+}
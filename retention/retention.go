@@ -0,0 +1,408 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+// Package retention creates volume and VM snapshots and prunes them according
+// to a grandfather-father-son retention policy (e.g. keep 7 daily, 4 weekly
+// and 12 monthly snapshots). A plan is made first, so it can be reviewed
+// before it is executed.
+package retention
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/xanzy/go-cloudstack/v2/cloudstack"
+	"github.com/xanzy/go-cloudstack/v2/internal/common"
+	"github.com/xanzy/go-cloudstack/v2/waiter"
+)
+
+// DefaultParallelism is the number of snapshots that are created or deleted at
+// the same time when no other parallelism is configured
+const DefaultParallelism = 5
+
+// DefaultTimeout is the default time to wait for a single snapshot to be
+// created or deleted
+const DefaultTimeout = 2 * time.Hour
+
+// createdLayout is the layout of the created field returned by CloudStack
+const createdLayout = "2006-01-02T15:04:05-0700"
+
+// Kind is the kind of resource snapshots are made of
+type Kind string
+
+const (
+	// Volume targets are backed up using volume snapshots
+	Volume Kind = "volume"
+
+	// VirtualMachine targets are backed up using VM snapshots
+	VirtualMachine Kind = "virtualmachine"
+)
+
+// Target is a volume or virtual machine the policy is applied to
+type Target struct {
+	Kind Kind
+	ID   string
+	Name string
+}
+
+// TargetPlan is the plan for a single target
+type TargetPlan struct {
+	Target
+	Create    bool // True if a new snapshot will be created first
+	Decisions []Decision
+}
+
+// Expired returns the snapshots that will be deleted
+func (tp *TargetPlan) Expired() []Item {
+	var items []Item
+	for _, d := range tp.Decisions {
+		if !d.Keep && d.ID != "" {
+			items = append(items, d.Item)
+		}
+	}
+	return items
+}
+
+// Plan is the plan for all targets
+type Plan []*TargetPlan
+
+// TargetResult is the result of executing the plan of a single target
+type TargetResult struct {
+	*TargetPlan
+	CreateJobID string
+	CreateErr   error
+	Deleted     []Item
+	DeleteErrs  map[string]error // Errors keyed by snapshot ID
+	Skipped     string           // Why pruning was skipped, if it was
+}
+
+// Option can be passed to New to set custom options
+type Option func(*Manager)
+
+// WithCreate creates a new snapshot of every target before pruning
+func WithCreate(create bool) Option {
+	return func(m *Manager) {
+		m.create = create
+	}
+}
+
+// WithParallelism sets the maximum number of targets processed at the same time
+func WithParallelism(n int) Option {
+	return func(m *Manager) {
+		if n > 0 {
+			m.parallelism = n
+		}
+	}
+}
+
+// WithTimeout sets the time to wait for a single snapshot to be created or deleted
+func WithTimeout(timeout time.Duration) Option {
+	return func(m *Manager) {
+		if timeout != 0 {
+			m.timeout = timeout
+		}
+	}
+}
+
+// WithOptions sets option functions (e.g. cloudstack.WithProject) that are
+// applied when listing targets and snapshots
+func WithOptions(opts ...cloudstack.OptionFunc) Option {
+	return func(m *Manager) {
+		m.opts = append(m.opts, opts...)
+	}
+}
+
+// Manager applies a retention policy to volumes and virtual machines
+type Manager struct {
+	cs          *cloudstack.CloudStackClient
+	policy      Policy
+	create      bool
+	parallelism int
+	timeout     time.Duration
+	opts        []cloudstack.OptionFunc
+	waiter      *waiter.Waiter
+}
+
+// New returns a new manager applying the policy using the given client
+func New(cs *cloudstack.CloudStackClient, policy Policy, options ...Option) *Manager {
+	m := &Manager{
+		cs:          cs,
+		policy:      policy,
+		parallelism: DefaultParallelism,
+		timeout:     DefaultTimeout,
+	}
+
+	for _, fn := range options {
+		fn(m)
+	}
+	m.waiter = waiter.New(cs, waiter.WithTimeout(m.timeout))
+
+	return m
+}
+
+// TargetsByTags returns all volumes or virtual machines with the given tags
+func (m *Manager) TargetsByTags(kind Kind, tags map[string]string) ([]Target, error) {
+	var targets []Target
+
+	switch kind {
+	case Volume:
+		p := m.cs.Volume.NewListVolumesParams()
+		p.SetTags(tags)
+		if err := common.ApplyOptions(m.cs, p, m.opts...); err != nil {
+			return nil, err
+		}
+
+		l, err := m.cs.Volume.ListVolumes(p)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range l.Volumes {
+			targets = append(targets, Target{Kind: Volume, ID: v.Id, Name: v.Name})
+		}
+	case VirtualMachine:
+		p := m.cs.VirtualMachine.NewListVirtualMachinesParams()
+		p.SetTags(tags)
+		if err := common.ApplyOptions(m.cs, p, m.opts...); err != nil {
+			return nil, err
+		}
+
+		l, err := m.cs.VirtualMachine.ListVirtualMachines(p)
+		if err != nil {
+			return nil, err
+		}
+		for _, vm := range l.VirtualMachines {
+			targets = append(targets, Target{Kind: VirtualMachine, ID: vm.Id, Name: vm.Name})
+		}
+	default:
+		return nil, fmt.Errorf("Unknown target kind: %s", kind)
+	}
+
+	return targets, nil
+}
+
+// Plan evaluates the policy for every target. When snapshots are created, the
+// new snapshot is part of the evaluation (with an empty ID), so the plan shows
+// exactly what will be kept once it is executed.
+func (m *Manager) Plan(targets []Target) (Plan, error) {
+	now := time.Now()
+
+	var plan Plan
+	for _, t := range targets {
+		items, err := m.snapshots(t)
+		if err != nil {
+			return nil, fmt.Errorf("Error listing snapshots of %s %s: %v", t.Kind, t.Name, err)
+		}
+
+		if m.create {
+			items = append(items, Item{Name: "(new)", Created: now, Status: Good})
+		}
+
+		plan = append(plan, &TargetPlan{
+			Target:    t,
+			Create:    m.create,
+			Decisions: m.policy.Evaluate(items),
+		})
+	}
+
+	return plan, nil
+}
+
+// snapshots lists the snapshots of a target
+func (m *Manager) snapshots(t Target) ([]Item, error) {
+	var items []Item
+
+	switch t.Kind {
+	case Volume:
+		p := m.cs.Snapshot.NewListSnapshotsParams()
+		p.SetVolumeid(t.ID)
+		if err := common.ApplyOptions(m.cs, p, m.opts...); err != nil {
+			return nil, err
+		}
+
+		l, err := m.cs.Snapshot.ListSnapshots(p)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range l.Snapshots {
+			items = append(items, newItem(s.Id, s.Name, s.Created, s.State, "BackedUp", "Error"))
+		}
+	case VirtualMachine:
+		p := m.cs.Snapshot.NewListVMSnapshotParams()
+		p.SetVirtualmachineid(t.ID)
+		if err := common.ApplyOptions(m.cs, p, m.opts...); err != nil {
+			return nil, err
+		}
+
+		l, err := m.cs.Snapshot.ListVMSnapshot(p)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range l.VMSnapshot {
+			items = append(items, newItem(s.Id, s.Name, s.Created, s.State, "Ready", "Error"))
+		}
+	default:
+		return nil, fmt.Errorf("Unknown target kind: %s", t.Kind)
+	}
+
+	return items, nil
+}
+
+func newItem(id, name, created, state, good, failed string) Item {
+	item := Item{ID: id, Name: name, State: state, Status: Pending}
+	if t, err := time.Parse(createdLayout, created); err == nil {
+		item.Created = t
+	}
+
+	switch state {
+	case good:
+		item.Status = Good
+	case failed:
+		item.Status = Failed
+	}
+
+	return item
+}
+
+// Execute executes the plan. Targets are processed in parallel; for each target
+// the new snapshot is created first and pruning is skipped if that fails. Before
+// deleting, the snapshots are listed again and pruning is skipped if it would
+// not leave at least one good snapshot.
+func (m *Manager) Execute(ctx context.Context, plan Plan) []*TargetResult {
+	results := make([]*TargetResult, len(plan))
+	sem := make(chan struct{}, m.parallelism)
+
+	var wg sync.WaitGroup
+	for i, tp := range plan {
+		results[i] = &TargetResult{TargetPlan: tp, DeleteErrs: make(map[string]error)}
+
+		wg.Add(1)
+		go func(r *TargetResult) {
+			defer wg.Done()
+
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				r.Skipped = ctx.Err().Error()
+				return
+			}
+
+			m.execute(ctx, r)
+		}(results[i])
+	}
+	wg.Wait()
+
+	return results
+}
+
+func (m *Manager) execute(ctx context.Context, r *TargetResult) {
+	if r.Create {
+		r.CreateJobID, r.CreateErr = m.createSnapshot(r.Target)
+		if r.CreateErr == nil {
+			r.CreateErr = m.waiter.WaitForJob(ctx, r.CreateJobID)
+		}
+		if r.CreateErr != nil {
+			r.Skipped = "creating the new snapshot failed"
+			return
+		}
+	}
+
+	expired := r.Expired()
+	if len(expired) == 0 {
+		return
+	}
+
+	if reason := m.unsafe(r.Target, expired); reason != "" {
+		r.Skipped = reason
+		return
+	}
+
+	for _, item := range expired {
+		jobid, err := m.deleteSnapshot(r.Target, item.ID)
+		if err == nil {
+			err = m.waiter.WaitForJob(ctx, jobid)
+		}
+		if err != nil {
+			r.DeleteErrs[item.ID] = err
+			continue
+		}
+		r.Deleted = append(r.Deleted, item)
+	}
+}
+
+// unsafe returns why deleting the expired snapshots is unsafe, if it is
+func (m *Manager) unsafe(t Target, expired []Item) string {
+	items, err := m.snapshots(t)
+	if err != nil {
+		return fmt.Sprintf("listing the snapshots failed: %v", err)
+	}
+
+	deleting := make(map[string]bool, len(expired))
+	for _, item := range expired {
+		deleting[item.ID] = true
+	}
+
+	for _, item := range items {
+		if item.Status == Good && !deleting[item.ID] {
+			return ""
+		}
+	}
+
+	return "no good snapshot would remain"
+}
+
+func (m *Manager) createSnapshot(t Target) (string, error) {
+	switch t.Kind {
+	case Volume:
+		r, err := m.cs.Snapshot.CreateSnapshot(m.cs.Snapshot.NewCreateSnapshotParams(t.ID))
+		if err != nil {
+			return "", err
+		}
+		return r.JobID, nil
+	case VirtualMachine:
+		r, err := m.cs.Snapshot.CreateVMSnapshot(m.cs.Snapshot.NewCreateVMSnapshotParams(t.ID))
+		if err != nil {
+			return "", err
+		}
+		return r.JobID, nil
+	default:
+		return "", fmt.Errorf("Unknown target kind: %s", t.Kind)
+	}
+}
+
+func (m *Manager) deleteSnapshot(t Target, id string) (string, error) {
+	switch t.Kind {
+	case Volume:
+		r, err := m.cs.Snapshot.DeleteSnapshot(m.cs.Snapshot.NewDeleteSnapshotParams(id))
+		if err != nil {
+			return "", err
+		}
+		return r.JobID, nil
+	case VirtualMachine:
+		r, err := m.cs.Snapshot.DeleteVMSnapshot(m.cs.Snapshot.NewDeleteVMSnapshotParams(id))
+		if err != nil {
+			return "", err
+		}
+		return r.JobID, nil
+	default:
+		return "", fmt.Errorf("Unknown target kind: %s", t.Kind)
+	}
+}
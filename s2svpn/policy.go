@@ -0,0 +1,215 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package s2svpn
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Cipher is an encryption algorithm supported by CloudStack
+type Cipher string
+
+const (
+	Cipher3DES Cipher = "3des"
+	AES128     Cipher = "aes128"
+	AES192     Cipher = "aes192"
+	AES256     Cipher = "aes256"
+)
+
+// Hash is an integrity algorithm supported by CloudStack
+type Hash string
+
+const (
+	MD5    Hash = "md5"
+	SHA1   Hash = "sha1"
+	SHA256 Hash = "sha256"
+	SHA384 Hash = "sha384"
+	SHA512 Hash = "sha512"
+)
+
+// DHGroup is a Diffie-Hellman group supported by CloudStack
+type DHGroup string
+
+const (
+	ModP1024 DHGroup = "modp1024" // Group 2
+	ModP1536 DHGroup = "modp1536" // Group 5
+	ModP2048 DHGroup = "modp2048" // Group 14
+	ModP3072 DHGroup = "modp3072" // Group 15
+	ModP4096 DHGroup = "modp4096" // Group 16
+	ModP6144 DHGroup = "modp6144" // Group 17
+	ModP8192 DHGroup = "modp8192" // Group 18
+)
+
+var (
+	ciphers  = []Cipher{Cipher3DES, AES128, AES192, AES256}
+	hashes   = []Hash{MD5, SHA1, SHA256, SHA384, SHA512}
+	dhGroups = []DHGroup{ModP1024, ModP1536, ModP2048, ModP3072, ModP4096, ModP6144, ModP8192}
+)
+
+// Proposal is a single IKE or ESP proposal, like "aes256-sha1;modp1536"
+type Proposal struct {
+	Cipher  Cipher  `json:"cipher" yaml:"cipher"`
+	Hash    Hash    `json:"hash" yaml:"hash"`
+	DHGroup DHGroup `json:"dh_group,omitempty" yaml:"dh_group,omitempty"` // The PFS group for ESP proposals
+}
+
+func (p Proposal) String() string {
+	if p.DHGroup == "" {
+		return fmt.Sprintf("%s-%s", p.Cipher, p.Hash)
+	}
+	return fmt.Sprintf("%s-%s;%s", p.Cipher, p.Hash, p.DHGroup)
+}
+
+func (p Proposal) validate() error {
+	if !validCipher(p.Cipher) {
+		return fmt.Errorf("unsupported cipher %q in proposal %s, must be one of %s", p.Cipher, p, join(ciphers))
+	}
+	if !validHash(p.Hash) {
+		return fmt.Errorf("unsupported hash %q in proposal %s, must be one of %s", p.Hash, p, join(hashes))
+	}
+	if p.DHGroup != "" && !validDHGroup(p.DHGroup) {
+		return fmt.Errorf("unsupported DH group %q in proposal %s, must be one of %s", p.DHGroup, p, join(dhGroups))
+	}
+	return nil
+}
+
+// Policy is an ordered list of proposals
+type Policy []Proposal
+
+// String returns the policy in the format expected by CloudStack
+func (p Policy) String() string {
+	s := make([]string, 0, len(p))
+	for _, proposal := range p {
+		s = append(s, proposal.String())
+	}
+	return strings.Join(s, ",")
+}
+
+// ValidateIKE checks the policy can be used as an IKE policy. Every IKE
+// proposal needs a DH group.
+func (p Policy) ValidateIKE() error {
+	if len(p) == 0 {
+		return fmt.Errorf("The IKE policy needs at least one proposal")
+	}
+	for _, proposal := range p {
+		if err := proposal.validate(); err != nil {
+			return fmt.Errorf("Invalid IKE policy: %v", err)
+		}
+		if proposal.DHGroup == "" {
+			return fmt.Errorf("Invalid IKE policy: proposal %s has no DH group", proposal)
+		}
+	}
+	return nil
+}
+
+// ValidateESP checks the policy can be used as an ESP policy. The DH group of
+// an ESP proposal is optional and enables perfect forward secrecy.
+func (p Policy) ValidateESP() error {
+	if len(p) == 0 {
+		return fmt.Errorf("The ESP policy needs at least one proposal")
+	}
+	for _, proposal := range p {
+		if err := proposal.validate(); err != nil {
+			return fmt.Errorf("Invalid ESP policy: %v", err)
+		}
+	}
+	return nil
+}
+
+// ParsePolicy parses a policy in the format used by CloudStack, for example
+// "aes256-sha256;modp2048,aes128-sha1;modp1536". The proposals are not
+// validated, so policies of existing gateways can always be parsed.
+func ParsePolicy(s string) (Policy, error) {
+	var policy Policy
+
+	for _, part := range strings.Split(s, ",") {
+		part = strings.ToLower(strings.TrimSpace(part))
+		if part == "" {
+			continue
+		}
+
+		var proposal Proposal
+		algorithms := part
+		if i := strings.Index(part, ";"); i >= 0 {
+			algorithms, proposal.DHGroup = part[:i], DHGroup(part[i+1:])
+		}
+
+		fields := strings.Split(algorithms, "-")
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("Invalid proposal %q, expected cipher-hash[;dhgroup]", part)
+		}
+		proposal.Cipher, proposal.Hash = Cipher(fields[0]), Hash(fields[1])
+
+		policy = append(policy, proposal)
+	}
+
+	if len(policy) == 0 {
+		return nil, fmt.Errorf("Empty policy %q", s)
+	}
+
+	return policy, nil
+}
+
+func validCipher(c Cipher) bool {
+	for _, v := range ciphers {
+		if c == v {
+			return true
+		}
+	}
+	return false
+}
+
+func validHash(h Hash) bool {
+	for _, v := range hashes {
+		if h == v {
+			return true
+		}
+	}
+	return false
+}
+
+func validDHGroup(g DHGroup) bool {
+	for _, v := range dhGroups {
+		if g == v {
+			return true
+		}
+	}
+	return false
+}
+
+func join(values interface{}) string {
+	var s []string
+	switch v := values.(type) {
+	case []Cipher:
+		for _, c := range v {
+			s = append(s, string(c))
+		}
+	case []Hash:
+		for _, h := range v {
+			s = append(s, string(h))
+		}
+	case []DHGroup:
+		for _, g := range v {
+			s = append(s, string(g))
+		}
+	}
+	return strings.Join(s, ", ")
+}
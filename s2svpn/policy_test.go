@@ -0,0 +1,123 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package s2svpn
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParsePolicy(t *testing.T) {
+	cases := []struct {
+		in   string
+		want Policy
+		err  bool
+	}{
+		{
+			in:   "aes256-sha256;modp2048",
+			want: Policy{{Cipher: AES256, Hash: SHA256, DHGroup: ModP2048}},
+		},
+		{
+			in: " AES256-SHA256;MODP2048 , aes128-sha1 ",
+			want: Policy{
+				{Cipher: AES256, Hash: SHA256, DHGroup: ModP2048},
+				{Cipher: AES128, Hash: SHA1},
+			},
+		},
+		{
+			// Unsupported algorithms still parse, so live policies can be read
+			in:   "blowfish-sha1;modp768",
+			want: Policy{{Cipher: "blowfish", Hash: SHA1, DHGroup: "modp768"}},
+		},
+		{in: "", err: true},
+		{in: " , ", err: true},
+		{in: "aes256", err: true},
+		{in: "aes256-sha1-md5;modp1024", err: true},
+	}
+
+	for _, c := range cases {
+		got, err := ParsePolicy(c.in)
+		if (err != nil) != c.err {
+			t.Errorf("ParsePolicy(%q): expected error %t, got: %v", c.in, c.err, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, c.want) {
+			t.Errorf("ParsePolicy(%q) = %#v, want %#v", c.in, got, c.want)
+		}
+	}
+}
+
+func TestPolicyString(t *testing.T) {
+	s := "aes256-sha256;modp2048,aes128-sha1"
+
+	p, err := ParsePolicy(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.String() != s {
+		t.Fatalf("Expected %q, got %q", s, p.String())
+	}
+}
+
+func TestValidatePolicy(t *testing.T) {
+	cases := []struct {
+		name   string
+		policy string
+		ike    string // Expected IKE error, empty if valid
+		esp    string // Expected ESP error, empty if valid
+	}{
+		{"with DH group", "aes256-sha256;modp2048", "", ""},
+		{"without DH group", "aes128-sha1", "has no DH group", ""},
+		{"second proposal without DH group", "aes256-sha256;modp2048,aes128-sha1", "has no DH group", ""},
+		{"unsupported cipher", "blowfish-sha1;modp1024", "unsupported cipher", "unsupported cipher"},
+		{"unsupported hash", "aes256-sha224;modp1024", "unsupported hash", "unsupported hash"},
+		{"unsupported DH group", "aes256-sha256;modp768", "unsupported DH group", "unsupported DH group"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			p, err := ParsePolicy(c.policy)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			check := func(kind string, err error, want string) {
+				switch {
+				case want == "" && err != nil:
+					t.Errorf("Expected a valid %s policy, got: %v", kind, err)
+				case want != "" && err == nil:
+					t.Errorf("Expected an invalid %s policy", kind)
+				case want != "" && !strings.Contains(err.Error(), want):
+					t.Errorf("Expected %s error containing %q, got: %v", kind, want, err)
+				}
+			}
+			check("IKE", p.ValidateIKE(), c.ike)
+			check("ESP", p.ValidateESP(), c.esp)
+		})
+	}
+
+	if err := Policy(nil).ValidateIKE(); err == nil {
+		t.Error("Expected an empty IKE policy to be invalid")
+	}
+	if err := Policy(nil).ValidateESP(); err == nil {
+		t.Error("Expected an empty ESP policy to be invalid")
+	}
+}
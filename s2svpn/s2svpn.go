@@ -0,0 +1,515 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+// Package s2svpn provisions site-to-site VPN connections from a VPC to a
+// customer gateway. IKE and ESP policies are built from typed proposals and
+// validated against what CloudStack supports before anything is created, so
+// mistakes do not only show up as a tunnel that does not come up. The state of
+// connections can be monitored, and unhealthy connections are reset.
+package s2svpn
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/xanzy/go-cloudstack/v2/cloudstack"
+	"github.com/xanzy/go-cloudstack/v2/internal/common"
+	"github.com/xanzy/go-cloudstack/v2/waiter"
+)
+
+const (
+	// DefaultTimeout is the default time to wait for each async job
+	DefaultTimeout = 10 * time.Minute
+
+	// DefaultUnhealthyThreshold is the default number of consecutive checks
+	// a connection must be unhealthy before it is reset
+	DefaultUnhealthyThreshold = 3
+
+	// MaxLifetime is the longest IKE and ESP lifetime CloudStack accepts
+	MaxLifetime = 24 * time.Hour
+)
+
+// CustomerGateway describes the remote end of the VPN connections
+type CustomerGateway struct {
+	Name        string        `json:"name" yaml:"name"`
+	Gateway     string        `json:"gateway" yaml:"gateway"` // The public IP address of the remote gateway
+	CIDRs       []string      `json:"cidrs" yaml:"cidrs"`     // The networks behind the remote gateway
+	IKE         Policy        `json:"ike" yaml:"ike"`
+	ESP         Policy        `json:"esp" yaml:"esp"`
+	IKELifetime time.Duration `json:"ike_lifetime,omitempty" yaml:"ike_lifetime,omitempty"` // CloudStack defaults to 24 hours
+	ESPLifetime time.Duration `json:"esp_lifetime,omitempty" yaml:"esp_lifetime,omitempty"` // CloudStack defaults to 1 hour
+	DPD         bool          `json:"dpd,omitempty" yaml:"dpd,omitempty"`                   // Enables dead peer detection
+	ForceEncap  bool          `json:"force_encap,omitempty" yaml:"force_encap,omitempty"`   // Forces UDP encapsulation of ESP packets
+	PSK         string        `json:"psk" yaml:"psk"`
+}
+
+// Validate checks the customer gateway without making any API calls
+func (g *CustomerGateway) Validate() error {
+	if g.Name == "" {
+		return fmt.Errorf("A customer gateway needs a name")
+	}
+	if net.ParseIP(g.Gateway).To4() == nil {
+		return fmt.Errorf("Gateway %q of customer gateway %s is not an IPv4 address", g.Gateway, g.Name)
+	}
+	if len(g.CIDRs) == 0 {
+		return fmt.Errorf("Customer gateway %s needs at least one CIDR", g.Name)
+	}
+	for _, cidr := range g.CIDRs {
+		if _, _, err := net.ParseCIDR(strings.TrimSpace(cidr)); err != nil {
+			return fmt.Errorf("Invalid CIDR of customer gateway %s: %v", g.Name, err)
+		}
+	}
+	if err := g.IKE.ValidateIKE(); err != nil {
+		return fmt.Errorf("Customer gateway %s: %v", g.Name, err)
+	}
+	if err := g.ESP.ValidateESP(); err != nil {
+		return fmt.Errorf("Customer gateway %s: %v", g.Name, err)
+	}
+	if err := validateLifetime("IKE", g.IKELifetime); err != nil {
+		return fmt.Errorf("Customer gateway %s: %v", g.Name, err)
+	}
+	if err := validateLifetime("ESP", g.ESPLifetime); err != nil {
+		return fmt.Errorf("Customer gateway %s: %v", g.Name, err)
+	}
+
+	// The PSK ends up quoted in the configuration of the VPC router
+	if strings.TrimSpace(g.PSK) == "" {
+		return fmt.Errorf("Customer gateway %s needs a pre-shared key", g.Name)
+	}
+	if strings.ContainsAny(g.PSK, "\"\r\n") {
+		return fmt.Errorf("The pre-shared key of customer gateway %s cannot contain quotes or newlines", g.Name)
+	}
+
+	return nil
+}
+
+func validateLifetime(name string, lifetime time.Duration) error {
+	if lifetime < 0 || lifetime > MaxLifetime {
+		return fmt.Errorf("%s lifetime %s is not between 1s and %s", name, lifetime, MaxLifetime)
+	}
+	if lifetime%time.Second != 0 {
+		return fmt.Errorf("%s lifetime %s is not a whole number of seconds", name, lifetime)
+	}
+	return nil
+}
+
+// Option can be passed to New to set custom options
+type Option func(*Manager)
+
+// WithTimeout sets the time to wait for each async job
+func WithTimeout(timeout time.Duration) Option {
+	return func(m *Manager) {
+		if timeout != 0 {
+			m.timeout = timeout
+		}
+	}
+}
+
+// WithUnhealthyThreshold sets the number of consecutive checks a connection
+// must be unhealthy before the monitor resets it
+func WithUnhealthyThreshold(threshold int) Option {
+	return func(m *Manager) {
+		if threshold > 0 {
+			m.threshold = threshold
+		}
+	}
+}
+
+// WithComparePSK compares the pre-shared key of an existing customer gateway
+// with the desired key, and updates the gateway if they differ. CloudStack does
+// not return the key to every caller, so by default it is not compared and an
+// existing customer gateway keeps its key.
+func WithComparePSK(compare bool) Option {
+	return func(m *Manager) {
+		m.comparePSK = compare
+	}
+}
+
+// WithOptions sets option functions (e.g. cloudstack.WithProject) that are
+// applied to all lookups and API calls made by the manager
+func WithOptions(opts ...cloudstack.OptionFunc) Option {
+	return func(m *Manager) {
+		m.opts = append(m.opts, opts...)
+	}
+}
+
+// Manager provisions and monitors site-to-site VPN connections
+type Manager struct {
+	cs         *cloudstack.CloudStackClient
+	timeout    time.Duration
+	threshold  int
+	comparePSK bool
+	opts       []cloudstack.OptionFunc
+	waiter     *waiter.Waiter
+
+	mu       sync.Mutex
+	failures map[string]map[string]int // Consecutive unhealthy checks by VPC and connection ID
+}
+
+// New returns a new manager using the given client
+func New(cs *cloudstack.CloudStackClient, options ...Option) *Manager {
+	m := &Manager{
+		cs:        cs,
+		timeout:   DefaultTimeout,
+		threshold: DefaultUnhealthyThreshold,
+		failures:  make(map[string]map[string]int),
+	}
+
+	for _, fn := range options {
+		fn(m)
+	}
+	m.waiter = waiter.New(cs, waiter.WithTimeout(m.timeout))
+
+	return m
+}
+
+// Tunnel contains the IDs of the resources making up a VPN connection
+type Tunnel struct {
+	CustomerGatewayID string
+	VPNGatewayID      string
+	ConnectionID      string
+}
+
+// Provision validates the customer gateway and then creates, in order, the
+// customer gateway, the VPN gateway of the VPC and the connection between
+// them. Each of them is reused if it already exists, and an existing customer
+// gateway with the same name is updated if its settings differ (see
+// WithComparePSK for its pre-shared key). The gateways
+// are left in place if a later step fails, as they can be reused by a retry.
+func (m *Manager) Provision(ctx context.Context, vpcid string, gw *CustomerGateway, passive bool) (*Tunnel, error) {
+	if err := gw.Validate(); err != nil {
+		return nil, err
+	}
+
+	t := &Tunnel{}
+
+	var err error
+	if t.CustomerGatewayID, err = m.ensureCustomerGateway(ctx, gw); err != nil {
+		return nil, err
+	}
+	if t.VPNGatewayID, err = m.ensureVPNGateway(ctx, vpcid); err != nil {
+		return nil, err
+	}
+	if t.ConnectionID, err = m.ensureConnection(ctx, t.CustomerGatewayID, t.VPNGatewayID, passive); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func (m *Manager) ensureCustomerGateway(ctx context.Context, gw *CustomerGateway) (string, error) {
+	p := m.cs.VPN.NewListVpnCustomerGatewaysParams()
+	p.SetListall(true)
+	p.SetKeyword(gw.Name)
+	if err := common.ApplyOptions(m.cs, p, m.opts...); err != nil {
+		return "", err
+	}
+
+	l, err := m.cs.VPN.ListVpnCustomerGateways(p)
+	if err != nil {
+		return "", fmt.Errorf("Error listing customer gateways: %v", err)
+	}
+
+	cidrs := strings.Join(gw.CIDRs, ",")
+
+	for _, live := range l.VpnCustomerGateways {
+		if live.Name != gw.Name {
+			continue
+		}
+		if gw.matches(live, m.comparePSK) {
+			return live.Id, nil
+		}
+
+		u := m.cs.VPN.NewUpdateVpnCustomerGatewayParams(cidrs, gw.ESP.String(), gw.Gateway, live.Id, gw.IKE.String(), gw.PSK)
+		u.SetName(gw.Name)
+		u.SetDpd(gw.DPD)
+		u.SetForceencap(gw.ForceEncap)
+		if gw.IKELifetime > 0 {
+			u.SetIkelifetime(int64(gw.IKELifetime / time.Second))
+		}
+		if gw.ESPLifetime > 0 {
+			u.SetEsplifetime(int64(gw.ESPLifetime / time.Second))
+		}
+		if err := common.ApplyOptions(m.cs, u, m.opts...); err != nil {
+			return "", err
+		}
+
+		resp, err := m.cs.VPN.UpdateVpnCustomerGateway(u)
+		if err == nil {
+			err = m.waiter.WaitForJobInto(ctx, resp.JobID, resp)
+		}
+		if err != nil {
+			return "", fmt.Errorf("Error updating customer gateway %s: %v", gw.Name, err)
+		}
+		return live.Id, nil
+	}
+
+	c := m.cs.VPN.NewCreateVpnCustomerGatewayParams(cidrs, gw.ESP.String(), gw.Gateway, gw.IKE.String(), gw.PSK)
+	c.SetName(gw.Name)
+	c.SetDpd(gw.DPD)
+	c.SetForceencap(gw.ForceEncap)
+	if gw.IKELifetime > 0 {
+		c.SetIkelifetime(int64(gw.IKELifetime / time.Second))
+	}
+	if gw.ESPLifetime > 0 {
+		c.SetEsplifetime(int64(gw.ESPLifetime / time.Second))
+	}
+	if err := common.ApplyOptions(m.cs, c, m.opts...); err != nil {
+		return "", err
+	}
+
+	resp, err := m.cs.VPN.CreateVpnCustomerGateway(c)
+	if err == nil {
+		err = m.waiter.WaitForJobInto(ctx, resp.JobID, resp)
+	}
+	if err != nil {
+		return "", fmt.Errorf("Error creating customer gateway %s: %v", gw.Name, err)
+	}
+
+	return resp.Id, nil
+}
+
+// matches returns true if the live customer gateway has the desired settings.
+// Lifetimes that are not set are not compared, as CloudStack fills in defaults,
+// and the pre-shared key is only compared if asked for.
+func (g *CustomerGateway) matches(live *cloudstack.VpnCustomerGateway, psk bool) bool {
+	ike, err := ParsePolicy(live.Ikepolicy)
+	if err != nil || ike.String() != g.IKE.String() {
+		return false
+	}
+	esp, err := ParsePolicy(live.Esppolicy)
+	if err != nil || esp.String() != g.ESP.String() {
+		return false
+	}
+	if g.IKELifetime > 0 && live.Ikelifetime != int64(g.IKELifetime/time.Second) {
+		return false
+	}
+	if g.ESPLifetime > 0 && live.Esplifetime != int64(g.ESPLifetime/time.Second) {
+		return false
+	}
+	return live.Gateway == g.Gateway &&
+		common.SameCIDRs(common.SplitCIDRs(live.Cidrlist), g.CIDRs) &&
+		live.Dpd == g.DPD &&
+		live.Forceencap == g.ForceEncap &&
+		(!psk || live.Ipsecpsk == g.PSK)
+}
+
+func (m *Manager) ensureVPNGateway(ctx context.Context, vpcid string) (string, error) {
+	p := m.cs.VPN.NewListVpnGatewaysParams()
+	p.SetVpcid(vpcid)
+	p.SetListall(true)
+	if err := common.ApplyOptions(m.cs, p, m.opts...); err != nil {
+		return "", err
+	}
+
+	l, err := m.cs.VPN.ListVpnGateways(p)
+	if err != nil {
+		return "", fmt.Errorf("Error listing VPN gateways of VPC %s: %v", vpcid, err)
+	}
+	if len(l.VpnGateways) > 0 {
+		return l.VpnGateways[0].Id, nil
+	}
+
+	c := m.cs.VPN.NewCreateVpnGatewayParams(vpcid)
+	if err := common.ApplyOptions(m.cs, c, m.opts...); err != nil {
+		return "", err
+	}
+
+	resp, err := m.cs.VPN.CreateVpnGateway(c)
+	if err == nil {
+		err = m.waiter.WaitForJobInto(ctx, resp.JobID, resp)
+	}
+	if err != nil {
+		return "", fmt.Errorf("Error creating VPN gateway of VPC %s: %v", vpcid, err)
+	}
+
+	return resp.Id, nil
+}
+
+func (m *Manager) ensureConnection(ctx context.Context, customergatewayid, vpngatewayid string, passive bool) (string, error) {
+	p := m.cs.VPN.NewListVpnConnectionsParams()
+	p.SetListall(true)
+	if err := common.ApplyOptions(m.cs, p, m.opts...); err != nil {
+		return "", err
+	}
+
+	l, err := m.cs.VPN.ListVpnConnections(p)
+	if err != nil {
+		return "", fmt.Errorf("Error listing VPN connections: %v", err)
+	}
+	for _, c := range l.VpnConnections {
+		if c.S2scustomergatewayid == customergatewayid && c.S2svpngatewayid == vpngatewayid {
+			return c.Id, nil
+		}
+	}
+
+	c := m.cs.VPN.NewCreateVpnConnectionParams(customergatewayid, vpngatewayid)
+	if passive {
+		c.SetPassive(true)
+	}
+	if err := common.ApplyOptions(m.cs, c, m.opts...); err != nil {
+		return "", err
+	}
+
+	resp, err := m.cs.VPN.CreateVpnConnection(c)
+	if err == nil {
+		err = m.waiter.WaitForJobInto(ctx, resp.JobID, resp)
+	}
+	if err != nil {
+		return "", fmt.Errorf("Error creating VPN connection: %v", err)
+	}
+
+	return resp.Id, nil
+}
+
+// ConnectionStatus is the state of a VPN connection
+type ConnectionStatus struct {
+	ID                string
+	CustomerGatewayID string
+	Gateway           string // The public IP address of the customer gateway
+	State             string // Pending, Connecting, Connected, Disconnected or Error
+	Passive           bool
+	Unhealthy         int   // The number of consecutive checks the connection was unhealthy
+	Reset             bool  // Set if the connection was reset by this check
+	Err               error // Set if resetting the connection failed
+}
+
+// Healthy returns true if the connection is connected
+func (s *ConnectionStatus) Healthy() bool {
+	return s.State == "Connected"
+}
+
+// Status returns the state of the VPN connections of the VPC, without
+// counting or resetting unhealthy connections
+func (m *Manager) Status(vpcid string) ([]*ConnectionStatus, error) {
+	p := m.cs.VPN.NewListVpnConnectionsParams()
+	p.SetVpcid(vpcid)
+	p.SetListall(true)
+	if err := common.ApplyOptions(m.cs, p, m.opts...); err != nil {
+		return nil, err
+	}
+
+	l, err := m.cs.VPN.ListVpnConnections(p)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]*ConnectionStatus, 0, len(l.VpnConnections))
+	for _, c := range l.VpnConnections {
+		statuses = append(statuses, &ConnectionStatus{
+			ID:                c.Id,
+			CustomerGatewayID: c.S2scustomergatewayid,
+			Gateway:           c.Gateway,
+			State:             c.State,
+			Passive:           c.Passive,
+		})
+	}
+
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Gateway < statuses[j].Gateway
+	})
+
+	return statuses, nil
+}
+
+// Check returns the state of the VPN connections of the VPC and resets every
+// connection that was unhealthy for the configured number of consecutive
+// checks. Passive connections are only counted, not reset, as they wait for
+// the customer gateway to connect.
+func (m *Manager) Check(ctx context.Context, vpcid string) ([]*ConnectionStatus, error) {
+	statuses, err := m.Status(vpcid)
+	if err != nil {
+		return nil, err
+	}
+
+	// Count the unhealthy connections. The counts of connections that are
+	// healthy again or no longer exist are dropped.
+	m.mu.Lock()
+	counts := make(map[string]int)
+	var reset []*ConnectionStatus
+	for _, s := range statuses {
+		if s.Healthy() {
+			continue
+		}
+
+		counts[s.ID] = m.failures[vpcid][s.ID] + 1
+		s.Unhealthy = counts[s.ID]
+
+		if !s.Passive && s.Unhealthy >= m.threshold {
+			reset = append(reset, s)
+		}
+	}
+	if len(counts) > 0 {
+		m.failures[vpcid] = counts
+	} else {
+		delete(m.failures, vpcid)
+	}
+	m.mu.Unlock()
+
+	// The lock is not held while resetting, so checks of other VPCs (and
+	// Status calls) are not blocked for the duration of the reset jobs
+	for _, s := range reset {
+		if err := m.reset(ctx, s.ID); err != nil {
+			s.Err = fmt.Errorf("Error resetting VPN connection to %s: %v", s.Gateway, err)
+			continue
+		}
+
+		s.Reset = true
+		m.mu.Lock()
+		delete(m.failures[vpcid], s.ID)
+		m.mu.Unlock()
+	}
+
+	return statuses, nil
+}
+
+func (m *Manager) reset(ctx context.Context, id string) error {
+	p := m.cs.VPN.NewResetVpnConnectionParams(id)
+	if err := common.ApplyOptions(m.cs, p, m.opts...); err != nil {
+		return err
+	}
+
+	resp, err := m.cs.VPN.ResetVpnConnection(p)
+	if err != nil {
+		return err
+	}
+	return m.waiter.WaitForJobInto(ctx, resp.JobID, resp)
+}
+
+// Monitor calls Check every interval until the context is done, passing the
+// result of each check to fn
+func (m *Manager) Monitor(ctx context.Context, vpcid string, interval time.Duration, fn func([]*ConnectionStatus, error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		fn(m.Check(ctx, vpcid))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,280 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package s2svpn
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/xanzy/go-cloudstack/v2/cloudstack"
+)
+
+// jobResults are the results of the jobs started by the fake server, which
+// uses the command as job ID
+var jobResults = map[string]string{
+	"createVpnCustomerGateway": `{"vpncustomergateway":{"id":"cgw-new"}}`,
+	"createVpnGateway":         `{"vpngateway":{"id":"vgw-new"}}`,
+	"createVpnConnection":      `{"vpnconnection":{"id":"conn-new"}}`,
+}
+
+// vpnServer is a fake management server returning the configured customer
+// gateways, VPN gateways and connections, and recording all other calls
+type vpnServer struct {
+	*httptest.Server
+
+	mu          sync.Mutex
+	gateways    string
+	vpnGateways string
+	connections string
+	calls       []string
+}
+
+func newVPNServer() *vpnServer {
+	s := &vpnServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		command := q.Get("command")
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		switch command {
+		case "listVpnCustomerGateways":
+			fmt.Fprintf(w, `{"listvpncustomergatewaysresponse":{"vpncustomergateway":[%s]}}`, s.gateways)
+		case "listVpnGateways":
+			fmt.Fprintf(w, `{"listvpngatewaysresponse":{"vpngateway":[%s]}}`, s.vpnGateways)
+		case "listVpnConnections":
+			fmt.Fprintf(w, `{"listvpnconnectionsresponse":{"vpnconnection":[%s]}}`, s.connections)
+		case "queryAsyncJobResult":
+			result, ok := jobResults[q.Get("jobid")]
+			if !ok {
+				result = "{}"
+			}
+			fmt.Fprintf(w, `{"queryasyncjobresultresponse":{"jobstatus":1,"jobresult":%s}}`, result)
+		default:
+			call := strings.TrimSpace(command + " " + q.Get("id"))
+			if q.Get("passive") == "true" {
+				call += " passive"
+			}
+			s.calls = append(s.calls, call)
+			fmt.Fprintf(w, `{"%sresponse":{"jobid":"%s"}}`, strings.ToLower(command), command)
+		}
+	}))
+
+	return s
+}
+
+func (s *vpnServer) client() *cloudstack.CloudStackClient {
+	return cloudstack.NewClient(s.URL, "key", "secret", false)
+}
+
+func (s *vpnServer) setConnections(connections string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.connections = connections
+}
+
+// takeCalls returns and clears the recorded calls
+func (s *vpnServer) takeCalls() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	calls := s.calls
+	s.calls = nil
+	return calls
+}
+
+func office() *CustomerGateway {
+	return &CustomerGateway{
+		Name:    "office",
+		Gateway: "203.0.113.1",
+		CIDRs:   []string{"192.168.0.0/16"},
+		IKE:     Policy{{Cipher: AES256, Hash: SHA256, DHGroup: ModP2048}},
+		ESP:     Policy{{Cipher: AES256, Hash: SHA256}},
+		PSK:     "secret",
+	}
+}
+
+// liveOffice is the office customer gateway as listed by CloudStack. A gateway
+// whose name only starts with office is listed as well.
+const liveOffice = `{"id":"cgw2","name":"office2"},` +
+	`{"id":"cgw1","name":"office","gateway":"203.0.113.1","cidrlist":"192.168.0.0/16",` +
+	`"ikepolicy":"aes256-sha256;modp2048","esppolicy":"aes256-sha256","ipsecpsk":"%s"}`
+
+func TestEnsureCustomerGateway(t *testing.T) {
+	cases := map[string]struct {
+		live    string
+		options []Option
+		change  func(g *CustomerGateway)
+		want    []string
+		id      string
+	}{
+		"created": {
+			want: []string{"createVpnCustomerGateway"},
+			id:   "cgw-new",
+		},
+		"unchanged": {
+			live: fmt.Sprintf(liveOffice, "secret"),
+			id:   "cgw1",
+		},
+		"updated": {
+			live:   fmt.Sprintf(liveOffice, "secret"),
+			change: func(g *CustomerGateway) { g.CIDRs = append(g.CIDRs, "172.16.0.0/12") },
+			want:   []string{"updateVpnCustomerGateway cgw1"},
+			id:     "cgw1",
+		},
+		"psk not compared": {
+			live: fmt.Sprintf(liveOffice, ""),
+			id:   "cgw1",
+		},
+		"psk compared": {
+			live:    fmt.Sprintf(liveOffice, "old"),
+			options: []Option{WithComparePSK(true)},
+			want:    []string{"updateVpnCustomerGateway cgw1"},
+			id:      "cgw1",
+		},
+	}
+
+	for name, c := range cases {
+		srv := newVPNServer()
+		srv.gateways = c.live
+
+		gw := office()
+		if c.change != nil {
+			c.change(gw)
+		}
+
+		id, err := New(srv.client(), c.options...).ensureCustomerGateway(context.Background(), gw)
+		srv.Close()
+
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if id != c.id {
+			t.Errorf("%s: expected customer gateway %s, got %s", name, c.id, id)
+		}
+		if got := srv.takeCalls(); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("%s: expected calls %v, got %v", name, c.want, got)
+		}
+	}
+}
+
+func TestProvision(t *testing.T) {
+	srv := newVPNServer()
+	defer srv.Close()
+
+	// The customer gateway exists, but is not connected to the VPC yet
+	srv.gateways = fmt.Sprintf(liveOffice, "secret")
+	srv.connections = `{"id":"conn1","s2scustomergatewayid":"cgw1","s2svpngatewayid":"vgw-other"}`
+
+	m := New(srv.client())
+
+	tunnel, err := m.Provision(context.Background(), "vpc1", office(), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (&Tunnel{CustomerGatewayID: "cgw1", VPNGatewayID: "vgw-new", ConnectionID: "conn-new"}); !reflect.DeepEqual(tunnel, want) {
+		t.Fatalf("Expected %+v, got %+v", want, tunnel)
+	}
+	if got, want := srv.takeCalls(), []string{"createVpnGateway", "createVpnConnection passive"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("Expected calls %v, got %v", want, got)
+	}
+
+	// An invalid customer gateway is rejected before making any changes
+	gw := office()
+	gw.PSK = ""
+	if _, err := m.Provision(context.Background(), "vpc1", gw, false); err == nil {
+		t.Fatal("Expected an error for a customer gateway without a pre-shared key")
+	}
+	if calls := srv.takeCalls(); len(calls) != 0 {
+		t.Fatalf("Expected no calls, got %v", calls)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	srv := newVPNServer()
+	defer srv.Close()
+
+	const (
+		active  = `{"id":"c1","gateway":"203.0.113.1","state":"%s"}`
+		passive = `{"id":"c2","gateway":"203.0.113.2","state":"%s","passive":true}`
+	)
+
+	m := New(srv.client(), WithUnhealthyThreshold(2))
+
+	check := func(step string, want map[string]int, reset []string) {
+		t.Helper()
+
+		statuses, err := m.Check(context.Background(), "vpc1")
+		if err != nil {
+			t.Fatalf("%s: %v", step, err)
+		}
+
+		got := make(map[string]int)
+		for _, s := range statuses {
+			got[s.ID] = s.Unhealthy
+			if s.Reset != contains(reset, s.ID) {
+				t.Errorf("%s: expected connection %s to be reset: %t", step, s.ID, !s.Reset)
+			}
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: expected unhealthy counts %v, got %v", step, want, got)
+		}
+
+		var calls []string
+		for _, id := range reset {
+			calls = append(calls, "resetVpnConnection "+id)
+		}
+		if got := srv.takeCalls(); !reflect.DeepEqual(got, calls) {
+			t.Errorf("%s: expected calls %v, got %v", step, calls, got)
+		}
+	}
+
+	srv.setConnections(fmt.Sprintf(active, "Disconnected") + "," + fmt.Sprintf(passive, "Error"))
+	check("first check", map[string]int{"c1": 1, "c2": 1}, nil)
+
+	// Passive connections wait for the customer gateway, so they are not reset
+	check("threshold reached", map[string]int{"c1": 2, "c2": 2}, []string{"c1"})
+
+	// The count of a connection starts over after it was reset
+	check("after reset", map[string]int{"c1": 1, "c2": 3}, nil)
+
+	// The counts of connections that are healthy again or gone are dropped
+	srv.setConnections(fmt.Sprintf(active, "Connected"))
+	check("recovered", map[string]int{"c1": 0}, nil)
+
+	srv.setConnections(fmt.Sprintf(active, "Disconnected") + "," + fmt.Sprintf(passive, "Error"))
+	check("unhealthy again", map[string]int{"c1": 1, "c2": 1}, nil)
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
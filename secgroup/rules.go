@@ -0,0 +1,235 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package secgroup
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/xanzy/go-cloudstack/v2/cloudstack"
+	"github.com/xanzy/go-cloudstack/v2/internal/common"
+)
+
+// Direction is the direction of traffic a rule applies to
+type Direction string
+
+const (
+	// Ingress rules allow incoming traffic
+	Ingress Direction = "ingress"
+
+	// Egress rules allow outgoing traffic
+	Egress Direction = "egress"
+)
+
+// Peer is a security group whose members are allowed by a rule
+type Peer struct {
+	Account string `json:"account,omitempty" yaml:"account,omitempty"` // Defaults to the account owning the group
+	Group   string `json:"group" yaml:"group"`
+}
+
+// Rule is a desired rule. A single rule can allow multiple CIDRs and peer
+// groups, CloudStack stores them as one rule per CIDR or peer group.
+type Rule struct {
+	Protocol  string   `json:"protocol" yaml:"protocol"` // tcp, udp, icmp, all or a protocol number
+	StartPort int      `json:"startport,omitempty" yaml:"startport,omitempty"`
+	EndPort   int      `json:"endport,omitempty" yaml:"endport,omitempty"`   // Defaults to the start port
+	ICMPType  *int     `json:"icmptype,omitempty" yaml:"icmptype,omitempty"` // Defaults to any (-1)
+	ICMPCode  *int     `json:"icmpcode,omitempty" yaml:"icmpcode,omitempty"` // Defaults to any (-1)
+	CIDRs     []string `json:"cidrs,omitempty" yaml:"cidrs,omitempty"`
+	Peers     []Peer   `json:"peers,omitempty" yaml:"peers,omitempty"`
+}
+
+// RuleSet is the complete set of desired rules of a security group
+type RuleSet struct {
+	Ingress []Rule `json:"ingress,omitempty" yaml:"ingress,omitempty"`
+	Egress  []Rule `json:"egress,omitempty" yaml:"egress,omitempty"`
+}
+
+// Permission is a single rule as stored by CloudStack: it allows either one
+// CIDR or one peer group. Permissions are compared after normalizing them, so
+// e.g. "10.1.2.3/8" equals "10.0.0.0/8" and protocol "6" equals "tcp".
+type Permission struct {
+	Direction Direction
+	Protocol  string
+	StartPort int
+	EndPort   int
+	ICMPType  int
+	ICMPCode  int
+	CIDR      string
+	Peer      Peer
+	RuleID    string // Only set for live rules
+}
+
+// key returns a string identifying the permission, ignoring the rule ID
+func (p Permission) key() string {
+	return fmt.Sprintf("%s|%s|%d|%d|%d|%d|%s|%s/%s",
+		p.Direction, p.Protocol, p.StartPort, p.EndPort, p.ICMPType, p.ICMPCode, p.CIDR, p.Peer.Account, p.Peer.Group)
+}
+
+// ports returns the key of everything but the source, which is used to group
+// CIDRs into a single API call
+func (p Permission) ports() string {
+	return fmt.Sprintf("%s|%s|%d|%d|%d|%d", p.Direction, p.Protocol, p.StartPort, p.EndPort, p.ICMPType, p.ICMPCode)
+}
+
+func (p Permission) String() string {
+	var s string
+	switch p.Protocol {
+	case "tcp", "udp":
+		if p.StartPort == p.EndPort {
+			s = fmt.Sprintf("%s %s/%d", p.Direction, p.Protocol, p.StartPort)
+		} else {
+			s = fmt.Sprintf("%s %s/%d-%d", p.Direction, p.Protocol, p.StartPort, p.EndPort)
+		}
+	case "icmp":
+		s = fmt.Sprintf("%s icmp type %d code %d", p.Direction, p.ICMPType, p.ICMPCode)
+	default:
+		s = fmt.Sprintf("%s %s", p.Direction, p.Protocol)
+	}
+
+	if p.CIDR != "" {
+		return s + " from " + p.CIDR
+	}
+	return fmt.Sprintf("%s from group %s/%s", s, p.Peer.Account, p.Peer.Group)
+}
+
+// normalize returns the permission with the protocol specific fields cleaned
+// up, so it can be compared with other permissions
+func (p Permission) normalize() Permission {
+	p.Protocol = common.NormalizeProtocol(p.Protocol)
+
+	switch p.Protocol {
+	case "tcp", "udp":
+		if p.EndPort == 0 {
+			p.EndPort = p.StartPort
+		}
+		p.ICMPType, p.ICMPCode = 0, 0
+	case "icmp":
+		p.StartPort, p.EndPort = 0, 0
+	default:
+		p.StartPort, p.EndPort = 0, 0
+		p.ICMPType, p.ICMPCode = 0, 0
+	}
+
+	return p
+}
+
+// Expand expands the rules into permissions. The account of peers without an
+// account is set to the given account.
+func Expand(direction Direction, rules []Rule, account string) ([]Permission, error) {
+	var perms []Permission
+
+	for _, r := range rules {
+		if len(r.CIDRs) == 0 && len(r.Peers) == 0 {
+			return nil, fmt.Errorf("Rule %s %s/%d-%d has neither CIDRs nor peer groups", direction, r.Protocol, r.StartPort, r.EndPort)
+		}
+
+		base := Permission{
+			Direction: direction,
+			Protocol:  r.Protocol,
+			StartPort: r.StartPort,
+			EndPort:   r.EndPort,
+			ICMPType:  -1,
+			ICMPCode:  -1,
+		}
+		if r.ICMPType != nil {
+			base.ICMPType = *r.ICMPType
+		}
+		if r.ICMPCode != nil {
+			base.ICMPCode = *r.ICMPCode
+		}
+		base = base.normalize()
+
+		switch base.Protocol {
+		case "tcp", "udp":
+			if base.StartPort < 1 || base.EndPort > 65535 || base.StartPort > base.EndPort {
+				return nil, fmt.Errorf("Invalid port range %d-%d in %s rule", base.StartPort, base.EndPort, direction)
+			}
+		}
+
+		for _, cidr := range r.CIDRs {
+			c, err := common.NormalizeCIDR(cidr)
+			if err != nil {
+				return nil, err
+			}
+			p := base
+			p.CIDR = c
+			perms = append(perms, p)
+		}
+
+		for _, peer := range r.Peers {
+			if peer.Group == "" {
+				return nil, fmt.Errorf("Peer in %s rule has no group", direction)
+			}
+			p := base
+			p.Peer = peer
+			if p.Peer.Account == "" {
+				p.Peer.Account = account
+			}
+			perms = append(perms, p)
+		}
+	}
+
+	return perms, nil
+}
+
+// Live returns the live permissions of the security group
+func Live(sg *cloudstack.SecurityGroup) ([]Permission, error) {
+	var perms []Permission
+
+	for direction, rules := range map[Direction][]cloudstack.SecurityGroupRule{
+		Ingress: sg.Ingressrule,
+		Egress:  sg.Egressrule,
+	} {
+		for _, r := range rules {
+			p := Permission{
+				Direction: direction,
+				Protocol:  r.Protocol,
+				StartPort: r.Startport,
+				EndPort:   r.Endport,
+				ICMPType:  r.Icmptype,
+				ICMPCode:  r.Icmpcode,
+				RuleID:    r.Ruleid,
+			}.normalize()
+
+			if r.Cidr != "" {
+				c, err := common.NormalizeCIDR(r.Cidr)
+				if err != nil {
+					return nil, err
+				}
+				p.CIDR = c
+			} else {
+				p.Peer = Peer{Account: r.Account, Group: r.Securitygroupname}
+			}
+
+			perms = append(perms, p)
+		}
+	}
+
+	sortPermissions(perms)
+
+	return perms, nil
+}
+
+func sortPermissions(perms []Permission) {
+	sort.Slice(perms, func(i, j int) bool {
+		return perms[i].key() < perms[j].key()
+	})
+}
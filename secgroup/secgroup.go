@@ -0,0 +1,444 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+// Package secgroup reconciles the rules of security groups with a desired rule
+// set. The live rules are compared with the desired rules after normalizing
+// both, and only the rules that differ are authorized or revoked.
+package secgroup
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/xanzy/go-cloudstack/v2/cloudstack"
+	"github.com/xanzy/go-cloudstack/v2/internal/common"
+	"github.com/xanzy/go-cloudstack/v2/waiter"
+)
+
+// DefaultTimeout is the default time to wait for a single rule to be
+// authorized or revoked
+const DefaultTimeout = 5 * time.Minute
+
+// Group is a security group with its desired rules
+type Group struct {
+	Name        string `json:"name" yaml:"name"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+	RuleSet     `yaml:",inline"`
+}
+
+// Plan is the set of changes needed to reconcile a security group
+type Plan struct {
+	GroupID     string
+	GroupName   string
+	Description string // Only used when creating the group
+	Account     string
+	Create      bool // True if the group does not exist yet
+	Add         []Permission
+	Remove      []Permission
+}
+
+// Empty returns true if the group is already in the desired state
+func (p *Plan) Empty() bool {
+	return !p.Create && len(p.Add) == 0 && len(p.Remove) == 0
+}
+
+// Result is the result of applying a plan
+type Result struct {
+	*Plan
+	DryRun  bool
+	Added   []Permission
+	Removed []Permission
+	Errs    []error
+}
+
+// Failed returns true if any change failed
+func (r *Result) Failed() bool {
+	return len(r.Errs) > 0
+}
+
+// Option can be passed to New to set custom options
+type Option func(*Reconciler)
+
+// WithDryRun only plans the changes without applying them
+func WithDryRun(dryRun bool) Option {
+	return func(r *Reconciler) {
+		r.dryRun = dryRun
+	}
+}
+
+// WithCreate creates groups that do not exist yet when reconciling groups by name
+func WithCreate(create bool) Option {
+	return func(r *Reconciler) {
+		r.create = create
+	}
+}
+
+// WithTimeout sets the time to wait for a single rule to be authorized or revoked
+func WithTimeout(timeout time.Duration) Option {
+	return func(r *Reconciler) {
+		if timeout != 0 {
+			r.timeout = timeout
+		}
+	}
+}
+
+// WithOptions sets option functions (e.g. cloudstack.WithProject) that are
+// applied when looking up, creating and authorizing security groups
+func WithOptions(opts ...cloudstack.OptionFunc) Option {
+	return func(r *Reconciler) {
+		r.opts = append(r.opts, opts...)
+	}
+}
+
+// Reconciler reconciles security groups
+type Reconciler struct {
+	cs      *cloudstack.CloudStackClient
+	dryRun  bool
+	create  bool
+	timeout time.Duration
+	opts    []cloudstack.OptionFunc
+	waiter  *waiter.Waiter
+}
+
+// New returns a new reconciler using the given client
+func New(cs *cloudstack.CloudStackClient, options ...Option) *Reconciler {
+	r := &Reconciler{
+		cs:      cs,
+		timeout: DefaultTimeout,
+	}
+
+	for _, fn := range options {
+		fn(r)
+	}
+	r.waiter = waiter.New(cs, waiter.WithTimeout(r.timeout))
+
+	return r
+}
+
+// Plan computes the changes needed to give the security group exactly the
+// desired rules
+func (r *Reconciler) Plan(id string, desired RuleSet) (*Plan, error) {
+	sg, _, err := r.cs.SecurityGroup.GetSecurityGroupByID(id, r.opts...)
+	if err != nil {
+		return nil, err
+	}
+	return r.plan(sg, desired)
+}
+
+func (r *Reconciler) plan(sg *cloudstack.SecurityGroup, desired RuleSet) (*Plan, error) {
+	want, err := expand(desired, sg.Account)
+	if err != nil {
+		return nil, fmt.Errorf("Error in rules of security group %s: %v", sg.Name, err)
+	}
+
+	have, err := Live(sg)
+	if err != nil {
+		return nil, fmt.Errorf("Error in live rules of security group %s: %v", sg.Name, err)
+	}
+
+	return diff(&Plan{GroupID: sg.Id, GroupName: sg.Name, Account: sg.Account}, want, have), nil
+}
+
+// expand expands both directions of the rule set
+func expand(rs RuleSet, account string) ([]Permission, error) {
+	ingress, err := Expand(Ingress, rs.Ingress, account)
+	if err != nil {
+		return nil, err
+	}
+	egress, err := Expand(Egress, rs.Egress, account)
+	if err != nil {
+		return nil, err
+	}
+	return append(ingress, egress...), nil
+}
+
+// diff fills the plan with the permissions to add and remove. Live duplicates
+// of a desired permission are removed as well.
+func diff(plan *Plan, want, have []Permission) *Plan {
+	wanted := make(map[string]bool, len(want))
+	for _, p := range want {
+		wanted[p.key()] = true
+	}
+
+	present := make(map[string]bool, len(have))
+	for _, p := range have {
+		k := p.key()
+		if !wanted[k] || present[k] {
+			plan.Remove = append(plan.Remove, p)
+		}
+		present[k] = true
+	}
+
+	added := make(map[string]bool)
+	for _, p := range want {
+		k := p.key()
+		if !present[k] && !added[k] {
+			plan.Add = append(plan.Add, p)
+			added[k] = true
+		}
+	}
+
+	sortPermissions(plan.Add)
+	sortPermissions(plan.Remove)
+
+	return plan
+}
+
+// Apply applies the plan, unless the reconciler is in dry-run mode. Rules are
+// added before they are removed, so traffic that is allowed both before and
+// after reconciling is never interrupted. CIDRs sharing the same protocol and
+// ports are authorized with a single call.
+func (r *Reconciler) Apply(ctx context.Context, plan *Plan) *Result {
+	res := &Result{Plan: plan, DryRun: r.dryRun}
+	if r.dryRun || plan.Empty() {
+		return res
+	}
+
+	if plan.Create {
+		if err := r.createGroup(plan); err != nil {
+			res.Errs = append(res.Errs, err)
+			return res
+		}
+	}
+
+	for _, batch := range batches(plan.Add) {
+		jobid, err := r.authorize(plan, batch)
+		if err == nil {
+			err = r.waiter.WaitForJob(ctx, jobid)
+		}
+		if err != nil {
+			res.Errs = append(res.Errs, fmt.Errorf("Error authorizing %s: %v", batch[0], err))
+			continue
+		}
+		res.Added = append(res.Added, batch...)
+	}
+
+	// Do not remove anything if not all desired rules are in place, as the
+	// rules to remove may be the only ones allowing some of the traffic.
+	if res.Failed() {
+		return res
+	}
+
+	for _, p := range plan.Remove {
+		jobid, err := r.revoke(p)
+		if err == nil {
+			err = r.waiter.WaitForJob(ctx, jobid)
+		}
+		if err != nil {
+			res.Errs = append(res.Errs, fmt.Errorf("Error revoking %s: %v", p, err))
+			continue
+		}
+		res.Removed = append(res.Removed, p)
+	}
+
+	return res
+}
+
+// Reconcile plans and applies the desired rules of the security group in one go
+func (r *Reconciler) Reconcile(ctx context.Context, id string, desired RuleSet) (*Result, error) {
+	plan, err := r.Plan(id, desired)
+	if err != nil {
+		return nil, err
+	}
+	return r.Apply(ctx, plan), nil
+}
+
+// PlanGroups plans the desired groups, looking them up by name. Groups that do
+// not exist are planned to be created if the reconciler is configured to do so.
+func (r *Reconciler) PlanGroups(groups []Group) ([]*Plan, error) {
+	var plans []*Plan
+
+	for _, g := range groups {
+		sg, count, err := r.cs.SecurityGroup.GetSecurityGroupByName(g.Name, r.opts...)
+		switch {
+		case count == 0 && r.create:
+			want, err := expand(g.RuleSet, "")
+			if err != nil {
+				return nil, fmt.Errorf("Error in rules of security group %s: %v", g.Name, err)
+			}
+			plan := diff(&Plan{GroupName: g.Name, Description: g.Description, Create: true}, want, nil)
+			plans = append(plans, plan)
+			continue
+		case err != nil:
+			return nil, err
+		}
+
+		plan, err := r.plan(sg, g.RuleSet)
+		if err != nil {
+			return nil, err
+		}
+		plans = append(plans, plan)
+	}
+
+	return plans, nil
+}
+
+// ReconcileGroups plans and applies the desired groups. Groups are applied one
+// after the other, as CloudStack serializes changes to security groups anyway.
+func (r *Reconciler) ReconcileGroups(ctx context.Context, groups []Group) ([]*Result, error) {
+	plans, err := r.PlanGroups(groups)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []*Result
+	for _, plan := range plans {
+		if err := ctx.Err(); err != nil {
+			return results, err
+		}
+		results = append(results, r.Apply(ctx, plan))
+	}
+
+	return results, nil
+}
+
+func (r *Reconciler) createGroup(plan *Plan) error {
+	p := r.cs.SecurityGroup.NewCreateSecurityGroupParams(plan.GroupName)
+	if plan.Description != "" {
+		p.SetDescription(plan.Description)
+	}
+	if err := common.ApplyOptions(r.cs, p, r.opts...); err != nil {
+		return err
+	}
+
+	sg, err := r.cs.SecurityGroup.CreateSecurityGroup(p)
+	if err != nil {
+		return fmt.Errorf("Error creating security group %s: %v", plan.GroupName, err)
+	}
+
+	plan.GroupID = sg.Id
+	plan.Account = sg.Account
+	plan.Create = false
+
+	return nil
+}
+
+// batches groups the permissions into batches that can be authorized with a
+// single call: CIDRs sharing the same protocol and ports are combined, peer
+// groups are authorized one at a time.
+func batches(perms []Permission) [][]Permission {
+	var result [][]Permission
+	byPorts := make(map[string]int)
+
+	for _, p := range perms {
+		if p.CIDR == "" {
+			result = append(result, []Permission{p})
+			continue
+		}
+
+		k := p.ports()
+		if i, ok := byPorts[k]; ok {
+			result[i] = append(result[i], p)
+			continue
+		}
+		byPorts[k] = len(result)
+		result = append(result, []Permission{p})
+	}
+
+	return result
+}
+
+// authorize authorizes a batch of permissions and returns the ID of the job
+func (r *Reconciler) authorize(plan *Plan, batch []Permission) (string, error) {
+	p := batch[0]
+
+	var cidrs []string
+	var peers map[string]string
+	for _, b := range batch {
+		if b.CIDR != "" {
+			cidrs = append(cidrs, b.CIDR)
+			continue
+		}
+		account := b.Peer.Account
+		if account == "" {
+			account = plan.Account
+		}
+		peers = map[string]string{account: b.Peer.Group}
+	}
+	sort.Strings(cidrs)
+
+	if p.Direction == Egress {
+		ap := r.cs.SecurityGroup.NewAuthorizeSecurityGroupEgressParams()
+		ap.SetSecuritygroupid(plan.GroupID)
+		ap.SetProtocol(p.Protocol)
+		switch p.Protocol {
+		case "tcp", "udp":
+			ap.SetStartport(p.StartPort)
+			ap.SetEndport(p.EndPort)
+		case "icmp":
+			ap.SetIcmptype(p.ICMPType)
+			ap.SetIcmpcode(p.ICMPCode)
+		}
+		if len(cidrs) > 0 {
+			ap.SetCidrlist(cidrs)
+		}
+		if peers != nil {
+			ap.SetUsersecuritygrouplist(peers)
+		}
+
+		resp, err := r.cs.SecurityGroup.AuthorizeSecurityGroupEgress(ap)
+		if err != nil {
+			return "", err
+		}
+		return resp.JobID, nil
+	}
+
+	ap := r.cs.SecurityGroup.NewAuthorizeSecurityGroupIngressParams()
+	ap.SetSecuritygroupid(plan.GroupID)
+	ap.SetProtocol(p.Protocol)
+	switch p.Protocol {
+	case "tcp", "udp":
+		ap.SetStartport(p.StartPort)
+		ap.SetEndport(p.EndPort)
+	case "icmp":
+		ap.SetIcmptype(p.ICMPType)
+		ap.SetIcmpcode(p.ICMPCode)
+	}
+	if len(cidrs) > 0 {
+		ap.SetCidrlist(cidrs)
+	}
+	if peers != nil {
+		ap.SetUsersecuritygrouplist(peers)
+	}
+
+	resp, err := r.cs.SecurityGroup.AuthorizeSecurityGroupIngress(ap)
+	if err != nil {
+		return "", err
+	}
+	return resp.JobID, nil
+}
+
+// revoke revokes a live permission and returns the ID of the job
+func (r *Reconciler) revoke(p Permission) (string, error) {
+	if p.Direction == Egress {
+		resp, err := r.cs.SecurityGroup.RevokeSecurityGroupEgress(r.cs.SecurityGroup.NewRevokeSecurityGroupEgressParams(p.RuleID))
+		if err != nil {
+			return "", err
+		}
+		return resp.JobID, nil
+	}
+
+	resp, err := r.cs.SecurityGroup.RevokeSecurityGroupIngress(r.cs.SecurityGroup.NewRevokeSecurityGroupIngressParams(p.RuleID))
+	if err != nil {
+		return "", err
+	}
+	return resp.JobID, nil
+}
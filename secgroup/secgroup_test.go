@@ -0,0 +1,172 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package secgroup
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/xanzy/go-cloudstack/v2/cloudstack"
+)
+
+func describe(perms []Permission) []string {
+	s := make([]string, len(perms))
+	for i, p := range perms {
+		s[i] = p.String()
+	}
+	return s
+}
+
+func intp(i int) *int {
+	return &i
+}
+
+func TestExpand(t *testing.T) {
+	perms, err := Expand(Ingress, []Rule{
+		{Protocol: "6", StartPort: 22, CIDRs: []string{"10.1.2.3/8", "192.168.1.0/24"}},
+		{Protocol: "udp", StartPort: 5000, EndPort: 5010, Peers: []Peer{{Group: "web"}, {Account: "ops", Group: "mon"}}},
+		{Protocol: "ICMP", ICMPType: intp(8), CIDRs: []string{"0.0.0.0/0"}},
+		{Protocol: "all", StartPort: 1, CIDRs: []string{"10.0.0.0/8"}},
+	}, "admin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		"ingress tcp/22 from 10.0.0.0/8",
+		"ingress tcp/22 from 192.168.1.0/24",
+		"ingress udp/5000-5010 from group admin/web",
+		"ingress udp/5000-5010 from group ops/mon",
+		"ingress icmp type 8 code -1 from 0.0.0.0/0",
+		"ingress all from 10.0.0.0/8",
+	}
+	if got := describe(perms); !reflect.DeepEqual(got, want) {
+		t.Fatalf("Expected %v, got %v", want, got)
+	}
+	if p := perms[5]; p.StartPort != 0 || p.ICMPType != 0 {
+		t.Fatalf("Expected the ports and ICMP fields of protocol all to be cleared, got %+v", p)
+	}
+}
+
+func TestExpandErrors(t *testing.T) {
+	cases := map[string]Rule{
+		"no sources":    {Protocol: "tcp", StartPort: 22},
+		"no port":       {Protocol: "tcp", CIDRs: []string{"0.0.0.0/0"}},
+		"reversed":      {Protocol: "tcp", StartPort: 80, EndPort: 22, CIDRs: []string{"0.0.0.0/0"}},
+		"too high":      {Protocol: "udp", StartPort: 1, EndPort: 70000, CIDRs: []string{"0.0.0.0/0"}},
+		"invalid cidr":  {Protocol: "tcp", StartPort: 22, CIDRs: []string{"10.0.0.300/8"}},
+		"peer no group": {Protocol: "tcp", StartPort: 22, Peers: []Peer{{Account: "ops"}}},
+	}
+
+	for name, rule := range cases {
+		if _, err := Expand(Ingress, []Rule{rule}, "admin"); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestPlan(t *testing.T) {
+	sg := &cloudstack.SecurityGroup{
+		Id:      "sg1",
+		Name:    "web",
+		Account: "admin",
+		Ingressrule: []cloudstack.SecurityGroupRule{
+			{Ruleid: "r1", Protocol: "tcp", Startport: 22, Endport: 22, Cidr: "10.0.0.0/8"},
+			{Ruleid: "r2", Protocol: "tcp", Startport: 22, Endport: 22, Cidr: "10.0.0.0/8"},
+			{Ruleid: "r3", Protocol: "tcp", Startport: 80, Endport: 80, Cidr: "0.0.0.0/0"},
+			{Ruleid: "r4", Protocol: "icmp", Icmptype: -1, Icmpcode: -1, Account: "admin", Securitygroupname: "mon"},
+		},
+		Egressrule: []cloudstack.SecurityGroupRule{
+			{Ruleid: "r5", Protocol: "all", Cidr: "0.0.0.0/0"},
+		},
+	}
+
+	desired := RuleSet{
+		Ingress: []Rule{
+			{Protocol: "tcp", StartPort: 22, CIDRs: []string{"10.1.2.3/8"}},
+			{Protocol: "tcp", StartPort: 443, CIDRs: []string{"0.0.0.0/0"}},
+			{Protocol: "icmp", Peers: []Peer{{Group: "mon"}}},
+		},
+		Egress: []Rule{
+			{Protocol: "all", CIDRs: []string{"0.0.0.0/0"}},
+		},
+	}
+
+	plan, err := New(nil).plan(sg, desired)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := describe(plan.Add); !reflect.DeepEqual(got, []string{"ingress tcp/443 from 0.0.0.0/0"}) {
+		t.Fatalf("Unexpected additions: %v", got)
+	}
+
+	var removed []string
+	for _, p := range plan.Remove {
+		removed = append(removed, p.RuleID)
+	}
+	if !reflect.DeepEqual(removed, []string{"r2", "r3"}) && !reflect.DeepEqual(removed, []string{"r1", "r3"}) {
+		t.Fatalf("Expected the duplicate and the unwanted rule to be removed, got %v", removed)
+	}
+
+	if plan.Empty() {
+		t.Fatal("Expected the plan not to be empty")
+	}
+
+	// Applying the plan leaves nothing to do
+	sg.Ingressrule = []cloudstack.SecurityGroupRule{
+		{Ruleid: "r1", Protocol: "tcp", Startport: 22, Endport: 22, Cidr: "10.0.0.0/8"},
+		{Ruleid: "r4", Protocol: "icmp", Icmptype: -1, Icmpcode: -1, Account: "admin", Securitygroupname: "mon"},
+		{Ruleid: "r6", Protocol: "tcp", Startport: 443, Endport: 443, Cidr: "0.0.0.0/0"},
+	}
+	plan, err = New(nil).plan(sg, desired)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !plan.Empty() {
+		t.Fatalf("Expected an empty plan, got %d additions and %d removals", len(plan.Add), len(plan.Remove))
+	}
+}
+
+func TestBatches(t *testing.T) {
+	perms, err := Expand(Ingress, []Rule{
+		{Protocol: "tcp", StartPort: 22, CIDRs: []string{"10.0.0.0/8", "192.168.0.0/16"}, Peers: []Peer{{Group: "a"}, {Group: "b"}}},
+		{Protocol: "tcp", StartPort: 80, CIDRs: []string{"0.0.0.0/0"}},
+		{Protocol: "tcp", StartPort: 22, CIDRs: []string{"172.16.0.0/12"}},
+	}, "admin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got [][]string
+	for _, b := range batches(perms) {
+		got = append(got, describe(b))
+	}
+
+	want := [][]string{
+		{"ingress tcp/22 from 10.0.0.0/8", "ingress tcp/22 from 192.168.0.0/16", "ingress tcp/22 from 172.16.0.0/12"},
+		{"ingress tcp/22 from group admin/a"},
+		{"ingress tcp/22 from group admin/b"},
+		{"ingress tcp/80 from 0.0.0.0/0"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Expected batches %v, got %v", want, got)
+	}
+}
@@ -0,0 +1,145 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package sshkey
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+
+	"github.com/xanzy/go-cloudstack/v2/cloudstack"
+	"github.com/xanzy/go-cloudstack/v2/internal/common"
+	"golang.org/x/crypto/ssh"
+)
+
+// KeyType is the type of key to generate
+type KeyType string
+
+const (
+	// RSA keys can be used to decrypt the passwords of virtual machines
+	RSA KeyType = "rsa"
+
+	// Ed25519 keys are smaller and faster, but cannot be used to decrypt passwords
+	Ed25519 KeyType = "ed25519"
+)
+
+// DefaultRSABits is the size of generated RSA keys when no size is given
+const DefaultRSABits = 4096
+
+// KeyPair is a locally generated SSH keypair
+type KeyPair struct {
+	PrivateKey  []byte // The PEM encoded private key
+	PublicKey   []byte // The public key in authorized_keys format
+	Fingerprint string // The fingerprint in the same format as CloudStack reports it
+}
+
+// Generate creates a new keypair locally. The bits are only used for RSA keys.
+func Generate(keyType KeyType, bits int) (*KeyPair, error) {
+	var key interface{}
+	var block *pem.Block
+
+	switch keyType {
+	case RSA:
+		if bits == 0 {
+			bits = DefaultRSABits
+		}
+		k, err := rsa.GenerateKey(rand.Reader, bits)
+		if err != nil {
+			return nil, err
+		}
+		key = k
+		block = &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(k)}
+	case Ed25519:
+		_, k, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+		der, err := x509.MarshalPKCS8PrivateKey(k)
+		if err != nil {
+			return nil, err
+		}
+		key = k
+		block = &pem.Block{Type: "PRIVATE KEY", Bytes: der}
+	default:
+		return nil, fmt.Errorf("Unsupported key type: %s", keyType)
+	}
+
+	signer, err := ssh.NewSignerFromKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &KeyPair{
+		PrivateKey:  pem.EncodeToMemory(block),
+		PublicKey:   ssh.MarshalAuthorizedKey(signer.PublicKey()),
+		Fingerprint: Fingerprint(signer.PublicKey()),
+	}, nil
+}
+
+// Register registers the public half of a keypair with the given name. The
+// fingerprint reported by CloudStack is checked against the local fingerprint.
+func (kp *KeyPair) Register(cs *cloudstack.CloudStackClient, name string, opts ...cloudstack.OptionFunc) (*cloudstack.RegisterSSHKeyPairResponse, error) {
+	return register(cs, name, kp.PublicKey, kp.Fingerprint, opts...)
+}
+
+// GenerateAndRegister creates a new keypair locally and only registers its public half
+func GenerateAndRegister(cs *cloudstack.CloudStackClient, name string, keyType KeyType, opts ...cloudstack.OptionFunc) (*KeyPair, error) {
+	kp, err := Generate(keyType, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := kp.Register(cs, name, opts...); err != nil {
+		return nil, err
+	}
+
+	return kp, nil
+}
+
+// PublicKeyFingerprint returns the fingerprint of a public key in authorized_keys format
+func PublicKeyFingerprint(authorizedKey []byte) (string, error) {
+	pub, _, _, _, err := ssh.ParseAuthorizedKey(authorizedKey)
+	if err != nil {
+		return "", err
+	}
+	return Fingerprint(pub), nil
+}
+
+func register(cs *cloudstack.CloudStackClient, name string, publicKey []byte, fingerprint string, opts ...cloudstack.OptionFunc) (*cloudstack.RegisterSSHKeyPairResponse, error) {
+	p := cs.SSH.NewRegisterSSHKeyPairParams(name, string(bytes.TrimSpace(publicKey)))
+	if err := common.ApplyOptions(cs, p, opts...); err != nil {
+		return nil, err
+	}
+
+	r, err := cs.SSH.RegisterSSHKeyPair(p)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.Fingerprint != fingerprint {
+		return r, fmt.Errorf("Registered keypair %s has fingerprint %s, expected %s", name, r.Fingerprint, fingerprint)
+	}
+
+	return r, nil
+}
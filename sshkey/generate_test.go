@@ -0,0 +1,178 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package sshkey
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/xanzy/go-cloudstack/v2/cloudstack"
+)
+
+func TestGenerate(t *testing.T) {
+	for _, keyType := range []KeyType{RSA, Ed25519} {
+		kp, err := Generate(keyType, 1024)
+		if err != nil {
+			t.Fatalf("%s: %v", keyType, err)
+		}
+
+		fp, err := PublicKeyFingerprint(kp.PublicKey)
+		if err != nil {
+			t.Fatalf("%s: %v", keyType, err)
+		}
+		if fp != kp.Fingerprint {
+			t.Fatalf("%s: expected public key fingerprint %s, got %s", keyType, kp.Fingerprint, fp)
+		}
+
+		if err := VerifyFingerprint(kp.PrivateKey, nil, kp.Fingerprint); err != nil {
+			t.Fatalf("%s: %v", keyType, err)
+		}
+	}
+
+	if _, err := Generate("dsa", 0); err == nil {
+		t.Fatal("Expected an error for an unsupported key type")
+	}
+}
+
+func TestLoadPublicKeys(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sshkey")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	var fingerprints []string
+	for _, name := range []string{"bob", "alice"} {
+		kp, err := Generate(Ed25519, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(dir, name+".pub"), kp.PublicKey, 0600); err != nil {
+			t.Fatal(err)
+		}
+		fingerprints = append([]string{kp.Fingerprint}, fingerprints...)
+	}
+
+	// Files without the .pub extension are ignored
+	if err := ioutil.WriteFile(filepath.Join(dir, "README"), []byte("not a key"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	keys, err := LoadPublicKeys(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 2 {
+		t.Fatalf("Expected 2 keys, got %d", len(keys))
+	}
+	for i, name := range []string{"alice", "bob"} {
+		if keys[i].Name != name || keys[i].Fingerprint != fingerprints[i] {
+			t.Fatalf("Expected key %d to be %s (%s), got %s (%s)", i, name, fingerprints[i], keys[i].Name, keys[i].Fingerprint)
+		}
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(dir, "broken.pub"), []byte("not a key"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadPublicKeys(dir); err == nil {
+		t.Fatal("Expected an error for an invalid public key")
+	}
+}
+
+func TestPlan(t *testing.T) {
+	alice, _ := Generate(Ed25519, 0)
+	bob, _ := Generate(Ed25519, 0)
+	keys := []PublicKey{
+		{Name: "alice", Key: alice.PublicKey, Fingerprint: alice.Fingerprint},
+		{Name: "bob", Key: bob.PublicKey, Fingerprint: bob.Fingerprint},
+	}
+
+	// The own account has alice with a stale key and an unknown keypair,
+	// the ops account already has both keys registered
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("account") == "ops" {
+			fmt.Fprintf(w, `{"listsshkeypairsresponse":{"count":2,"sshkeypair":[`+
+				`{"name":"alice","fingerprint":"%s"},{"name":"bob","fingerprint":"%s"}]}}`,
+				alice.Fingerprint, bob.Fingerprint)
+			return
+		}
+		w.Write([]byte(`{"listsshkeypairsresponse":{"count":2,"sshkeypair":[` +
+			`{"name":"alice","fingerprint":"00:11"},{"name":"old","fingerprint":"22:33"}]}}`))
+	}))
+	defer srv.Close()
+
+	cs := cloudstack.NewClient(srv.URL, "key", "secret", false)
+	scopes := []Scope{{}, {Account: "ops", DomainID: "d1"}}
+
+	changes, err := Plan(cs, keys, scopes, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertChanges(t, changes, []string{
+		"replace keypair alice in own account",
+		"register keypair bob in own account",
+	})
+
+	changes, err = Plan(cs, keys, scopes, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertChanges(t, changes, []string{
+		"replace keypair alice in own account",
+		"register keypair bob in own account",
+		"delete keypair old in own account",
+	})
+	if changes[0].Fingerprint != "00:11" || changes[0].Key != &keys[0] {
+		t.Fatalf("Expected the replace to carry the old fingerprint and the local key, got %+v", changes[0])
+	}
+}
+
+func assertChanges(t *testing.T, changes []Change, want []string) {
+	t.Helper()
+
+	got := make([]string, len(changes))
+	for i, c := range changes {
+		got[i] = c.String()
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Expected changes:\n%s\ngot:\n%s", strings.Join(want, "\n"), strings.Join(got, "\n"))
+	}
+}
+
+func TestScopeString(t *testing.T) {
+	cases := map[string]Scope{
+		"own account":             {},
+		"account ops (domain d1)": {Account: "ops", DomainID: "d1"},
+		"project p1":              {Account: "ops", ProjectID: "p1"},
+	}
+
+	for want, scope := range cases {
+		if got := scope.String(); got != want {
+			t.Errorf("Expected %q, got %q", want, got)
+		}
+	}
+}
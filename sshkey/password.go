@@ -0,0 +1,147 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+// Package sshkey contains helpers for working with the SSH keypairs registered
+// in CloudStack, like generating keys locally, reconciling registered keypairs
+// and decrypting the passwords of virtual machines.
+package sshkey
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/xanzy/go-cloudstack/v2/cloudstack"
+	"golang.org/x/crypto/ssh"
+)
+
+// ParsePrivateKey parses a PEM encoded (PKCS#1, PKCS#8 or OpenSSH) private key.
+// The passphrase is only used when the key is encrypted and may be nil otherwise.
+func ParsePrivateKey(pemBytes []byte, passphrase []byte) (interface{}, error) {
+	if len(passphrase) == 0 {
+		return ssh.ParseRawPrivateKey(pemBytes)
+	}
+	return ssh.ParseRawPrivateKeyWithPassphrase(pemBytes, passphrase)
+}
+
+// Fingerprint returns the fingerprint of a public key, in the same (MD5 colon
+// separated) format as CloudStack reports it in SSHKeyPair.Fingerprint
+func Fingerprint(pub ssh.PublicKey) string {
+	return ssh.FingerprintLegacyMD5(pub)
+}
+
+// PrivateKeyFingerprint returns the fingerprint of the public half of a PEM encoded private key
+func PrivateKeyFingerprint(pemBytes []byte, passphrase []byte) (string, error) {
+	key, err := ParsePrivateKey(pemBytes, passphrase)
+	if err != nil {
+		return "", err
+	}
+
+	signer, err := ssh.NewSignerFromKey(key)
+	if err != nil {
+		return "", err
+	}
+
+	return Fingerprint(signer.PublicKey()), nil
+}
+
+// VerifyFingerprint checks if the PEM encoded private key belongs to the keypair
+// with the given fingerprint (e.g. as returned in SSHKeyPair.Fingerprint)
+func VerifyFingerprint(pemBytes []byte, passphrase []byte, fingerprint string) error {
+	fp, err := PrivateKeyFingerprint(pemBytes, passphrase)
+	if err != nil {
+		return err
+	}
+
+	if fp != fingerprint {
+		return fmt.Errorf("Private key fingerprint %s does not match keypair fingerprint %s", fp, fingerprint)
+	}
+
+	return nil
+}
+
+// DecryptPassword decrypts an encrypted password (as returned in the Encryptedpassword
+// field of GetVMPasswordResponse) using a PEM encoded RSA private key
+func DecryptPassword(encrypted string, pemBytes []byte, passphrase []byte) (string, error) {
+	key, err := ParsePrivateKey(pemBytes, passphrase)
+	if err != nil {
+		return "", err
+	}
+
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return "", fmt.Errorf("Passwords can only be decrypted using an RSA key, got: %T", key)
+	}
+
+	b, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil {
+		return "", err
+	}
+
+	password, err := rsa.DecryptPKCS1v15(rand.Reader, rsaKey, b)
+	if err != nil {
+		return "", err
+	}
+
+	return string(password), nil
+}
+
+// GetVMPassword retrieves and decrypts the password of a virtual machine. Before
+// decrypting, it verifies that the private key belongs to the keypair registered
+// for the virtual machine.
+func GetVMPassword(cs *cloudstack.CloudStackClient, id string, pemBytes []byte, passphrase []byte, opts ...cloudstack.OptionFunc) (string, error) {
+	vm, _, err := cs.VirtualMachine.GetVirtualMachineByID(id, opts...)
+	if err != nil {
+		return "", err
+	}
+
+	if vm.Keypair == "" {
+		return "", fmt.Errorf("Virtual machine %s has no SSH keypair", id)
+	}
+
+	p := cs.SSH.NewListSSHKeyPairsParams()
+	p.SetName(vm.Keypair)
+	// The keypair of a project virtual machine is owned by the project, not by an account
+	if vm.Projectid != "" {
+		p.SetProjectid(vm.Projectid)
+	} else {
+		p.SetAccount(vm.Account)
+		p.SetDomainid(vm.Domainid)
+	}
+
+	l, err := cs.SSH.ListSSHKeyPairs(p)
+	if err != nil {
+		return "", err
+	}
+	if l.Count != 1 {
+		return "", fmt.Errorf("Expected one keypair with name %s, found %d", vm.Keypair, l.Count)
+	}
+
+	if err := VerifyFingerprint(pemBytes, passphrase, l.SSHKeyPairs[0].Fingerprint); err != nil {
+		return "", err
+	}
+
+	r, err := cs.VirtualMachine.GetVMPassword(cs.VirtualMachine.NewGetVMPasswordParams(id))
+	if err != nil {
+		return "", err
+	}
+
+	return DecryptPassword(r.Encryptedpassword, pemBytes, passphrase)
+}
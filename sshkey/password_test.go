@@ -0,0 +1,180 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package sshkey
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/xanzy/go-cloudstack/v2/cloudstack"
+)
+
+func newRSAKey(t *testing.T) (*rsa.PrivateKey, []byte) {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return key, pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+}
+
+func TestDecryptPassword(t *testing.T) {
+	key, pemBytes := newRSAKey(t)
+
+	b, err := rsa.EncryptPKCS1v15(rand.Reader, &key.PublicKey, []byte("s3cr3t"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	password, err := DecryptPassword(base64.StdEncoding.EncodeToString(b), pemBytes, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if password != "s3cr3t" {
+		t.Fatalf("Expected password s3cr3t, got %s", password)
+	}
+
+	if _, err := DecryptPassword("not base64!", pemBytes, nil); err == nil {
+		t.Fatal("Expected an error for an invalid encrypted password")
+	}
+
+	_, other := newRSAKey(t)
+	if _, err := DecryptPassword(base64.StdEncoding.EncodeToString(b), other, nil); err == nil {
+		t.Fatal("Expected an error when decrypting with the wrong key")
+	}
+}
+
+func TestVerifyFingerprint(t *testing.T) {
+	_, pemBytes := newRSAKey(t)
+
+	fp, err := PrivateKeyFingerprint(pemBytes, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := VerifyFingerprint(pemBytes, nil, fp); err != nil {
+		t.Fatalf("Expected the fingerprint to match: %v", err)
+	}
+
+	_, other := newRSAKey(t)
+	if err := VerifyFingerprint(other, nil, fp); err == nil {
+		t.Fatal("Expected an error for a key with another fingerprint")
+	}
+
+	if _, err := PrivateKeyFingerprint([]byte("not a key"), nil); err == nil {
+		t.Fatal("Expected an error for an invalid private key")
+	}
+}
+
+func TestParseEncryptedPrivateKey(t *testing.T) {
+	key, _ := newRSAKey(t)
+
+	block, err := x509.EncryptPEMBlock(rand.Reader, "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(key), []byte("pass"), x509.PEMCipherAES256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pemBytes := pem.EncodeToMemory(block)
+
+	if _, err := ParsePrivateKey(pemBytes, nil); err == nil {
+		t.Fatal("Expected an error without a passphrase")
+	}
+	if _, err := ParsePrivateKey(pemBytes, []byte("wrong")); err == nil {
+		t.Fatal("Expected an error for a wrong passphrase")
+	}
+
+	parsed, err := ParsePrivateKey(pemBytes, []byte("pass"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if k, ok := parsed.(*rsa.PrivateKey); !ok || k.D.Cmp(key.D) != 0 {
+		t.Fatal("Expected the parsed key to equal the original key")
+	}
+}
+
+func TestGetVMPassword(t *testing.T) {
+	key, pemBytes := newRSAKey(t)
+
+	fp, err := PrivateKeyFingerprint(pemBytes, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := rsa.EncryptPKCS1v15(rand.Reader, &key.PublicKey, []byte("s3cr3t"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name string
+		vm   string
+		want url.Values // The owner the keypair is looked up by
+	}{
+		{
+			name: "account",
+			vm:   `"account":"admin","domainid":"domain-1"`,
+			want: url.Values{"account": {"admin"}, "domainid": {"domain-1"}},
+		},
+		{
+			name: "project",
+			vm:   `"account":"PrjAcct-web-1","domainid":"domain-1","projectid":"project-1"`,
+			want: url.Values{"projectid": {"project-1"}},
+		},
+	}
+
+	for _, c := range cases {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			q := r.URL.Query()
+			switch q.Get("command") {
+			case "listVirtualMachines":
+				fmt.Fprintf(w, `{"listvirtualmachinesresponse":{"count":1,"virtualmachine":[{"id":"vm-1","keypair":"deploy",%s}]}}`, c.vm)
+			case "listSSHKeyPairs":
+				for _, k := range []string{"account", "domainid", "projectid"} {
+					if q.Get(k) != c.want.Get(k) {
+						t.Errorf("%s: expected %s %q, got %q", c.name, k, c.want.Get(k), q.Get(k))
+					}
+				}
+				fmt.Fprintf(w, `{"listsshkeypairsresponse":{"count":1,"sshkeypair":[{"name":"deploy","fingerprint":"%s"}]}}`, fp)
+			case "getVMPassword":
+				fmt.Fprintf(w, `{"getvmpasswordresponse":{"encryptedpassword":"%s"}}`, base64.StdEncoding.EncodeToString(b))
+			default:
+				t.Errorf("Unexpected command: %s", q.Get("command"))
+			}
+		}))
+
+		password, err := GetVMPassword(cloudstack.NewClient(srv.URL, "key", "secret", false), "vm-1", pemBytes, nil)
+		srv.Close()
+
+		if err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		if password != "s3cr3t" {
+			t.Fatalf("%s: expected password s3cr3t, got %s", c.name, password)
+		}
+	}
+}
@@ -0,0 +1,247 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package sshkey
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/xanzy/go-cloudstack/v2/cloudstack"
+	"golang.org/x/crypto/ssh"
+)
+
+// PublicKey is a public key that should be registered as a keypair
+type PublicKey struct {
+	Name        string
+	Key         []byte // The public key in authorized_keys format
+	Fingerprint string
+}
+
+// LoadPublicKeys reads all *.pub files in a directory. The name of each keypair
+// is the name of the file without the .pub extension.
+func LoadPublicKeys(dir string) ([]PublicKey, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.pub"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+
+	keys := make([]PublicKey, 0, len(files))
+	for _, file := range files {
+		b, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+
+		pub, _, _, _, err := ssh.ParseAuthorizedKey(b)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing public key %s: %v", file, err)
+		}
+
+		keys = append(keys, PublicKey{
+			Name:        strings.TrimSuffix(filepath.Base(file), ".pub"),
+			Key:         ssh.MarshalAuthorizedKey(pub),
+			Fingerprint: Fingerprint(pub),
+		})
+	}
+
+	return keys, nil
+}
+
+// Scope is an account or project the keypairs are reconciled in. An empty
+// scope means the keypairs of the caller's own account.
+type Scope struct {
+	Account   string
+	DomainID  string
+	ProjectID string
+}
+
+func (s Scope) String() string {
+	switch {
+	case s.ProjectID != "":
+		return "project " + s.ProjectID
+	case s.Account != "":
+		return fmt.Sprintf("account %s (domain %s)", s.Account, s.DomainID)
+	default:
+		return "own account"
+	}
+}
+
+// Action is the action needed to bring a keypair in line with the local keys
+type Action string
+
+const (
+	// Register registers a local key that is missing in CloudStack
+	Register Action = "register"
+
+	// Replace deletes and re-registers a keypair whose fingerprint differs
+	Replace Action = "replace"
+
+	// Delete deletes a keypair that has no local key (only when pruning)
+	Delete Action = "delete"
+)
+
+// Change is a single change needed to reconcile the keypairs of a scope
+type Change struct {
+	Scope       Scope
+	Name        string
+	Action      Action
+	Fingerprint string // The fingerprint currently registered in CloudStack, if any
+	Key         *PublicKey
+}
+
+func (c Change) String() string {
+	return fmt.Sprintf("%s keypair %s in %s", c.Action, c.Name, c.Scope)
+}
+
+// Plan compares the local keys with the keypairs registered in each scope and
+// returns the changes needed to reconcile them. When prune is true, keypairs
+// that have no local key are deleted as well.
+func Plan(cs *cloudstack.CloudStackClient, keys []PublicKey, scopes []Scope, prune bool) ([]Change, error) {
+	if len(scopes) == 0 {
+		scopes = []Scope{{}}
+	}
+
+	var changes []Change
+	for _, scope := range scopes {
+		registered, err := listKeyPairs(cs, scope)
+		if err != nil {
+			return nil, fmt.Errorf("Error listing keypairs of %s: %v", scope, err)
+		}
+
+		local := make(map[string]bool, len(keys))
+		for i := range keys {
+			k := &keys[i]
+			local[k.Name] = true
+
+			fp, ok := registered[k.Name]
+			switch {
+			case !ok:
+				changes = append(changes, Change{Scope: scope, Name: k.Name, Action: Register, Key: k})
+			case fp != k.Fingerprint:
+				changes = append(changes, Change{Scope: scope, Name: k.Name, Action: Replace, Fingerprint: fp, Key: k})
+			}
+		}
+
+		if !prune {
+			continue
+		}
+
+		var names []string
+		for name := range registered {
+			if !local[name] {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			changes = append(changes, Change{Scope: scope, Name: name, Action: Delete, Fingerprint: registered[name]})
+		}
+	}
+
+	return changes, nil
+}
+
+// Apply executes the planned changes in order and stops at the first error
+func Apply(cs *cloudstack.CloudStackClient, changes []Change) error {
+	for _, c := range changes {
+		if c.Action == Delete || c.Action == Replace {
+			p := cs.SSH.NewDeleteSSHKeyPairParams(c.Name)
+			if err := scopeOption(c.Scope)(cs, p); err != nil {
+				return err
+			}
+			if _, err := cs.SSH.DeleteSSHKeyPair(p); err != nil {
+				return fmt.Errorf("Error trying to %s: %v", c, err)
+			}
+		}
+
+		if c.Action == Register || c.Action == Replace {
+			if _, err := register(cs, c.Name, c.Key.Key, c.Key.Fingerprint, scopeOption(c.Scope)); err != nil {
+				return fmt.Errorf("Error trying to %s: %v", c, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Reconcile plans and applies the changes needed to reconcile the keys in a
+// directory with the keypairs registered in each scope
+func Reconcile(cs *cloudstack.CloudStackClient, dir string, scopes []Scope, prune bool) ([]Change, error) {
+	keys, err := LoadPublicKeys(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := Plan(cs, keys, scopes, prune)
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, Apply(cs, changes)
+}
+
+// listKeyPairs returns the fingerprints of the keypairs in a scope, keyed by name
+func listKeyPairs(cs *cloudstack.CloudStackClient, scope Scope) (map[string]string, error) {
+	p := cs.SSH.NewListSSHKeyPairsParams()
+	if err := scopeOption(scope)(cs, p); err != nil {
+		return nil, err
+	}
+
+	l, err := cs.SSH.ListSSHKeyPairs(p)
+	if err != nil {
+		return nil, err
+	}
+
+	keypairs := make(map[string]string, l.Count)
+	for _, k := range l.SSHKeyPairs {
+		keypairs[k.Name] = k.Fingerprint
+	}
+
+	return keypairs, nil
+}
+
+// scopeOption returns an option that sets the account or project of any params struct
+func scopeOption(scope Scope) cloudstack.OptionFunc {
+	return func(cs *cloudstack.CloudStackClient, p interface{}) error {
+		if scope.ProjectID != "" {
+			return cloudstack.WithProject(scope.ProjectID)(cs, p)
+		}
+		if scope.Account == "" {
+			return nil
+		}
+
+		ps, ok := p.(interface {
+			SetAccount(string)
+			SetDomainid(string)
+		})
+		if !ok {
+			return fmt.Errorf("Params %T do not support setting an account", p)
+		}
+		ps.SetAccount(scope.Account)
+		ps.SetDomainid(scope.DomainID)
+
+		return nil
+	}
+}
@@ -0,0 +1,509 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+// Package templatesync keeps versioned templates consistent across zones. The
+// versions of a template belong to a family and are identified by tags (by
+// default "template-family" and "template-version"). Syncing a version copies
+// it to all zones it is missing from, deprecates the older versions of the
+// family once the new version is ready everywhere, and deletes older versions
+// no virtual machine references anymore.
+package templatesync
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/xanzy/go-cloudstack/v2/cloudstack"
+	"github.com/xanzy/go-cloudstack/v2/internal/common"
+	"github.com/xanzy/go-cloudstack/v2/waiter"
+)
+
+// DefaultFamilyTag is the default tag key holding the family of a template
+const DefaultFamilyTag = "template-family"
+
+// DefaultVersionTag is the default tag key holding the version of a template
+const DefaultVersionTag = "template-version"
+
+// DefaultParallelism is the number of zones a template is copied to at the
+// same time when no other parallelism is configured
+const DefaultParallelism = 4
+
+// DefaultTimeout is the default time to wait for a template to become ready
+// in a single zone
+const DefaultTimeout = 2 * time.Hour
+
+// DefaultKeep is the default number of older versions that are never deleted,
+// so there is always something to roll back to
+const DefaultKeep = 1
+
+// Option can be passed to New to set custom options
+type Option func(*Syncer)
+
+// WithTags sets the tag keys holding the family and version of a template
+func WithTags(family, version string) Option {
+	return func(s *Syncer) {
+		if family != "" {
+			s.familyTag = family
+		}
+		if version != "" {
+			s.versionTag = version
+		}
+	}
+}
+
+// WithParallelism sets the maximum number of zones copied to at the same time
+func WithParallelism(n int) Option {
+	return func(s *Syncer) {
+		if n > 0 {
+			s.parallelism = n
+		}
+	}
+}
+
+// WithTimeout sets the time to wait for a template to become ready in a zone
+func WithTimeout(timeout time.Duration) Option {
+	return func(s *Syncer) {
+		if timeout != 0 {
+			s.timeout = timeout
+		}
+	}
+}
+
+// WithKeep sets the number of older versions that are deprecated but never
+// deleted, even if no virtual machine references them
+func WithKeep(n int) Option {
+	return func(s *Syncer) {
+		if n >= 0 {
+			s.keep = n
+		}
+	}
+}
+
+// WithGarbageCollection enables or disables deleting unused older versions
+// (default: enabled)
+func WithGarbageCollection(gc bool) Option {
+	return func(s *Syncer) {
+		s.gc = gc
+	}
+}
+
+// WithTemplateFilter sets the template filter used to list the versions of a
+// family (default: self)
+func WithTemplateFilter(filter string) Option {
+	return func(s *Syncer) {
+		if filter != "" {
+			s.templateFilter = filter
+		}
+	}
+}
+
+// WithOptions sets option functions (e.g. cloudstack.WithProject) that are
+// applied when listing templates and virtual machines
+func WithOptions(opts ...cloudstack.OptionFunc) Option {
+	return func(s *Syncer) {
+		s.opts = append(s.opts, opts...)
+	}
+}
+
+// Syncer replicates and retires the versions of template families
+type Syncer struct {
+	cs             *cloudstack.CloudStackClient
+	familyTag      string
+	versionTag     string
+	parallelism    int
+	timeout        time.Duration
+	keep           int
+	gc             bool
+	templateFilter string
+	opts           []cloudstack.OptionFunc
+	waiter         *waiter.Waiter
+}
+
+// New returns a new syncer using the given client
+func New(cs *cloudstack.CloudStackClient, options ...Option) *Syncer {
+	s := &Syncer{
+		cs:             cs,
+		familyTag:      DefaultFamilyTag,
+		versionTag:     DefaultVersionTag,
+		parallelism:    DefaultParallelism,
+		timeout:        DefaultTimeout,
+		keep:           DefaultKeep,
+		gc:             true,
+		templateFilter: "self",
+	}
+
+	for _, fn := range options {
+		fn(s)
+	}
+	s.waiter = waiter.New(cs, waiter.WithTimeout(s.timeout))
+
+	return s
+}
+
+// Versions returns all versions of the family, from newest to oldest
+func (s *Syncer) Versions(family string) ([]*Version, error) {
+	p := s.cs.Template.NewListTemplatesParams(s.templateFilter)
+	p.SetTags(map[string]string{s.familyTag: family})
+	if err := common.ApplyOptions(s.cs, p, s.opts...); err != nil {
+		return nil, err
+	}
+
+	l, err := s.cs.Template.ListTemplates(p)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.group(l.Templates), nil
+}
+
+// Version returns the template with the given ID, including the entries of all
+// zones it is registered in
+func (s *Syncer) Version(id string) (*Version, error) {
+	p := s.cs.Template.NewListTemplatesParams(s.templateFilter)
+	p.SetId(id)
+	if err := common.ApplyOptions(s.cs, p, s.opts...); err != nil {
+		return nil, err
+	}
+
+	l, err := s.cs.Template.ListTemplates(p)
+	if err != nil {
+		return nil, err
+	}
+	if l.Count == 0 {
+		return nil, fmt.Errorf("No match found for template %s", id)
+	}
+
+	return s.group(l.Templates)[0], nil
+}
+
+// group groups the template entries of all zones by template ID
+func (s *Syncer) group(templates []*cloudstack.Template) []*Version {
+	byID := make(map[string]*Version)
+
+	var versions []*Version
+	for _, t := range templates {
+		v, ok := byID[t.Id]
+		if !ok {
+			v = &Version{
+				ID:      t.Id,
+				Name:    t.Name,
+				Family:  tagValue(t.Tags, s.familyTag),
+				Version: tagValue(t.Tags, s.versionTag),
+			}
+			byID[t.Id] = v
+			versions = append(versions, v)
+		}
+		v.add(t)
+	}
+
+	sortVersions(versions)
+
+	return versions
+}
+
+// Plan describes how a version will be synced
+type Plan struct {
+	Source       *Version
+	SourceZoneID string         // The zone the template is copied from
+	Copy         []string       // The zones the template will be copied to
+	Wait         []string       // The zones the template is still becoming ready in
+	Deprecate    []*Version     // Older versions that will be deprecated
+	Delete       []*Version     // Older versions that will be deleted
+	InUse        map[string]int // Referencing virtual machines of the checked older versions
+}
+
+// Plan plans syncing the template to the zones. The template must be ready in
+// at least one zone and must be tagged with its family and version. Older
+// versions of the family are deprecated, and deleted if no virtual machine
+// references them (the number of referencing virtual machines is recorded in
+// InUse). The newest older versions are kept, as configured by WithKeep.
+func (s *Syncer) Plan(id string, zoneids []string) (*Plan, error) {
+	src, err := s.Version(id)
+	if err != nil {
+		return nil, err
+	}
+	if src.Family == "" || src.Version == "" {
+		return nil, fmt.Errorf("Template %s is not tagged with %s and %s", src.Name, s.familyTag, s.versionTag)
+	}
+
+	ready := src.ReadyZones()
+	if len(ready) == 0 {
+		return nil, fmt.Errorf("Template %s is not ready in any zone", src.Name)
+	}
+
+	plan := &Plan{
+		Source:       src,
+		SourceZoneID: ready[0],
+		InUse:        make(map[string]int),
+	}
+
+	seen := make(map[string]bool)
+	for _, zoneid := range zoneids {
+		if seen[zoneid] {
+			continue
+		}
+		seen[zoneid] = true
+
+		t, ok := src.Zones[zoneid]
+		switch {
+		case !ok:
+			plan.Copy = append(plan.Copy, zoneid)
+		case !t.Isready:
+			plan.Wait = append(plan.Wait, zoneid)
+		}
+	}
+
+	versions, err := s.Versions(src.Family)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing versions of %s: %v", src.Family, err)
+	}
+
+	var older []*Version
+	for _, v := range versions {
+		if v.ID != src.ID && CompareVersions(v.Version, src.Version) < 0 {
+			older = append(older, v)
+		}
+	}
+
+	for i, v := range older {
+		if !v.Deprecated() {
+			plan.Deprecate = append(plan.Deprecate, v)
+		}
+		if !s.gc || i < s.keep {
+			continue
+		}
+
+		count, err := s.references(v.ID)
+		if err != nil {
+			return nil, fmt.Errorf("Error listing virtual machines using template %s: %v", v.Name, err)
+		}
+		plan.InUse[v.ID] = count
+
+		if count == 0 {
+			plan.Delete = append(plan.Delete, v)
+		}
+	}
+
+	return plan, nil
+}
+
+// references returns the number of virtual machines using the template
+func (s *Syncer) references(id string) (int, error) {
+	p := s.cs.VirtualMachine.NewListVirtualMachinesParams()
+	p.SetTemplateid(id)
+	p.SetListall(true)
+	p.SetPagesize(1)
+	p.SetPage(1)
+	if err := common.ApplyOptions(s.cs, p, s.opts...); err != nil {
+		return 0, err
+	}
+
+	l, err := s.cs.VirtualMachine.ListVirtualMachines(p)
+	if err != nil {
+		return 0, err
+	}
+
+	return l.Count, nil
+}
+
+// ZoneResult is the result of syncing a template to a single zone
+type ZoneResult struct {
+	ZoneID   string
+	Copied   bool // True if a copy was started, false if only waited for
+	JobID    string
+	Template *cloudstack.Template
+	Err      error
+}
+
+// Report is the result of applying a plan
+type Report struct {
+	*Plan
+	Zones      []*ZoneResult
+	Deprecated []*Version
+	Deleted    []*Version
+	Errs       map[string]error // Deprecation and deletion errors keyed by template ID
+	Skipped    string           // Why deprecating and deleting was skipped, if it was
+}
+
+// Failed returns true if anything in the report failed
+func (r *Report) Failed() bool {
+	if len(r.Errs) > 0 {
+		return true
+	}
+	for _, z := range r.Zones {
+		if z.Err != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// Apply applies the plan. The template is copied to the zones in parallel.
+// Older versions are only deprecated and deleted once the template is ready in
+// all zones, so there is never a zone without a usable version.
+func (s *Syncer) Apply(ctx context.Context, plan *Plan) *Report {
+	r := &Report{Plan: plan, Errs: make(map[string]error)}
+
+	var zones []*ZoneResult
+	for _, zoneid := range plan.Copy {
+		zones = append(zones, &ZoneResult{ZoneID: zoneid, Copied: true})
+	}
+	for _, zoneid := range plan.Wait {
+		zones = append(zones, &ZoneResult{ZoneID: zoneid})
+	}
+	r.Zones = zones
+
+	sem := make(chan struct{}, s.parallelism)
+
+	var wg sync.WaitGroup
+	for _, z := range zones {
+		wg.Add(1)
+		go func(z *ZoneResult) {
+			defer wg.Done()
+
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				z.Err = ctx.Err()
+				return
+			}
+
+			s.syncZone(ctx, plan, z)
+		}(z)
+	}
+	wg.Wait()
+
+	for _, z := range zones {
+		if z.Err != nil {
+			r.Skipped = fmt.Sprintf("template %s is not ready in zone %s", plan.Source.Name, z.ZoneID)
+			return r
+		}
+	}
+
+	for _, v := range plan.Deprecate {
+		if err := s.deprecate(v); err != nil {
+			r.Errs[v.ID] = err
+			continue
+		}
+		r.Deprecated = append(r.Deprecated, v)
+	}
+
+	for _, v := range plan.Delete {
+		// Check again, as a virtual machine may have been deployed since planning
+		count, err := s.references(v.ID)
+		if err == nil && count > 0 {
+			err = fmt.Errorf("Template %s is used by %d virtual machine(s)", v.Name, count)
+		}
+		if err == nil {
+			var resp *cloudstack.DeleteTemplateResponse
+			resp, err = s.cs.Template.DeleteTemplate(s.cs.Template.NewDeleteTemplateParams(v.ID))
+			if err == nil {
+				err = s.waiter.WaitForJob(ctx, resp.JobID)
+			}
+		}
+		if err != nil {
+			r.Errs[v.ID] = err
+			continue
+		}
+		r.Deleted = append(r.Deleted, v)
+	}
+
+	return r
+}
+
+// syncZone copies the template to the zone if needed, and waits until it is ready
+func (s *Syncer) syncZone(ctx context.Context, plan *Plan, z *ZoneResult) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	w := waiter.New(s.cs, waiter.WithTemplateFilter(s.templateFilter), waiter.WithOptions(s.opts...))
+
+	if z.Copied {
+		p := s.cs.Template.NewCopyTemplateParams(plan.Source.ID)
+		p.SetSourcezoneid(plan.SourceZoneID)
+		p.SetDestzoneid(z.ZoneID)
+
+		r, err := s.cs.Template.CopyTemplate(p)
+		if err != nil && err != cloudstack.AsyncTimeoutErr {
+			z.Err = fmt.Errorf("Error copying template %s to zone %s: %v", plan.Source.Name, z.ZoneID, err)
+			return
+		}
+		z.JobID = r.JobID
+
+		// Copying large templates easily takes longer than the timeout of an
+		// async client, so the job is waited for using our own timeout.
+		if err := w.WaitForJob(ctx, z.JobID); err != nil {
+			z.Err = err
+			return
+		}
+	}
+
+	z.Template, z.Err = w.WaitForTemplateReady(ctx, plan.Source.ID, z.ZoneID)
+}
+
+// deprecate makes the template private and unfeatured, so it is no longer
+// offered for new deployments while existing virtual machines keep using it.
+// The UpdateTemplate API cannot change these flags, so the permissions of the
+// template are updated instead.
+func (s *Syncer) deprecate(v *Version) error {
+	p := s.cs.Template.NewUpdateTemplatePermissionsParams(v.ID)
+	p.SetIspublic(false)
+	p.SetIsfeatured(false)
+
+	_, err := s.cs.Template.UpdateTemplatePermissions(p)
+	return err
+}
+
+// Sync plans and applies syncing the template to the zones in one go
+func (s *Syncer) Sync(ctx context.Context, id string, zoneids []string) (*Report, error) {
+	plan, err := s.Plan(id, zoneids)
+	if err != nil {
+		return nil, err
+	}
+	return s.Apply(ctx, plan), nil
+}
+
+// Zones returns the IDs of all enabled zones, which is a convenient target set
+// for templates that should be available everywhere
+func (s *Syncer) Zones() ([]string, error) {
+	p := s.cs.Zone.NewListZonesParams()
+	p.SetAvailable(true)
+	if err := common.ApplyOptions(s.cs, p, s.opts...); err != nil {
+		return nil, err
+	}
+
+	l, err := s.cs.Zone.ListZones(p)
+	if err != nil {
+		return nil, err
+	}
+
+	var zoneids []string
+	for _, z := range l.Zones {
+		if z.Allocationstate == "" || z.Allocationstate == "Enabled" {
+			zoneids = append(zoneids, z.Id)
+		}
+	}
+	sort.Strings(zoneids)
+
+	return zoneids, nil
+}
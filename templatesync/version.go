@@ -0,0 +1,145 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package templatesync
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xanzy/go-cloudstack/v2/cloudstack"
+)
+
+// createdLayout is the layout of the created field returned by CloudStack
+const createdLayout = "2006-01-02T15:04:05-0700"
+
+// Version is a single version of a template family. CloudStack returns a
+// separate template entry for every zone a template is registered in, so the
+// entries are grouped by zone.
+type Version struct {
+	ID      string
+	Name    string
+	Family  string
+	Version string
+	Created time.Time
+	Zones   map[string]*cloudstack.Template // Template entries keyed by zone ID
+}
+
+// ReadyZones returns the IDs of the zones the template is ready in
+func (v *Version) ReadyZones() []string {
+	var zones []string
+	for id, t := range v.Zones {
+		if t.Isready {
+			zones = append(zones, id)
+		}
+	}
+	sort.Strings(zones)
+	return zones
+}
+
+// Deprecated returns true if the template is neither public nor featured in
+// any of its zones
+func (v *Version) Deprecated() bool {
+	for _, t := range v.Zones {
+		if t.Ispublic || t.Isfeatured {
+			return false
+		}
+	}
+	return true
+}
+
+// add adds the entry of a single zone to the version
+func (v *Version) add(t *cloudstack.Template) {
+	if v.Zones == nil {
+		v.Zones = make(map[string]*cloudstack.Template)
+	}
+	v.Zones[t.Zoneid] = t
+
+	if created, err := time.Parse(createdLayout, t.Created); err == nil {
+		if v.Created.IsZero() || created.Before(v.Created) {
+			v.Created = created
+		}
+	}
+}
+
+// CompareVersions compares two version strings and returns -1, 0 or 1. The
+// versions are split on dots, dashes and underscores, and numeric parts are
+// compared as numbers, so "1.10" is newer than "1.9" and "2024.01.15" is newer
+// than "2023.12.31". Non-numeric parts are compared as strings.
+func CompareVersions(a, b string) int {
+	split := func(s string) []string {
+		return strings.FieldsFunc(s, func(r rune) bool {
+			return r == '.' || r == '-' || r == '_'
+		})
+	}
+
+	pa, pb := split(a), split(b)
+	for i := 0; i < len(pa) && i < len(pb); i++ {
+		na, erra := strconv.ParseUint(pa[i], 10, 64)
+		nb, errb := strconv.ParseUint(pb[i], 10, 64)
+
+		switch {
+		case erra == nil && errb == nil:
+			if na != nb {
+				return compare(na < nb)
+			}
+		case pa[i] != pb[i]:
+			return compare(pa[i] < pb[i])
+		}
+	}
+
+	switch {
+	case len(pa) < len(pb):
+		return -1
+	case len(pa) > len(pb):
+		return 1
+	default:
+		return 0
+	}
+}
+
+func compare(less bool) int {
+	if less {
+		return -1
+	}
+	return 1
+}
+
+// sortVersions sorts the versions from newest to oldest, using the creation
+// time for versions with equal version strings
+func sortVersions(versions []*Version) {
+	sort.SliceStable(versions, func(i, j int) bool {
+		if c := CompareVersions(versions[i].Version, versions[j].Version); c != 0 {
+			return c > 0
+		}
+		return versions[i].Created.After(versions[j].Created)
+	})
+}
+
+// tagValue returns the value of the tag with the given key
+func tagValue(tags []cloudstack.Tags, key string) string {
+	for _, t := range tags {
+		if t.Key == key {
+			return t.Value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,117 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package templatesync
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/xanzy/go-cloudstack/v2/cloudstack"
+)
+
+func TestCompareVersions(t *testing.T) {
+	cases := []struct {
+		a, b string
+		want int
+	}{
+		{"1.0", "1.0", 0},
+		{"1.10", "1.9", 1},
+		{"1.9", "1.10", -1},
+		{"2024.01.15", "2023.12.31", 1},
+		{"1.0", "1.0.1", -1},
+		{"1.0-rc2", "1.0-rc1", 1},
+		{"1_2", "1.2", 0},
+		{"1.a", "1.10", 1},
+	}
+
+	for _, c := range cases {
+		if got := CompareVersions(c.a, c.b); got != c.want {
+			t.Errorf("CompareVersions(%q, %q) = %d, want %d", c.a, c.b, got, c.want)
+		}
+	}
+}
+
+func template(id, zoneid, version, created string) *cloudstack.Template {
+	return &cloudstack.Template{
+		Id:      id,
+		Name:    "ubuntu-" + version,
+		Zoneid:  zoneid,
+		Created: created,
+		Tags: []cloudstack.Tags{
+			{Key: DefaultFamilyTag, Value: "ubuntu"},
+			{Key: DefaultVersionTag, Value: version},
+		},
+	}
+}
+
+func TestGroup(t *testing.T) {
+	s := New(nil)
+
+	versions := s.group([]*cloudstack.Template{
+		template("t1", "z1", "20.04", "2020-04-23T10:00:00+0000"),
+		template("t2", "z1", "22.04", "2022-04-21T10:00:00+0000"),
+		template("t1", "z2", "20.04", "2020-04-20T10:00:00+0000"),
+		template("t3", "z1", "22.04", "2022-05-01T10:00:00+0000"),
+		template("t4", "z1", "22.10", "2022-10-20T10:00:00+0000"),
+	})
+
+	var ids []string
+	for _, v := range versions {
+		ids = append(ids, v.ID)
+	}
+
+	// Newest version first, and the newest template of equal versions first
+	if strings.Join(ids, ",") != "t4,t3,t2,t1" {
+		t.Fatalf("Expected versions t4,t3,t2,t1, got %v", ids)
+	}
+
+	t1 := versions[3]
+	if t1.Family != "ubuntu" || t1.Version != "20.04" {
+		t.Fatalf("Expected family ubuntu and version 20.04, got %s and %s", t1.Family, t1.Version)
+	}
+	if len(t1.Zones) != 2 {
+		t.Fatalf("Expected the entries of both zones, got %d", len(t1.Zones))
+	}
+	if got := t1.Created.Format(createdLayout); got != "2020-04-20T10:00:00+0000" {
+		t.Fatalf("Expected the oldest creation time of all zones, got %s", got)
+	}
+}
+
+func TestVersionZones(t *testing.T) {
+	v := &Version{}
+	for _, zone := range []string{"z2", "z1", "z3"} {
+		t := template("t1", zone, "1.0", "")
+		t.Isready = zone != "z3"
+		v.add(t)
+	}
+
+	if got := v.ReadyZones(); !reflect.DeepEqual(got, []string{"z1", "z2"}) {
+		t.Fatalf("Expected ready zones [z1 z2], got %v", got)
+	}
+
+	if !v.Deprecated() {
+		t.Fatal("Expected a template that is neither public nor featured to be deprecated")
+	}
+	v.Zones["z1"].Isfeatured = true
+	if v.Deprecated() {
+		t.Fatal("Expected a featured template not to be deprecated")
+	}
+}
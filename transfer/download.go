@@ -0,0 +1,417 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package transfer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/xanzy/go-cloudstack/v2/cloudstack"
+	"github.com/xanzy/go-cloudstack/v2/waiter"
+)
+
+// DefaultRetries is the default number of times an interrupted download is resumed
+const DefaultRetries = 3
+
+// ErrChecksumMismatch is returned when the checksum of a downloaded file does
+// not match the expected checksum
+var ErrChecksumMismatch = errors.New("Checksum mismatch")
+
+// Kind is the kind of artifact that can be extracted
+type Kind string
+
+// The kinds of artifacts that can be extracted
+const (
+	Template Kind = "template"
+	ISO      Kind = "iso"
+	Volume   Kind = "volume"
+)
+
+// Artifact is a template, ISO or volume to download
+type Artifact struct {
+	Kind   Kind
+	ID     string
+	ZoneID string // Required for volumes, optional for templates and ISOs
+
+	// Checksum is the expected checksum in the CloudStack format. If empty, the
+	// download is not verified. The checksum registered for a template or ISO is
+	// not used for this, as it is often the checksum of the (compressed) file the
+	// template was registered from instead of the extracted file.
+	Checksum string
+}
+
+// DownloadResult is the result of a download
+type DownloadResult struct {
+	URL      string
+	Size     int64
+	Checksum string // The computed checksum in the CloudStack format
+	Verified bool   // True if the checksum was compared with an expected checksum
+
+	// RegisteredChecksum is the checksum registered for the template or ISO, if
+	// any. It is only reported, as it does not necessarily match the download.
+	RegisteredChecksum string
+}
+
+// parseChecksum splits a checksum in the CloudStack format into its algorithm
+// and its hex encoded value
+func parseChecksum(checksum string) (ChecksumAlgorithm, string) {
+	if strings.HasPrefix(checksum, "{") {
+		if i := strings.Index(checksum, "}"); i > 0 {
+			return ChecksumAlgorithm(strings.ToUpper(checksum[1:i])), strings.ToLower(checksum[i+1:])
+		}
+	}
+	return MD5, strings.ToLower(checksum)
+}
+
+// Extract extracts the artifact and returns the URL it can be downloaded from
+func Extract(ctx context.Context, cs *cloudstack.CloudStackClient, a Artifact, opts ...Option) (string, error) {
+	o := newOptions(cs, opts)
+
+	ctx, cancel := context.WithTimeout(ctx, o.timeout)
+	defer cancel()
+
+	var r struct {
+		JobID  string `json:"jobid"`
+		State  string `json:"state"`
+		Status string `json:"status"`
+		Url    string `json:"url"`
+	}
+
+	var err error
+	switch a.Kind {
+	case Template:
+		p := cs.Template.NewExtractTemplateParams(a.ID, "HTTP_DOWNLOAD")
+		if a.ZoneID != "" {
+			p.SetZoneid(a.ZoneID)
+		}
+		var resp *cloudstack.ExtractTemplateResponse
+		if resp, err = cs.Template.ExtractTemplate(p); err == nil {
+			r.JobID, r.State, r.Status, r.Url = resp.JobID, resp.State, resp.Status, resp.Url
+		}
+	case ISO:
+		p := cs.ISO.NewExtractIsoParams(a.ID, "HTTP_DOWNLOAD")
+		if a.ZoneID != "" {
+			p.SetZoneid(a.ZoneID)
+		}
+		var resp *cloudstack.ExtractIsoResponse
+		if resp, err = cs.ISO.ExtractIso(p); err == nil {
+			r.JobID, r.State, r.Status, r.Url = resp.JobID, resp.State, resp.Status, resp.Url
+		}
+	case Volume:
+		var resp *cloudstack.ExtractVolumeResponse
+		if resp, err = cs.Volume.ExtractVolume(cs.Volume.NewExtractVolumeParams(a.ID, "HTTP_DOWNLOAD", a.ZoneID)); err == nil {
+			r.JobID, r.State, r.Status, r.Url = resp.JobID, resp.State, resp.Status, resp.Url
+		}
+	default:
+		return "", fmt.Errorf("Unknown artifact kind: %s", a.Kind)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	// Without an async client only the job ID is returned
+	if r.Url == "" {
+		if err := waiter.New(cs).WaitForJobInto(ctx, r.JobID, &r); err != nil {
+			return "", err
+		}
+	}
+
+	if strings.Contains(strings.ToUpper(r.State+r.Status), "ERROR") || r.Url == "" {
+		return "", fmt.Errorf("Extracting %s %s failed (state: %s, status: %s)", a.Kind, a.ID, r.State, r.Status)
+	}
+
+	return r.Url, nil
+}
+
+// registeredChecksum returns the checksum registered for the template or ISO
+func registeredChecksum(cs *cloudstack.CloudStackClient, a Artifact) string {
+	// This is best effort, as not every template or ISO has a checksum and the
+	// caller might not be allowed to list all templates
+	switch a.Kind {
+	case Template:
+		p := cs.Template.NewListTemplatesParams("all")
+		p.SetId(a.ID)
+		if l, err := cs.Template.ListTemplates(p); err == nil && l.Count > 0 {
+			return l.Templates[0].Checksum
+		}
+	case ISO:
+		p := cs.ISO.NewListIsosParams()
+		p.SetId(a.ID)
+		if l, err := cs.ISO.ListIsos(p); err == nil && l.Count > 0 {
+			return l.Isos[0].Checksum
+		}
+	}
+
+	return ""
+}
+
+// Download extracts the artifact and streams it to w. Interrupted downloads are
+// resumed using HTTP Range requests.
+func Download(ctx context.Context, cs *cloudstack.CloudStackClient, a Artifact, w io.Writer, opts ...Option) (*DownloadResult, error) {
+	url, err := Extract(ctx, cs, a, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := DownloadURL(ctx, cs, url, w, a.Checksum, opts...)
+	if err != nil {
+		return nil, err
+	}
+	r.RegisteredChecksum = registeredChecksum(cs, a)
+
+	return r, nil
+}
+
+// DownloadToFile extracts the artifact and downloads it to the given path. The
+// data is written to path+".part" first, which is renamed once the download is
+// verified. If the .part file already exists, the download is resumed from the
+// end of that file.
+func DownloadToFile(ctx context.Context, cs *cloudstack.CloudStackClient, a Artifact, path string, opts ...Option) (*DownloadResult, error) {
+	url, err := Extract(ctx, cs, a, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	part := path + ".part"
+	f, err := os.OpenFile(part, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	r, err := download(ctx, newOptions(cs, opts), url, f, a.Checksum, true)
+	if err != nil {
+		// Resuming a download that does not match the checksum of the caller
+		// would only fail again
+		if errors.Is(err, ErrChecksumMismatch) {
+			f.Close()
+			os.Remove(part)
+		}
+		return nil, err
+	}
+
+	if err := f.Close(); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(part, path); err != nil {
+		return nil, err
+	}
+	r.RegisteredChecksum = registeredChecksum(cs, a)
+
+	return r, nil
+}
+
+// DownloadURL streams the file at url (e.g. as returned by Extract) to w and
+// verifies its size and, if one is given, its checksum
+func DownloadURL(ctx context.Context, cs *cloudstack.CloudStackClient, url string, w io.Writer, checksum string, opts ...Option) (*DownloadResult, error) {
+	return download(ctx, newOptions(cs, opts), url, w, checksum, false)
+}
+
+// download does the actual download. When resume is true, w must be a file of
+// which the existing contents are kept and downloaded data is appended.
+func download(ctx context.Context, o *options, url string, w io.Writer, checksum string, resume bool) (*DownloadResult, error) {
+	alg := o.algorithm
+	if alg == "" {
+		alg = MD5
+	}
+	var expected string
+	if checksum != "" {
+		alg, expected = parseChecksum(checksum)
+	}
+
+	h, err := alg.hash()
+	if err != nil {
+		return nil, err
+	}
+
+	var offset int64
+	if resume {
+		f := w.(*os.File)
+		if offset, err = io.Copy(h, f); err != nil {
+			return nil, err
+		}
+	}
+
+	out := &progressWriter{w: io.MultiWriter(w, h), n: offset, total: -1, progress: o.progress}
+
+	retries := o.retries
+	for {
+		err = fetch(ctx, o.client, url, out)
+		if err == nil || ctx.Err() != nil || retries == 0 {
+			break
+		}
+		retries--
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if out.total >= 0 && out.n != out.total {
+		return nil, fmt.Errorf("Downloaded %d bytes, expected %d bytes", out.n, out.total)
+	}
+
+	r := &DownloadResult{
+		URL:      url,
+		Size:     out.n,
+		Checksum: alg.format(h.Sum(nil)),
+	}
+
+	if expected != "" {
+		if _, got := parseChecksum(r.Checksum); got != expected {
+			return nil, fmt.Errorf("%w: got %s, expected %s", ErrChecksumMismatch, r.Checksum, checksum)
+		}
+		r.Verified = true
+	}
+
+	return r, nil
+}
+
+// fetch requests the remaining part of the file, starting at the number of bytes
+// already written, and copies it to out
+func fetch(ctx context.Context, client *http.Client, url string, out *progressWriter) error {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+
+	if out.n > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", out.n))
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	var body io.Reader = resp.Body
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		out.total = resp.ContentLength
+		// The server does not support ranges, so skip what we already have
+		if out.n > 0 {
+			if _, err := io.CopyN(ioutil.Discard, body, out.n); err != nil {
+				return err
+			}
+		}
+	case http.StatusPartialContent:
+		out.total = totalFromContentRange(resp.Header.Get("Content-Range"))
+	case http.StatusRequestedRangeNotSatisfiable:
+		// Everything was downloaded already
+		if total := totalFromContentRange(resp.Header.Get("Content-Range")); total == out.n {
+			out.total = total
+			return nil
+		}
+		return fmt.Errorf("Download failed with status %s", resp.Status)
+	default:
+		return fmt.Errorf("Download failed with status %s", resp.Status)
+	}
+
+	_, err = io.Copy(out, body)
+	return err
+}
+
+// totalFromContentRange returns the total size from a Content-Range header
+// (e.g. "bytes 100-199/200"), or -1 if it is unknown
+func totalFromContentRange(cr string) int64 {
+	i := strings.LastIndex(cr, "/")
+	if i < 0 {
+		return -1
+	}
+	total, err := strconv.ParseInt(cr[i+1:], 10, 64)
+	if err != nil {
+		return -1
+	}
+	return total
+}
+
+// progressWriter counts the bytes written and calls the progress function
+type progressWriter struct {
+	w        io.Writer
+	n        int64
+	total    int64
+	progress ProgressFunc
+}
+
+func (pw *progressWriter) Write(b []byte) (int, error) {
+	n, err := pw.w.Write(b)
+	pw.n += int64(n)
+	if pw.progress != nil && n > 0 {
+		pw.progress(pw.n, pw.total)
+	}
+	return n, err
+}
+
+// Job is a single download of a DownloadAll call
+type Job struct {
+	Artifact Artifact
+	Path     string
+}
+
+// JobResult is the result of a single download of a DownloadAll call
+type JobResult struct {
+	Job
+	*DownloadResult
+	Err error
+}
+
+// DownloadAll downloads the artifacts to files, running the given number of
+// downloads at the same time
+func DownloadAll(ctx context.Context, cs *cloudstack.CloudStackClient, jobs []Job, parallelism int, opts ...Option) []*JobResult {
+	if parallelism <= 0 {
+		parallelism = 1
+	}
+
+	results := make([]*JobResult, len(jobs))
+	sem := make(chan struct{}, parallelism)
+
+	var wg sync.WaitGroup
+	for i, job := range jobs {
+		results[i] = &JobResult{Job: job}
+
+		wg.Add(1)
+		go func(r *JobResult) {
+			defer wg.Done()
+
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				r.Err = ctx.Err()
+				return
+			}
+
+			r.DownloadResult, r.Err = DownloadToFile(ctx, cs, r.Artifact, r.Path, opts...)
+		}(results[i])
+	}
+	wg.Wait()
+
+	return results
+}
@@ -0,0 +1,233 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package transfer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/xanzy/go-cloudstack/v2/cloudstack"
+)
+
+const (
+	content = "0123456789abcdefghijklmnopqrstuvwxyz"
+	md5sum  = "e9b1713db620f1e3a14b6812de523f4b"
+)
+
+func TestParseChecksum(t *testing.T) {
+	cases := map[string][2]string{
+		"AB72C3":          {"MD5", "ab72c3"},
+		"{SHA-256}AB72C3": {"SHA-256", "ab72c3"},
+		"{sha-1}ab72c3":   {"SHA-1", "ab72c3"},
+		"{ab72c3":         {"MD5", "{ab72c3"},
+	}
+
+	for checksum, want := range cases {
+		alg, value := parseChecksum(checksum)
+		if string(alg) != want[0] || value != want[1] {
+			t.Errorf("parseChecksum(%q) = %s, %s; want %s, %s", checksum, alg, value, want[0], want[1])
+		}
+	}
+}
+
+func TestTotalFromContentRange(t *testing.T) {
+	cases := map[string]int64{
+		"bytes 100-199/200": 200,
+		"bytes */200":       200,
+		"bytes 100-199/*":   -1,
+		"":                  -1,
+	}
+
+	for cr, want := range cases {
+		if got := totalFromContentRange(cr); got != want {
+			t.Errorf("totalFromContentRange(%q) = %d, want %d", cr, got, want)
+		}
+	}
+}
+
+// newFileServer serves the content with support for ranges. The first failures
+// requests are aborted halfway through the content.
+func newFileServer(failures int32) (*httptest.Server, *int32) {
+	var requests int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) <= failures {
+			w.Header().Set("Content-Length", "36")
+			w.Write([]byte(content[:10]))
+			panic(http.ErrAbortHandler)
+		}
+		http.ServeContent(w, r, "file", time.Time{}, strings.NewReader(content))
+	}))
+
+	return srv, &requests
+}
+
+func TestDownloadResume(t *testing.T) {
+	srv, requests := newFileServer(1)
+	defer srv.Close()
+
+	var buf bytes.Buffer
+	o := &options{client: srv.Client(), algorithm: MD5, retries: 1}
+
+	r, err := download(context.Background(), o, srv.URL, &buf, md5sum, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if buf.String() != content || r.Size != int64(len(content)) {
+		t.Fatalf("Expected the full content, got %q", buf.String())
+	}
+	if !r.Verified || r.Checksum != md5sum {
+		t.Fatalf("Expected a verified checksum %s, got %s", md5sum, r.Checksum)
+	}
+	if n := atomic.LoadInt32(requests); n != 2 {
+		t.Fatalf("Expected the download to be resumed once, got %d requests", n)
+	}
+}
+
+func TestDownloadRetriesExhausted(t *testing.T) {
+	srv, _ := newFileServer(2)
+	defer srv.Close()
+
+	o := &options{client: srv.Client(), algorithm: MD5, retries: 1}
+
+	if _, err := download(context.Background(), o, srv.URL, ioutil.Discard, "", false); err == nil {
+		t.Fatal("Expected an error when all retries fail")
+	}
+}
+
+func TestDownloadChecksumMismatch(t *testing.T) {
+	srv, _ := newFileServer(0)
+	defer srv.Close()
+
+	o := &options{client: srv.Client(), algorithm: MD5}
+
+	_, err := download(context.Background(), o, srv.URL, ioutil.Discard, "{SHA-256}00", false)
+	if !errors.Is(err, ErrChecksumMismatch) {
+		t.Fatalf("Expected a checksum mismatch, got: %v", err)
+	}
+}
+
+func TestDownloadResumeFile(t *testing.T) {
+	srv, _ := newFileServer(0)
+	defer srv.Close()
+
+	f, err := ioutil.TempFile("", "transfer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	// A partial download from an earlier run
+	if _, err := f.WriteString(content[:20]); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.Seek(0, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	o := &options{client: srv.Client(), algorithm: MD5}
+
+	r, err := download(context.Background(), o, srv.URL, f, md5sum, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !r.Verified {
+		t.Fatal("Expected the checksum to be verified over the whole file")
+	}
+
+	b, err := ioutil.ReadFile(f.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != content {
+		t.Fatalf("Expected the full content, got %q", b)
+	}
+
+	// Resuming a complete file gets a 416 from the server
+	if _, err := f.Seek(0, 0); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := download(context.Background(), o, srv.URL, f, md5sum, true); err != nil {
+		t.Fatalf("Expected a complete file to verify, got: %v", err)
+	}
+}
+
+func TestDownloadToFile(t *testing.T) {
+	files, _ := newFileServer(0)
+	defer files.Close()
+
+	// The registered checksum is of the file the template was registered from
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("command") {
+		case "extractTemplate":
+			w.Write([]byte(`{"extracttemplateresponse":{"jobid":"extract"}}`))
+		case "queryAsyncJobResult":
+			fmt.Fprintf(w, `{"queryasyncjobresultresponse":{"jobstatus":1,"jobresult":{"template":{"state":"DOWNLOAD_URL_CREATED","url":"%s"}}}}`, files.URL)
+		case "listTemplates":
+			w.Write([]byte(`{"listtemplatesresponse":{"count":1,"template":[{"id":"t1","checksum":"{SHA-256}00"}]}}`))
+		default:
+			t.Errorf("Unexpected command: %s", r.URL.Query().Get("command"))
+		}
+	}))
+	defer api.Close()
+
+	dir, err := ioutil.TempDir("", "transfer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	cs := cloudstack.NewClient(api.URL, "key", "secret", false)
+	path := filepath.Join(dir, "template.qcow2")
+
+	r, err := DownloadToFile(context.Background(), cs, Artifact{Kind: Template, ID: "t1"}, path)
+	if err != nil {
+		t.Fatalf("Expected the registered checksum not to be verified, got: %v", err)
+	}
+	if r.Verified || r.Checksum != md5sum || r.RegisteredChecksum != "{SHA-256}00" {
+		t.Fatalf("Expected an unverified download reporting the registered checksum, got %+v", r)
+	}
+	if b, err := ioutil.ReadFile(path); err != nil || string(b) != content {
+		t.Fatalf("Expected the full content, got %q (%v)", b, err)
+	}
+
+	// A checksum passed by the caller is verified
+	path = filepath.Join(dir, "other.qcow2")
+	_, err = DownloadToFile(context.Background(), cs, Artifact{Kind: Template, ID: "t1", Checksum: "{SHA-256}00"}, path)
+	if !errors.Is(err, ErrChecksumMismatch) {
+		t.Fatalf("Expected a checksum mismatch, got: %v", err)
+	}
+	if _, err := os.Stat(path + ".part"); !os.IsNotExist(err) {
+		t.Fatalf("Expected the partial file to be removed, got: %v", err)
+	}
+}
@@ -0,0 +1,204 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+// Package transfer streams templates and volumes between local files (or any
+// io.Reader) and the secondary storage of CloudStack, without holding them in
+// memory.
+package transfer
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/xanzy/go-cloudstack/v2/cloudstack"
+)
+
+// DefaultTimeout is the default time to wait for an uploaded template or volume
+// to become ready
+const DefaultTimeout = time.Hour
+
+// ChecksumAlgorithm is an algorithm CloudStack can verify checksums with
+type ChecksumAlgorithm string
+
+// The supported checksum algorithms. MD5 checksums are passed as plain hex
+// strings, all others are prefixed with the name of the algorithm (e.g.
+// "{SHA-256}ab12..."), which is the format CloudStack expects.
+const (
+	MD5    ChecksumAlgorithm = "MD5"
+	SHA1   ChecksumAlgorithm = "SHA-1"
+	SHA256 ChecksumAlgorithm = "SHA-256"
+	SHA512 ChecksumAlgorithm = "SHA-512"
+)
+
+func (a ChecksumAlgorithm) hash() (hash.Hash, error) {
+	switch a {
+	case MD5:
+		return md5.New(), nil
+	case SHA1:
+		return sha1.New(), nil
+	case SHA256:
+		return sha256.New(), nil
+	case SHA512:
+		return sha512.New(), nil
+	default:
+		return nil, fmt.Errorf("Unsupported checksum algorithm: %s", a)
+	}
+}
+
+// format returns the checksum in the format used by CloudStack
+func (a ChecksumAlgorithm) format(sum []byte) string {
+	if a == MD5 {
+		return hex.EncodeToString(sum)
+	}
+	return fmt.Sprintf("{%s}%s", a, hex.EncodeToString(sum))
+}
+
+// Checksum reads r until EOF and returns its checksum in the CloudStack format
+func Checksum(r io.Reader, alg ChecksumAlgorithm) (string, error) {
+	h, err := alg.hash()
+	if err != nil {
+		return "", err
+	}
+	if _, err := io.Copy(h, r); err != nil {
+		return "", err
+	}
+	return alg.format(h.Sum(nil)), nil
+}
+
+// ProgressFunc is called while transferring, with the number of bytes that were
+// transferred so far and the total number of bytes (or -1 if unknown)
+type ProgressFunc func(transferred, total int64)
+
+// progressReader calls the progress function while reading
+type progressReader struct {
+	r        io.Reader
+	n        int64
+	total    int64
+	progress ProgressFunc
+}
+
+func (pr *progressReader) Read(b []byte) (int, error) {
+	n, err := pr.r.Read(b)
+	pr.n += int64(n)
+	if pr.progress != nil && n > 0 {
+		pr.progress(pr.n, pr.total)
+	}
+	return n, err
+}
+
+// Option can be passed to the upload and download functions to set custom options
+type Option func(*options)
+
+type options struct {
+	client    *http.Client
+	progress  ProgressFunc
+	algorithm ChecksumAlgorithm
+	timeout   time.Duration
+	retries   int
+}
+
+func newOptions(cs *cloudstack.CloudStackClient, opts []Option) *options {
+	o := &options{
+		client:    transferClient(cs.HTTPClient()),
+		algorithm: MD5,
+		timeout:   DefaultTimeout,
+		retries:   DefaultRetries,
+	}
+
+	for _, fn := range opts {
+		fn(o)
+	}
+
+	return o
+}
+
+// transferClient returns a copy of the HTTP client of the API to transfer data
+// to and from the secondary storage VMs
+func transferClient(c *http.Client) *http.Client {
+	// Transfers of large files take much longer than API calls, so the overall
+	// timeout of the client is dropped and the context is used instead.
+	client := *c
+	client.Timeout = 0
+
+	// The secondary storage VMs have their own certificates and addresses, so
+	// the server name and pinned public keys of the API do not apply to them
+	if t, ok := c.Transport.(*http.Transport); ok && t.TLSClientConfig != nil {
+		t = t.Clone()
+		t.TLSClientConfig.ServerName = ""
+		t.TLSClientConfig.VerifyPeerCertificate = nil
+		client.Transport = t
+	}
+
+	return &client
+}
+
+// WithProgress sets a function that is called while transferring
+func WithProgress(fn ProgressFunc) Option {
+	return func(o *options) {
+		o.progress = fn
+	}
+}
+
+// WithChecksumAlgorithm sets the algorithm used to compute checksums (default:
+// MD5). An empty algorithm disables computing checksums for uploads.
+func WithChecksumAlgorithm(alg ChecksumAlgorithm) Option {
+	return func(o *options) {
+		o.algorithm = alg
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to transfer the data. By default a
+// copy of the HTTP client of the CloudStack client is used, so its CA certificates
+// and other TLS settings apply. Only the server name and pinned public keys are
+// dropped, as those are specific to the API.
+func WithHTTPClient(client *http.Client) Option {
+	return func(o *options) {
+		if client != nil {
+			o.client = client
+		}
+	}
+}
+
+// WithRetries sets the number of times an interrupted download is resumed
+func WithRetries(n int) Option {
+	return func(o *options) {
+		if n >= 0 {
+			o.retries = n
+		}
+	}
+}
+
+// WithTimeout sets the time to wait for CloudStack to finish its part of the
+// transfer (e.g. for an uploaded template to become ready, or for an extract job
+// to finish)
+func WithTimeout(timeout time.Duration) Option {
+	return func(o *options) {
+		if timeout != 0 {
+			o.timeout = timeout
+		}
+	}
+}
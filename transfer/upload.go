@@ -0,0 +1,229 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package transfer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/xanzy/go-cloudstack/v2/cloudstack"
+	"github.com/xanzy/go-cloudstack/v2/waiter"
+)
+
+// uploadParams are the parameters returned by getUploadParamsForTemplate and
+// getUploadParamsForVolume
+type uploadParams struct {
+	PostURL   string
+	Signature string
+	Metadata  string
+	Expires   string
+}
+
+// UploadTemplateFromFile uploads a local file as a template and waits until the
+// template is ready. The checksum of the file is computed and set before the
+// upload params are requested, unless checksums are disabled.
+func UploadTemplateFromFile(ctx context.Context, cs *cloudstack.CloudStackClient, p *cloudstack.GetUploadParamsForTemplateParams, path string, opts ...Option) (*cloudstack.Template, error) {
+	f, size, err := open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return UploadTemplate(ctx, cs, p, f, size, opts...)
+}
+
+// UploadTemplate uploads the contents of r as a template and waits until the
+// template is ready. If r is an io.ReadSeeker its checksum is computed first;
+// otherwise the checksum can be set in the params by the caller. If the size is
+// not known it can be -1, but not all secondary storage VMs accept uploads
+// without a content length.
+func UploadTemplate(ctx context.Context, cs *cloudstack.CloudStackClient, p *cloudstack.GetUploadParamsForTemplateParams, r io.Reader, size int64, opts ...Option) (*cloudstack.Template, error) {
+	o := newOptions(cs, opts)
+
+	checksum, err := o.checksum(r)
+	if err != nil {
+		return nil, err
+	}
+	if checksum != "" {
+		p.SetChecksum(checksum)
+	}
+
+	up, err := cs.Template.GetUploadParamsForTemplate(p)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &uploadParams{PostURL: up.PostURL, Signature: up.Signature, Metadata: up.Metadata, Expires: up.Expires}
+	if err := o.post(ctx, params, filename(r, up.Id), r, size); err != nil {
+		return nil, fmt.Errorf("Error uploading template %s: %v", up.Id, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, o.timeout)
+	defer cancel()
+
+	return waiter.New(cs, waiter.WithTemplateFilter("self")).WaitForTemplateReady(ctx, up.Id, "")
+}
+
+// UploadVolumeFromFile uploads a local file as a volume and waits until the
+// volume is uploaded. The checksum of the file is computed and set before the
+// upload params are requested, unless checksums are disabled.
+func UploadVolumeFromFile(ctx context.Context, cs *cloudstack.CloudStackClient, p *cloudstack.GetUploadParamsForVolumeParams, path string, opts ...Option) (*cloudstack.Volume, error) {
+	f, size, err := open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return UploadVolume(ctx, cs, p, f, size, opts...)
+}
+
+// UploadVolume uploads the contents of r as a volume and waits until the volume
+// is uploaded. Checksums and sizes are handled the same way as by UploadTemplate.
+func UploadVolume(ctx context.Context, cs *cloudstack.CloudStackClient, p *cloudstack.GetUploadParamsForVolumeParams, r io.Reader, size int64, opts ...Option) (*cloudstack.Volume, error) {
+	o := newOptions(cs, opts)
+
+	checksum, err := o.checksum(r)
+	if err != nil {
+		return nil, err
+	}
+	if checksum != "" {
+		p.SetChecksum(checksum)
+	}
+
+	up, err := cs.Volume.GetUploadParamsForVolume(p)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &uploadParams{PostURL: up.PostURL, Signature: up.Signature, Metadata: up.Metadata, Expires: up.Expires}
+	if err := o.post(ctx, params, filename(r, up.Id), r, size); err != nil {
+		return nil, fmt.Errorf("Error uploading volume %s: %v", up.Id, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, o.timeout)
+	defer cancel()
+
+	return waiter.New(cs).WaitForVolumeState(ctx, up.Id, "Uploaded", "Ready")
+}
+
+func open(path string) (*os.File, int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, 0, err
+	}
+
+	return f, fi.Size(), nil
+}
+
+// filename returns the name of the file being uploaded, or the given default
+func filename(r io.Reader, def string) string {
+	if f, ok := r.(*os.File); ok {
+		return filepath.Base(f.Name())
+	}
+	return def
+}
+
+// checksum computes the checksum of r if it can be rewound afterwards
+func (o *options) checksum(r io.Reader) (string, error) {
+	rs, ok := r.(io.ReadSeeker)
+	if !ok || o.algorithm == "" {
+		return "", nil
+	}
+
+	start, err := rs.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return "", err
+	}
+
+	checksum, err := Checksum(rs, o.algorithm)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := rs.Seek(start, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	return checksum, nil
+}
+
+// post streams r to the secondary storage VM as a multipart form, the same way
+// the CloudStack UI uploads files from the browser
+func (o *options) post(ctx context.Context, up *uploadParams, name string, r io.Reader, size int64) error {
+	// Render the multipart envelope without the file contents, so the body can
+	// be streamed from r with a known content length.
+	var envelope bytes.Buffer
+	mw := multipart.NewWriter(&envelope)
+	if _, err := mw.CreateFormFile("file", name); err != nil {
+		return err
+	}
+	head := append([]byte(nil), envelope.Bytes()...)
+	envelope.Reset()
+	if err := mw.Close(); err != nil {
+		return err
+	}
+	tail := envelope.Bytes()
+
+	body := io.MultiReader(
+		bytes.NewReader(head),
+		&progressReader{r: r, total: size, progress: o.progress},
+		bytes.NewReader(tail),
+	)
+
+	req, err := http.NewRequest("POST", up.PostURL, body)
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	req.Header.Set("X-signature", up.Signature)
+	req.Header.Set("X-metadata", up.Metadata)
+	req.Header.Set("X-expires", up.Expires)
+	if size >= 0 {
+		req.ContentLength = int64(len(head)) + size + int64(len(tail))
+	}
+
+	resp, err := o.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 4096))
+		return fmt.Errorf("Upload failed with status %s: %s", resp.Status, bytes.TrimSpace(msg))
+	}
+
+	return nil
+}
@@ -0,0 +1,170 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package transfer
+
+import (
+	"context"
+	"crypto/x509"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/xanzy/go-cloudstack/v2/cloudstack"
+)
+
+func TestChecksum(t *testing.T) {
+	cases := map[ChecksumAlgorithm]string{
+		MD5:    "5d41402abc4b2a76b9719d911017c592",
+		SHA1:   "{SHA-1}aaf4c61ddcc5e8a2dabede0f3b482cd9aea9434d",
+		SHA256: "{SHA-256}2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824",
+	}
+
+	for alg, want := range cases {
+		got, err := Checksum(strings.NewReader("hello"), alg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Errorf("Expected %s checksum %s, got %s", alg, want, got)
+		}
+	}
+
+	if _, err := Checksum(strings.NewReader("hello"), "CRC32"); err == nil {
+		t.Fatal("Expected an error for an unsupported algorithm")
+	}
+}
+
+func TestOptionsChecksum(t *testing.T) {
+	o := &options{algorithm: MD5}
+
+	r := strings.NewReader("xhello")
+	r.ReadByte()
+
+	checksum, err := o.checksum(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if checksum != "5d41402abc4b2a76b9719d911017c592" {
+		t.Fatalf("Expected the checksum of the unread part, got %s", checksum)
+	}
+
+	// The reader is rewound to where it was
+	if rest, _ := ioutil.ReadAll(r); string(rest) != "hello" {
+		t.Fatalf("Expected the reader to be rewound, got %q", rest)
+	}
+
+	// Readers that cannot be rewound are not checksummed
+	if checksum, err := o.checksum(ioutil.NopCloser(strings.NewReader("hello"))); checksum != "" || err != nil {
+		t.Fatalf("Expected no checksum, got %q: %v", checksum, err)
+	}
+
+	o.algorithm = ""
+	if checksum, err := o.checksum(strings.NewReader("hello")); checksum != "" || err != nil {
+		t.Fatalf("Expected no checksum when disabled, got %q: %v", checksum, err)
+	}
+}
+
+func TestPost(t *testing.T) {
+	var got struct {
+		name, content, signature string
+		length                   int64
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got.length = r.ContentLength
+		got.signature = r.Header.Get("X-signature")
+
+		f, fh, err := r.FormFile("file")
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		defer f.Close()
+
+		b, _ := ioutil.ReadAll(f)
+		got.name = fh.Filename
+		got.content = string(b)
+	}))
+	defer srv.Close()
+
+	var progress int64
+	o := &options{
+		client:   srv.Client(),
+		progress: func(n, total int64) { progress = n },
+	}
+	up := &uploadParams{PostURL: srv.URL, Signature: "sig", Metadata: "meta", Expires: "exp"}
+
+	if err := o.post(context.Background(), up, "disk.qcow2", strings.NewReader("data"), 4); err != nil {
+		t.Fatal(err)
+	}
+
+	if got.name != "disk.qcow2" || got.content != "data" || got.signature != "sig" {
+		t.Fatalf("Unexpected upload: %+v", got)
+	}
+	if got.length <= 4 {
+		t.Fatalf("Expected the content length to include the multipart envelope, got %d", got.length)
+	}
+	if progress != 4 {
+		t.Fatalf("Expected progress to reach 4 bytes, got %d", progress)
+	}
+}
+
+func TestPostFailure(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "signature expired", http.StatusForbidden)
+	}))
+	defer srv.Close()
+
+	o := &options{client: srv.Client()}
+	up := &uploadParams{PostURL: srv.URL}
+
+	err := o.post(context.Background(), up, "disk.qcow2", strings.NewReader("data"), -1)
+	if err == nil || !strings.Contains(err.Error(), "signature expired") {
+		t.Fatalf("Expected the error returned by the server, got: %v", err)
+	}
+}
+
+func TestPostPinnedClient(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer srv.Close()
+
+	pool := x509.NewCertPool()
+	pool.AddCert(srv.Certificate())
+
+	// The server name and pin only apply to the API, not to the secondary storage VM
+	cs := cloudstack.NewClient("https://api.example.com/client/api", "key", "secret", true,
+		cloudstack.WithRootCAs(pool),
+		cloudstack.WithServerName("api.example.com"),
+		cloudstack.WithPinnedPublicKeys("c2hhMjU2IG9mIHRoZSBBUEkga2V5"),
+	)
+
+	o := newOptions(cs, nil)
+	up := &uploadParams{PostURL: srv.URL}
+
+	if err := o.post(context.Background(), up, "disk.qcow2", strings.NewReader("data"), 4); err != nil {
+		t.Fatalf("Expected the upload to use the CA certificates only, got: %v", err)
+	}
+
+	if c := cs.HTTPClient().Transport.(*http.Transport).TLSClientConfig; c.ServerName != "api.example.com" || c.VerifyPeerCertificate == nil {
+		t.Fatal("Expected the TLS settings of the API client not to change")
+	}
+}
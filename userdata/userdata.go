@@ -0,0 +1,325 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+// Package userdata composes cloud-init userdata (cloud-config, scripts and
+// boothooks) into the base64 encoded format expected by CloudStack, and
+// decodes userdata returned by CloudStack.
+package userdata
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"net/textproto"
+	"strings"
+
+	"github.com/xanzy/go-cloudstack/v2/cloudstack"
+)
+
+// The maximum size of the base64 encoded userdata accepted by CloudStack
+const (
+	MaxGETSize  = 2 * 1024
+	MaxPOSTSize = 32 * 1024
+)
+
+// The content types of the parts understood by cloud-init
+const (
+	CloudConfig = "text/cloud-config"
+	ShellScript = "text/x-shellscript"
+	Boothook    = "text/cloud-boothook"
+	IncludeURL  = "text/x-include-url"
+)
+
+// Part is a single part of the userdata
+type Part struct {
+	ContentType string
+	Filename    string
+	Content     []byte
+}
+
+// Builder composes one or more parts into userdata
+type Builder struct {
+	parts []Part
+	gzip  bool
+}
+
+// New returns a new empty builder
+func New() *Builder {
+	return &Builder{}
+}
+
+// AddCloudConfig adds a cloud-config YAML document, adding the "#cloud-config" header if needed
+func (b *Builder) AddCloudConfig(yaml string) *Builder {
+	if !strings.HasPrefix(yaml, "#cloud-config") {
+		yaml = "#cloud-config\n" + yaml
+	}
+	return b.AddPart(Part{ContentType: CloudConfig, Filename: "cloud-config.yaml", Content: []byte(yaml)})
+}
+
+// AddShellScript adds a script that is executed once when the instance boots for the first time.
+// The script should start with a "#!" line; if it doesn't, the userdata is always sent as a
+// multipart MIME message, so cloud-init still knows it is a script.
+func (b *Builder) AddShellScript(filename string, script string) *Builder {
+	return b.AddPart(Part{ContentType: ShellScript, Filename: filename, Content: []byte(script)})
+}
+
+// AddBoothook adds a cloud-boothook, which is executed early in the boot process on every boot,
+// adding the "#cloud-boothook" header if needed
+func (b *Builder) AddBoothook(filename string, script string) *Builder {
+	if !strings.HasPrefix(script, "#cloud-boothook") {
+		script = "#cloud-boothook\n" + script
+	}
+	return b.AddPart(Part{ContentType: Boothook, Filename: filename, Content: []byte(script)})
+}
+
+// AddPart adds a part with any content type
+func (b *Builder) AddPart(p Part) *Builder {
+	b.parts = append(b.parts, p)
+	return b
+}
+
+// Gzip sets whether the userdata should be gzip compressed before it is encoded
+func (b *Builder) Gzip(enabled bool) *Builder {
+	b.gzip = enabled
+	return b
+}
+
+// Bytes returns the raw (not base64 encoded) userdata. A single part is returned
+// as is if cloud-init detects its content type from the first line (e.g. "#!" for
+// a shell script), otherwise the parts are combined into a multipart MIME message.
+func (b *Builder) Bytes() ([]byte, error) {
+	if len(b.parts) == 0 {
+		return nil, fmt.Errorf("Userdata must contain at least one part")
+	}
+
+	var data []byte
+	if p := b.parts[0]; len(b.parts) == 1 && detectContentType(p.Content) == p.ContentType {
+		data = p.Content
+	} else {
+		var err error
+		if data, err = b.multipart(); err != nil {
+			return nil, err
+		}
+	}
+
+	if !b.gzip {
+		return data, nil
+	}
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(data); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (b *Builder) multipart() ([]byte, error) {
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+
+	// Use a boundary derived from the content, so the same parts
+	// always result in exactly the same userdata.
+	h := sha256.New()
+	for _, p := range b.parts {
+		h.Write(p.Content)
+	}
+	if err := mw.SetBoundary(fmt.Sprintf("MIMEBOUNDARY-%x", h.Sum(nil)[:8])); err != nil {
+		return nil, err
+	}
+
+	for _, p := range b.parts {
+		header := make(textproto.MIMEHeader)
+		header.Set("Content-Type", fmt.Sprintf("%s; charset=\"utf-8\"", p.ContentType))
+		header.Set("MIME-Version", "1.0")
+		header.Set("Content-Transfer-Encoding", "7bit")
+		if p.Filename != "" {
+			header.Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", p.Filename))
+		}
+
+		w, err := mw.CreatePart(header)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := w.Write(p.Content); err != nil {
+			return nil, err
+		}
+	}
+	if err := mw.Close(); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "Content-Type: multipart/mixed; boundary=%q\r\n", mw.Boundary())
+	fmt.Fprintf(&buf, "MIME-Version: 1.0\r\n\r\n")
+	buf.Write(body.Bytes())
+
+	return buf.Bytes(), nil
+}
+
+// Encode returns the base64 encoded userdata, without checking its size
+func (b *Builder) Encode() (string, error) {
+	data, err := b.Bytes()
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(data), nil
+}
+
+// EncodeFor returns the base64 encoded userdata, after checking that its size
+// does not exceed the limit of the HTTP method the client will use to send it
+func (b *Builder) EncodeFor(cs *cloudstack.CloudStackClient) (string, error) {
+	encoded, err := b.Encode()
+	if err != nil {
+		return "", err
+	}
+	if err := Validate(cs, encoded); err != nil {
+		return "", err
+	}
+	return encoded, nil
+}
+
+// MaxSize returns the maximum size of the encoded userdata when sent using the
+// given client. The deployVirtualMachine and updateVirtualMachine calls use a
+// POST request, unless the client is configured to only use HTTP GET calls.
+func MaxSize(cs *cloudstack.CloudStackClient) int {
+	if cs.HTTPGETOnly {
+		return MaxGETSize
+	}
+	return MaxPOSTSize
+}
+
+// Validate checks if the encoded userdata can be sent using the given client
+func Validate(cs *cloudstack.CloudStackClient, encoded string) error {
+	if max := MaxSize(cs); len(encoded) > max {
+		return fmt.Errorf("Encoded userdata is %d bytes, which exceeds the maximum of %d bytes", len(encoded), max)
+	}
+	return nil
+}
+
+// Decode decodes base64 encoded userdata, decompressing it if it is gzipped
+func Decode(encoded string) ([]byte, error) {
+	data, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) < 2 || data[0] != 0x1f || data[1] != 0x8b {
+		return data, nil
+	}
+
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+
+	return ioutil.ReadAll(zr)
+}
+
+// DecodeParts decodes base64 encoded userdata and splits it into its parts
+func DecodeParts(encoded string) ([]Part, error) {
+	data, err := Decode(encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.HasPrefix(bytes.TrimSpace(data), []byte("Content-Type:")) {
+		return []Part{{ContentType: detectContentType(data), Content: data}}, nil
+	}
+
+	msg, err := mail.ReadMessage(bufio.NewReader(bytes.NewReader(data)))
+	if err != nil {
+		return nil, err
+	}
+
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(mediaType, "multipart/") {
+		body, err := ioutil.ReadAll(msg.Body)
+		if err != nil {
+			return nil, err
+		}
+		return []Part{{ContentType: mediaType, Content: body}}, nil
+	}
+
+	var parts []Part
+	mr := multipart.NewReader(msg.Body, params["boundary"])
+	for {
+		p, err := mr.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		content, err := ioutil.ReadAll(p)
+		if err != nil {
+			return nil, err
+		}
+
+		ct, _, err := mime.ParseMediaType(p.Header.Get("Content-Type"))
+		if err != nil {
+			ct = detectContentType(content)
+		}
+		parts = append(parts, Part{ContentType: ct, Filename: p.FileName(), Content: content})
+	}
+
+	return parts, nil
+}
+
+// detectContentType detects the content type of a single part the same way cloud-init does
+func detectContentType(data []byte) string {
+	switch {
+	case bytes.HasPrefix(data, []byte("#cloud-config")):
+		return CloudConfig
+	case bytes.HasPrefix(data, []byte("#cloud-boothook")):
+		return Boothook
+	case bytes.HasPrefix(data, []byte("#include")):
+		return IncludeURL
+	case bytes.HasPrefix(data, []byte("#!")):
+		return ShellScript
+	default:
+		return "text/plain"
+	}
+}
+
+// GetVirtualMachineUserData returns the decoded userdata of a virtual machine
+func GetVirtualMachineUserData(cs *cloudstack.CloudStackClient, virtualmachineid string) ([]byte, error) {
+	r, err := cs.User.GetVirtualMachineUserData(cs.User.NewGetVirtualMachineUserDataParams(virtualmachineid))
+	if err != nil {
+		return nil, err
+	}
+	return Decode(r.Userdata)
+}
@@ -0,0 +1,76 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package userdata
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestBytesSinglePart(t *testing.T) {
+	cases := []struct {
+		name      string
+		builder   *Builder
+		raw       bool // Whether the part is sent without a MIME message
+		wantStart string
+	}{
+		{"cloud-config", New().AddCloudConfig("packages: [git]\n"), true, "#cloud-config\n"},
+		{"boothook", New().AddBoothook("hook.sh", "#!/bin/sh\necho hi\n"), true, "#cloud-boothook\n#!/bin/sh\n"},
+		{"boothook with header", New().AddBoothook("hook.sh", "#cloud-boothook\n#!/bin/sh\n"), true, "#cloud-boothook\n#!/bin/sh\n"},
+		{"shell script", New().AddShellScript("run.sh", "#!/bin/sh\necho hi\n"), true, "#!/bin/sh\n"},
+		{"shell script without shebang", New().AddShellScript("run.sh", "echo hi\n"), false, "Content-Type: multipart/mixed"},
+		{"plain part", New().AddPart(Part{ContentType: "text/plain", Content: []byte("hello")}), true, "hello"},
+		{"mismatching part", New().AddPart(Part{ContentType: ShellScript, Content: []byte("#cloud-config\n")}), false, "Content-Type: multipart/mixed"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			data, err := c.builder.Bytes()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.HasPrefix(string(data), c.wantStart) {
+				t.Fatalf("Expected userdata starting with %q, got:\n%s", c.wantStart, data)
+			}
+
+			encoded, err := c.builder.Encode()
+			if err != nil {
+				t.Fatal(err)
+			}
+			parts, err := DecodeParts(encoded)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(parts) != 1 || parts[0].ContentType != c.builder.parts[0].ContentType {
+				t.Fatalf("Expected a single %s part, got: %+v", c.builder.parts[0].ContentType, parts)
+			}
+			if !reflect.DeepEqual(parts[0].Content, c.builder.parts[0].Content) {
+				t.Fatalf("Expected content %q, got %q", c.builder.parts[0].Content, parts[0].Content)
+			}
+		})
+	}
+}
+
+func TestBytesNoParts(t *testing.T) {
+	if _, err := New().Bytes(); err == nil {
+		t.Fatal("Expected an error for userdata without parts")
+	}
+}
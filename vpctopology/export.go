@@ -0,0 +1,267 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package vpctopology
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/xanzy/go-cloudstack/v2/internal/common"
+	"github.com/xanzy/go-cloudstack/v2/networkacl"
+)
+
+// Export reads an existing VPC into a spec. References are exported by name,
+// so the spec can be reviewed and used to build the same VPC elsewhere.
+func (b *Builder) Export(vpcid string) (*Spec, error) {
+	vpc, _, err := b.cs.VPC.GetVPCByID(vpcid, b.opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	spec := &Spec{
+		Name:          vpc.Name,
+		Zone:          vpc.Zonename,
+		Offering:      vpc.Vpcofferingid,
+		CIDR:          vpc.Cidr,
+		NetworkDomain: vpc.Networkdomain,
+	}
+	if vpc.Displaytext != vpc.Name {
+		spec.Description = vpc.Displaytext
+	}
+	if o, _, err := b.cs.VPC.GetVPCOfferingByID(vpc.Vpcofferingid, b.opts...); err == nil {
+		spec.Offering = o.Name
+	}
+
+	acls, err := b.exportACLs(vpcid)
+	if err != nil {
+		return nil, fmt.Errorf("Error exporting ACL lists of VPC %s: %v", vpc.Name, err)
+	}
+	for _, acl := range acls {
+		spec.ACLs = append(spec.ACLs, *acl)
+	}
+
+	names := make(map[string]string)
+	for id, acl := range acls {
+		names[id] = acl.Name
+	}
+	aclName := func(id string) string {
+		if id == "" {
+			return ""
+		}
+		if name, ok := names[id]; ok {
+			return name
+		}
+		// The list is not part of the VPC, e.g. default_allow
+		if l, _, err := b.cs.NetworkACL.GetNetworkACLListByID(id, b.opts...); err == nil {
+			names[id] = l.Name
+			return l.Name
+		}
+		return id
+	}
+
+	if spec.Tiers, err = b.exportTiers(vpcid, aclName); err != nil {
+		return nil, fmt.Errorf("Error exporting tiers of VPC %s: %v", vpc.Name, err)
+	}
+	if spec.PrivateGateways, err = b.exportPrivateGateways(vpcid, aclName); err != nil {
+		return nil, fmt.Errorf("Error exporting private gateways of VPC %s: %v", vpc.Name, err)
+	}
+	if spec.VPN, err = b.exportVPN(vpcid); err != nil {
+		return nil, fmt.Errorf("Error exporting site-to-site VPN of VPC %s: %v", vpc.Name, err)
+	}
+
+	sort.Slice(spec.ACLs, func(i, j int) bool {
+		return spec.ACLs[i].Name < spec.ACLs[j].Name
+	})
+
+	return spec, nil
+}
+
+// exportACLs returns the ACL lists of the VPC by ID
+func (b *Builder) exportACLs(vpcid string) (map[string]*ACL, error) {
+	p := b.cs.NetworkACL.NewListNetworkACLListsParams()
+	p.SetVpcid(vpcid)
+	p.SetListall(true)
+	if err := common.ApplyOptions(b.cs, p, b.opts...); err != nil {
+		return nil, err
+	}
+
+	l, err := b.cs.NetworkACL.ListNetworkACLLists(p)
+	if err != nil {
+		return nil, err
+	}
+
+	reconciler := networkacl.New(b.cs, networkacl.WithOptions(b.opts...))
+
+	acls := make(map[string]*ACL)
+	for _, list := range l.NetworkACLLists {
+		// The default lists are shared by all VPCs
+		if list.Vpcid != vpcid {
+			continue
+		}
+
+		items, err := reconciler.Live(list.Id)
+		if err != nil {
+			return nil, fmt.Errorf("Error listing rules of ACL list %s: %v", list.Name, err)
+		}
+
+		acl := &ACL{Name: list.Name, Description: list.Description}
+		for _, i := range items {
+			acl.Rules = append(acl.Rules, i.Rule())
+		}
+		acls[list.Id] = acl
+	}
+
+	return acls, nil
+}
+
+func (b *Builder) exportTiers(vpcid string, aclName func(string) string) ([]Tier, error) {
+	p := b.cs.Network.NewListNetworksParams()
+	p.SetVpcid(vpcid)
+	p.SetListall(true)
+	if err := common.ApplyOptions(b.cs, p, b.opts...); err != nil {
+		return nil, err
+	}
+
+	l, err := b.cs.Network.ListNetworks(p)
+	if err != nil {
+		return nil, err
+	}
+
+	var tiers []Tier
+	for _, n := range l.Networks {
+		t := Tier{
+			Name:     n.Name,
+			CIDR:     n.Cidr,
+			Offering: n.Networkofferingname,
+			ACL:      aclName(n.Aclid),
+		}
+		if n.Displaytext != n.Name {
+			t.Description = n.Displaytext
+		}
+		if _, cidr, err := parseCIDR(n.Cidr); err == nil && gateway(cidr).String() != n.Gateway {
+			t.Gateway = n.Gateway
+		}
+		tiers = append(tiers, t)
+	}
+
+	sort.Slice(tiers, func(i, j int) bool {
+		return tiers[i].Name < tiers[j].Name
+	})
+
+	return tiers, nil
+}
+
+func (b *Builder) exportPrivateGateways(vpcid string, aclName func(string) string) ([]PrivateGateway, error) {
+	p := b.cs.VPC.NewListPrivateGatewaysParams()
+	p.SetVpcid(vpcid)
+	p.SetListall(true)
+	if err := common.ApplyOptions(b.cs, p, b.opts...); err != nil {
+		return nil, err
+	}
+
+	l, err := b.cs.VPC.ListPrivateGateways(p)
+	if err != nil {
+		return nil, err
+	}
+	if len(l.PrivateGateways) == 0 {
+		return nil, nil
+	}
+
+	rp := b.cs.VPC.NewListStaticRoutesParams()
+	rp.SetVpcid(vpcid)
+	rp.SetListall(true)
+	if err := common.ApplyOptions(b.cs, rp, b.opts...); err != nil {
+		return nil, err
+	}
+
+	rl, err := b.cs.VPC.ListStaticRoutes(rp)
+	if err != nil {
+		return nil, err
+	}
+
+	routes := make(map[string][]string)
+	for _, r := range rl.StaticRoutes {
+		routes[r.Gatewayid] = append(routes[r.Gatewayid], r.Cidr)
+	}
+
+	var gateways []PrivateGateway
+	for _, g := range l.PrivateGateways {
+		sort.Strings(routes[g.Id])
+		gateways = append(gateways, PrivateGateway{
+			IPAddress: g.Ipaddress,
+			Gateway:   g.Gateway,
+			Netmask:   g.Netmask,
+			VLAN:      g.Vlan,
+			ACL:       aclName(g.Aclid),
+			SourceNAT: g.Sourcenatsupported,
+			Routes:    routes[g.Id],
+		})
+	}
+
+	sort.Slice(gateways, func(i, j int) bool {
+		return gateways[i].IPAddress < gateways[j].IPAddress
+	})
+
+	return gateways, nil
+}
+
+func (b *Builder) exportVPN(vpcid string) (*VPN, error) {
+	p := b.cs.VPN.NewListVpnGatewaysParams()
+	p.SetVpcid(vpcid)
+	p.SetListall(true)
+	if err := common.ApplyOptions(b.cs, p, b.opts...); err != nil {
+		return nil, err
+	}
+
+	l, err := b.cs.VPN.ListVpnGateways(p)
+	if err != nil {
+		return nil, err
+	}
+	if len(l.VpnGateways) == 0 {
+		return nil, nil
+	}
+
+	cp := b.cs.VPN.NewListVpnConnectionsParams()
+	cp.SetVpcid(vpcid)
+	cp.SetListall(true)
+	if err := common.ApplyOptions(b.cs, cp, b.opts...); err != nil {
+		return nil, err
+	}
+
+	cl, err := b.cs.VPN.ListVpnConnections(cp)
+	if err != nil {
+		return nil, err
+	}
+
+	vpn := &VPN{}
+	for _, c := range cl.VpnConnections {
+		name := c.S2scustomergatewayid
+		if g, _, err := b.cs.VPN.GetVpnCustomerGatewayByID(c.S2scustomergatewayid, b.opts...); err == nil {
+			name = g.Name
+		}
+		vpn.Connections = append(vpn.Connections, Connection{CustomerGateway: name, Passive: c.Passive})
+	}
+
+	sort.Slice(vpn.Connections, func(i, j int) bool {
+		return vpn.Connections[i].CustomerGateway < vpn.Connections[j].CustomerGateway
+	})
+
+	return vpn, nil
+}
@@ -0,0 +1,104 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package vpctopology
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/xanzy/go-cloudstack/v2/cloudstack"
+	"github.com/xanzy/go-cloudstack/v2/networkacl"
+)
+
+// exportResponses are the list responses of a VPC with a tier using an ACL
+// list of the VPC, a tier using default_deny, a private gateway and a VPN
+// connection
+var exportResponses = map[string]string{
+	"listVPCs":                `{"count":1,"vpc":[{"id":"vpc1","name":"prod","displaytext":"prod","zonename":"zone1","vpcofferingid":"off1","cidr":"10.0.0.0/16"}]}`,
+	"listVPCOfferings":        `{"count":1,"vpcoffering":[{"id":"off1","name":"Default VPC offering"}]}`,
+	"listNetworkACLs":         `{"count":2,"networkacl":[{"id":"r2","number":20,"action":"Deny","traffictype":"Egress","protocol":"all","cidrlist":"0.0.0.0/0"},{"id":"r1","number":10,"action":"Allow","traffictype":"Ingress","protocol":"tcp","startport":"443","endport":"443","cidrlist":"0.0.0.0/0"}]}`,
+	"listNetworks":            `{"count":2,"network":[{"id":"n1","name":"web","displaytext":"web","cidr":"10.0.1.0/24","gateway":"10.0.1.1","networkofferingname":"tier","aclid":"acl-web"},{"id":"n2","name":"db","displaytext":"db","cidr":"10.0.2.0/24","gateway":"10.0.2.254","networkofferingname":"tier","aclid":"acl-deny"}]}`,
+	"listPrivateGateways":     `{"count":1,"privategateway":[{"id":"pg1","ipaddress":"172.16.0.2","gateway":"172.16.0.1","netmask":"255.255.255.0","vlan":"100","aclid":"acl-web"}]}`,
+	"listStaticRoutes":        `{"count":1,"staticroute":[{"id":"sr1","gatewayid":"pg1","cidr":"192.168.0.0/16"}]}`,
+	"listVpnGateways":         `{"count":1,"vpngateway":[{"id":"vpn1"}]}`,
+	"listVpnConnections":      `{"count":1,"vpnconnection":[{"id":"c1","s2scustomergatewayid":"cgw1"}]}`,
+	"listVpnCustomerGateways": `{"count":1,"vpncustomergateway":[{"id":"cgw1","name":"office"}]}`,
+}
+
+func TestExport(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		command := q.Get("command")
+
+		resp, ok := exportResponses[command]
+		if command == "listNetworkACLLists" {
+			ok = true
+			if q.Get("id") != "" {
+				resp = `{"count":1,"networkacllist":[{"id":"acl-deny","name":"default_deny"}]}`
+			} else {
+				resp = `{"count":2,"networkacllist":[{"id":"acl-web","name":"web","vpcid":"vpc1"},{"id":"acl-deny","name":"default_deny"}]}`
+			}
+		}
+		if !ok {
+			w.WriteHeader(530)
+			fmt.Fprintf(w, `{"errorresponse":{"errorcode":530,"errortext":"Unexpected command %s"}}`, command)
+			return
+		}
+
+		fmt.Fprintf(w, `{"%sresponse":%s}`, strings.ToLower(command), resp)
+	}))
+	defer srv.Close()
+
+	spec, err := New(cloudstack.NewClient(srv.URL, "key", "secret", false)).Export("vpc1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &Spec{
+		Name:     "prod",
+		Zone:     "zone1",
+		Offering: "Default VPC offering",
+		CIDR:     "10.0.0.0/16",
+		ACLs: []ACL{{Name: "web", Rules: []networkacl.Rule{
+			{Action: "allow", TrafficType: "ingress", Protocol: "tcp", StartPort: 443, CIDRs: []string{"0.0.0.0/0"}},
+			{Action: "deny", TrafficType: "egress", Protocol: "all", CIDRs: []string{"0.0.0.0/0"}},
+		}}},
+		Tiers: []Tier{
+			{Name: "db", CIDR: "10.0.2.0/24", Gateway: "10.0.2.254", Offering: "tier", ACL: "default_deny"},
+			{Name: "web", CIDR: "10.0.1.0/24", Offering: "tier", ACL: "web"},
+		},
+		PrivateGateways: []PrivateGateway{
+			{IPAddress: "172.16.0.2", Gateway: "172.16.0.1", Netmask: "255.255.255.0", VLAN: "100", ACL: "web", Routes: []string{"192.168.0.0/16"}},
+		},
+		VPN: &VPN{Connections: []Connection{{CustomerGateway: "office"}}},
+	}
+	if !reflect.DeepEqual(spec, want) {
+		t.Fatalf("Expected %+v, got %+v", want, spec)
+	}
+
+	// The exported spec can be used to build the same VPC elsewhere
+	if err := spec.Validate(); err != nil {
+		t.Fatalf("Expected the exported spec to be valid, got: %v", err)
+	}
+}
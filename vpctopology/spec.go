@@ -0,0 +1,219 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package vpctopology
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+
+	"github.com/xanzy/go-cloudstack/v2/cloudstack"
+	"github.com/xanzy/go-cloudstack/v2/networkacl"
+)
+
+// Spec describes a VPC and everything in it. All references (zone, offerings,
+// ACL lists and customer gateways) can be either a name or an ID. ACL lists are
+// referenced by the name of a list in the spec, by default_allow or
+// default_deny, or by the ID of an existing list.
+type Spec struct {
+	Name            string           `json:"name" yaml:"name"`
+	Description     string           `json:"description,omitempty" yaml:"description,omitempty"` // Defaults to the name
+	Zone            string           `json:"zone" yaml:"zone"`
+	Offering        string           `json:"offering" yaml:"offering"`
+	CIDR            string           `json:"cidr" yaml:"cidr"`
+	NetworkDomain   string           `json:"network_domain,omitempty" yaml:"network_domain,omitempty"`
+	ACLs            []ACL            `json:"acls,omitempty" yaml:"acls,omitempty"`
+	Tiers           []Tier           `json:"tiers,omitempty" yaml:"tiers,omitempty"`
+	PrivateGateways []PrivateGateway `json:"private_gateways,omitempty" yaml:"private_gateways,omitempty"`
+	VPN             *VPN             `json:"vpn,omitempty" yaml:"vpn,omitempty"`
+}
+
+// ACL is an ACL list and its rules, in order
+type ACL struct {
+	Name        string            `json:"name" yaml:"name"`
+	Description string            `json:"description,omitempty" yaml:"description,omitempty"`
+	Rules       []networkacl.Rule `json:"rules,omitempty" yaml:"rules,omitempty"`
+}
+
+// Tier is a network in the VPC. Its CIDR must be part of the CIDR of the VPC.
+type Tier struct {
+	Name        string `json:"name" yaml:"name"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"` // Defaults to the name
+	CIDR        string `json:"cidr" yaml:"cidr"`
+	Gateway     string `json:"gateway,omitempty" yaml:"gateway,omitempty"` // Defaults to the first address of the CIDR
+	Offering    string `json:"offering" yaml:"offering"`
+	ACL         string `json:"acl,omitempty" yaml:"acl,omitempty"`
+}
+
+// PrivateGateway is a gateway from the VPC into a private network, with the
+// static routes pointing to it
+type PrivateGateway struct {
+	IPAddress string   `json:"ip_address" yaml:"ip_address"`
+	Gateway   string   `json:"gateway" yaml:"gateway"`
+	Netmask   string   `json:"netmask" yaml:"netmask"`
+	VLAN      string   `json:"vlan" yaml:"vlan"`
+	ACL       string   `json:"acl,omitempty" yaml:"acl,omitempty"`
+	SourceNAT bool     `json:"source_nat,omitempty" yaml:"source_nat,omitempty"`
+	Routes    []string `json:"routes,omitempty" yaml:"routes,omitempty"` // The CIDRs routed through the gateway
+}
+
+// VPN is the site-to-site VPN gateway of the VPC and its connections
+type VPN struct {
+	Connections []Connection `json:"connections,omitempty" yaml:"connections,omitempty"`
+}
+
+// Connection is a site-to-site VPN connection to an existing customer gateway
+type Connection struct {
+	CustomerGateway string `json:"customer_gateway" yaml:"customer_gateway"`
+	Passive         bool   `json:"passive,omitempty" yaml:"passive,omitempty"`
+}
+
+// Validate checks the spec without making any API calls. Tier CIDRs must be
+// within the CIDR of the VPC and must not overlap each other, the rules of the
+// ACL lists must be valid, and tiers and private gateways must reference an
+// ACL list that can exist in the VPC.
+func (s *Spec) Validate() error {
+	if s.Name == "" || s.Zone == "" || s.Offering == "" {
+		return fmt.Errorf("A VPC needs a name, zone and offering")
+	}
+
+	_, super, err := parseCIDR(s.CIDR)
+	if err != nil {
+		return fmt.Errorf("Invalid CIDR of VPC %s: %v", s.Name, err)
+	}
+
+	acls := make(map[string]bool)
+	for _, acl := range s.ACLs {
+		if acl.Name == "" {
+			return fmt.Errorf("Every ACL list needs a name")
+		}
+		if acls[acl.Name] {
+			return fmt.Errorf("Duplicate ACL list %s", acl.Name)
+		}
+		if err := networkacl.Validate(acl.Rules); err != nil {
+			return fmt.Errorf("Invalid rules of ACL list %s: %v", acl.Name, err)
+		}
+		acls[acl.Name] = true
+	}
+
+	// Apart from the default lists, only lists of the VPC itself can be used
+	knownACL := func(ref string) bool {
+		return ref == "" || acls[ref] || ref == "default_allow" || ref == "default_deny" || cloudstack.IsID(ref)
+	}
+
+	tiers := make(map[string]*net.IPNet)
+	for _, t := range s.Tiers {
+		if t.Name == "" || t.Offering == "" {
+			return fmt.Errorf("Every tier needs a name and offering")
+		}
+		if _, ok := tiers[t.Name]; ok {
+			return fmt.Errorf("Duplicate tier %s", t.Name)
+		}
+		if !knownACL(t.ACL) {
+			return fmt.Errorf("Tier %s uses unknown ACL list %s", t.Name, t.ACL)
+		}
+
+		ip, cidr, err := parseCIDR(t.CIDR)
+		if err != nil {
+			return fmt.Errorf("Invalid CIDR of tier %s: %v", t.Name, err)
+		}
+		if !ip.Equal(cidr.IP) {
+			return fmt.Errorf("CIDR %s of tier %s has host bits set, did you mean %s?", t.CIDR, t.Name, cidr)
+		}
+		if !within(cidr, super) {
+			return fmt.Errorf("CIDR %s of tier %s is not within CIDR %s of VPC %s", cidr, t.Name, super, s.Name)
+		}
+		for name, other := range tiers {
+			if cidr.Contains(other.IP) || other.Contains(cidr.IP) {
+				return fmt.Errorf("CIDR %s of tier %s overlaps CIDR %s of tier %s", cidr, t.Name, other, name)
+			}
+		}
+		tiers[t.Name] = cidr
+
+		if t.Gateway != "" {
+			gw := net.ParseIP(t.Gateway).To4()
+			if gw == nil || !cidr.Contains(gw) || gw.Equal(cidr.IP) || gw.Equal(broadcast(cidr)) {
+				return fmt.Errorf("Gateway %s of tier %s is not a host address in %s", t.Gateway, t.Name, cidr)
+			}
+		}
+	}
+
+	for _, gw := range s.PrivateGateways {
+		if net.ParseIP(gw.IPAddress).To4() == nil || net.ParseIP(gw.Gateway).To4() == nil {
+			return fmt.Errorf("Private gateway %s needs a valid IP address and gateway", gw.IPAddress)
+		}
+		if mask := net.ParseIP(gw.Netmask).To4(); mask == nil {
+			return fmt.Errorf("Invalid netmask %s of private gateway %s", gw.Netmask, gw.IPAddress)
+		}
+		if gw.VLAN == "" {
+			return fmt.Errorf("Private gateway %s needs a VLAN", gw.IPAddress)
+		}
+		if !knownACL(gw.ACL) {
+			return fmt.Errorf("Private gateway %s uses unknown ACL list %s", gw.IPAddress, gw.ACL)
+		}
+		for _, route := range gw.Routes {
+			if _, _, err := parseCIDR(route); err != nil {
+				return fmt.Errorf("Invalid static route %s of private gateway %s: %v", route, gw.IPAddress, err)
+			}
+		}
+	}
+
+	if s.VPN != nil {
+		for _, c := range s.VPN.Connections {
+			if c.CustomerGateway == "" {
+				return fmt.Errorf("Every VPN connection needs a customer gateway")
+			}
+		}
+	}
+
+	return nil
+}
+
+func parseCIDR(s string) (net.IP, *net.IPNet, error) {
+	ip, cidr, err := net.ParseCIDR(s)
+	if err != nil {
+		return nil, nil, err
+	}
+	if ip.To4() == nil {
+		return nil, nil, fmt.Errorf("%s is not an IPv4 CIDR", s)
+	}
+	return ip.To4(), cidr, nil
+}
+
+// within returns true if the inner CIDR is part of the outer CIDR
+func within(inner, outer *net.IPNet) bool {
+	innerOnes, _ := inner.Mask.Size()
+	outerOnes, _ := outer.Mask.Size()
+	return outer.Contains(inner.IP) && innerOnes >= outerOnes
+}
+
+// broadcast returns the last address of the CIDR
+func broadcast(cidr *net.IPNet) net.IP {
+	ip := make(net.IP, 4)
+	binary.BigEndian.PutUint32(ip, binary.BigEndian.Uint32(cidr.IP.To4())|^binary.BigEndian.Uint32(net.IP(cidr.Mask).To4()))
+	return ip
+}
+
+// gateway returns the first host address of the CIDR
+func gateway(cidr *net.IPNet) net.IP {
+	ip := make(net.IP, 4)
+	binary.BigEndian.PutUint32(ip, binary.BigEndian.Uint32(cidr.IP.To4())+1)
+	return ip
+}
@@ -0,0 +1,104 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package vpctopology
+
+import (
+	"net"
+	"testing"
+
+	"github.com/xanzy/go-cloudstack/v2/networkacl"
+)
+
+func validSpec() *Spec {
+	return &Spec{
+		Name:     "prod",
+		Zone:     "zone1",
+		Offering: "Default VPC offering",
+		CIDR:     "10.0.0.0/16",
+		ACLs: []ACL{{Name: "web", Rules: []networkacl.Rule{
+			{Action: "Allow", TrafficType: "Ingress", Protocol: "tcp", StartPort: 443},
+		}}},
+		Tiers: []Tier{
+			{Name: "web", CIDR: "10.0.1.0/24", Offering: "tier", ACL: "web"},
+			{Name: "db", CIDR: "10.0.2.0/24", Gateway: "10.0.2.254", Offering: "tier", ACL: "default_deny"},
+		},
+		PrivateGateways: []PrivateGateway{
+			{IPAddress: "172.16.0.2", Gateway: "172.16.0.1", Netmask: "255.255.255.0", VLAN: "100", ACL: "2f8d8f3e-4f1c-4b8e-9d8c-6a1f3f0b2c7d", Routes: []string{"192.168.0.0/16"}},
+		},
+		VPN: &VPN{Connections: []Connection{{CustomerGateway: "office"}}},
+	}
+}
+
+func TestValidate(t *testing.T) {
+	if err := validSpec().Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]func(s *Spec){
+		"no name":             func(s *Spec) { s.Name = "" },
+		"invalid cidr":        func(s *Spec) { s.CIDR = "10.0.0.0" },
+		"ipv6 cidr":           func(s *Spec) { s.CIDR = "fd00::/48" },
+		"duplicate acl":       func(s *Spec) { s.ACLs = append(s.ACLs, ACL{Name: "web"}) },
+		"acl no name":         func(s *Spec) { s.ACLs = append(s.ACLs, ACL{}) },
+		"invalid acl rule":    func(s *Spec) { s.ACLs[0].Rules[0].StartPort = 0 },
+		"unknown tier acl":    func(s *Spec) { s.Tiers[0].ACL = "app" },
+		"unknown private acl": func(s *Spec) { s.PrivateGateways[0].ACL = "app" },
+		"duplicate tier":      func(s *Spec) { s.Tiers[1].Name = "web" },
+		"tier no offering":    func(s *Spec) { s.Tiers[0].Offering = "" },
+		"tier host bits":      func(s *Spec) { s.Tiers[0].CIDR = "10.0.1.1/24" },
+		"tier outside vpc":    func(s *Spec) { s.Tiers[0].CIDR = "10.1.1.0/24" },
+		"tier larger":         func(s *Spec) { s.Tiers[0].CIDR = "10.0.0.0/8" },
+		"tiers overlap":       func(s *Spec) { s.Tiers[1].CIDR = "10.0.0.0/23"; s.Tiers[1].Gateway = "" },
+		"gateway outside":     func(s *Spec) { s.Tiers[1].Gateway = "10.0.3.1" },
+		"gateway network":     func(s *Spec) { s.Tiers[1].Gateway = "10.0.2.0" },
+		"gateway broadcast":   func(s *Spec) { s.Tiers[1].Gateway = "10.0.2.255" },
+		"private gateway ip":  func(s *Spec) { s.PrivateGateways[0].IPAddress = "" },
+		"private netmask":     func(s *Spec) { s.PrivateGateways[0].Netmask = "24" },
+		"private no vlan":     func(s *Spec) { s.PrivateGateways[0].VLAN = "" },
+		"invalid route":       func(s *Spec) { s.PrivateGateways[0].Routes = []string{"192.168.0.0"} },
+		"vpn no gateway":      func(s *Spec) { s.VPN.Connections[0].CustomerGateway = "" },
+	}
+
+	for name, fn := range cases {
+		s := validSpec()
+		fn(s)
+		if err := s.Validate(); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestAddresses(t *testing.T) {
+	cases := map[string][2]string{
+		"10.0.1.0/24":    {"10.0.1.1", "10.0.1.255"},
+		"10.0.0.0/16":    {"10.0.0.1", "10.0.255.255"},
+		"192.168.4.0/22": {"192.168.4.1", "192.168.7.255"},
+	}
+
+	for c, want := range cases {
+		_, cidr, _ := net.ParseCIDR(c)
+		if got := gateway(cidr).String(); got != want[0] {
+			t.Errorf("gateway(%s) = %s, want %s", c, got, want[0])
+		}
+		if got := broadcast(cidr).String(); got != want[1] {
+			t.Errorf("broadcast(%s) = %s, want %s", c, got, want[1])
+		}
+	}
+}
@@ -0,0 +1,532 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+// Package vpctopology builds a complete VPC from a declarative spec: the VPC
+// itself, its ACL lists, tiers, private gateways, static routes and site-to-site
+// VPN, created in dependency order. If any step fails, everything created until
+// then is removed again. An existing VPC can be exported into the same spec, for
+// backups and reviews.
+package vpctopology
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/xanzy/go-cloudstack/v2/cloudstack"
+	"github.com/xanzy/go-cloudstack/v2/internal/common"
+	"github.com/xanzy/go-cloudstack/v2/networkacl"
+	"github.com/xanzy/go-cloudstack/v2/waiter"
+)
+
+// DefaultTimeout is the default time to wait for each async job
+const DefaultTimeout = 10 * time.Minute
+
+// Result contains the IDs of the resources created for a VPC
+type Result struct {
+	VPCID             string
+	ACLIDs            map[string]string // By ACL list name
+	TierIDs           map[string]string // By tier name
+	PrivateGatewayIDs map[string]string // By IP address
+	StaticRouteIDs    map[string]string // By CIDR
+	VPNGatewayID      string
+	VPNConnectionIDs  map[string]string // By customer gateway, as given in the spec
+}
+
+// Error is returned when building a VPC failed
+type Error struct {
+	Step         string  // The step that failed
+	Err          error   // The error returned by the failed step
+	RollbackErrs []error // Errors returned while rolling back, meaning resources may have leaked
+}
+
+func (e *Error) Error() string {
+	msg := fmt.Sprintf("Failed to %s: %v", e.Step, e.Err)
+	if len(e.RollbackErrs) > 0 {
+		var errs []string
+		for _, err := range e.RollbackErrs {
+			errs = append(errs, err.Error())
+		}
+		msg += fmt.Sprintf(" (rollback failed: %s)", strings.Join(errs, "; "))
+	}
+	return msg
+}
+
+// Unwrap returns the error returned by the failed step
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Option can be passed to New to set custom options
+type Option func(*Builder)
+
+// WithTimeout sets the time to wait for each async job
+func WithTimeout(timeout time.Duration) Option {
+	return func(b *Builder) {
+		if timeout != 0 {
+			b.timeout = timeout
+		}
+	}
+}
+
+// WithOptions sets option functions (e.g. cloudstack.WithProject) that are
+// applied to all lookups and API calls made by the builder
+func WithOptions(opts ...cloudstack.OptionFunc) Option {
+	return func(b *Builder) {
+		b.opts = append(b.opts, opts...)
+	}
+}
+
+// Builder builds VPCs from a Spec and exports existing VPCs into a Spec
+type Builder struct {
+	cs      *cloudstack.CloudStackClient
+	timeout time.Duration
+	opts    []cloudstack.OptionFunc
+	waiter  *waiter.Waiter
+}
+
+// New returns a new builder using the given client
+func New(cs *cloudstack.CloudStackClient, options ...Option) *Builder {
+	b := &Builder{
+		cs:      cs,
+		timeout: DefaultTimeout,
+	}
+
+	for _, fn := range options {
+		fn(b)
+	}
+	b.waiter = waiter.New(cs, waiter.WithTimeout(b.timeout))
+
+	return b
+}
+
+// build keeps track of a single build, so it can be rolled back
+type build struct {
+	*Builder
+
+	ctx    context.Context
+	spec   *Spec
+	result *Result
+	undo   []func() error
+
+	zoneID           string
+	offeringID       string
+	tierOfferings    []string
+	customerGateways []string
+}
+
+// Build validates the spec, resolves all references and creates the VPC and
+// everything in it. If any step fails, all resources that were created until
+// then are removed again and an *Error is returned.
+func (b *Builder) Build(ctx context.Context, spec *Spec) (*Result, error) {
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+
+	r := &build{
+		Builder: b,
+		ctx:     ctx,
+		spec:    spec,
+		result: &Result{
+			ACLIDs:            make(map[string]string),
+			TierIDs:           make(map[string]string),
+			PrivateGatewayIDs: make(map[string]string),
+			StaticRouteIDs:    make(map[string]string),
+			VPNConnectionIDs:  make(map[string]string),
+		},
+	}
+
+	steps := []struct {
+		name string
+		fn   func() error
+	}{
+		{"resolve references", r.resolve},
+		{"create VPC", r.createVPC},
+		{"create ACL lists", r.createACLs},
+		{"create tiers", r.createTiers},
+		{"create private gateways", r.createPrivateGateways},
+		{"create site-to-site VPN", r.createVPN},
+	}
+
+	for _, step := range steps {
+		if err := step.fn(); err != nil {
+			return nil, &Error{Step: step.name, Err: err, RollbackErrs: r.rollback()}
+		}
+	}
+
+	return r.result, nil
+}
+
+func (r *build) rollback() []error {
+	var errs []error
+	for i := len(r.undo) - 1; i >= 0; i-- {
+		if err := r.undo[i](); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// onUndo registers the deletion of a resource to run when rolling back. It is
+// registered as soon as the create call returned, before waiting for its job,
+// so the resource is also removed when the job fails or times out after
+// CloudStack allocated it. Most create calls return the ID right away; if not,
+// the rollback waits for the job to learn the ID. The rollback does not use
+// the context of the build, as that may be the reason it failed.
+func (r *build) onUndo(what, jobid string, v interface{}, id func() string, del func(id string) (string, error)) {
+	r.undo = append(r.undo, func() error {
+		ctx := context.Background()
+
+		if id() == "" {
+			if err := r.waiter.WaitForJobInto(ctx, jobid, v); err != nil {
+				return fmt.Errorf("Error finding %s created by job %s: %v", what, jobid, err)
+			}
+			if id() == "" {
+				return fmt.Errorf("Error finding %s created by job %s", what, jobid)
+			}
+		}
+
+		delJobID, err := del(id())
+		if err == nil {
+			err = r.waiter.WaitForJob(ctx, delJobID)
+		}
+		if err != nil {
+			return fmt.Errorf("Error deleting %s %s: %v", what, id(), err)
+		}
+		return nil
+	})
+}
+
+func (r *build) resolve() error {
+	var err error
+
+	if r.zoneID, err = r.lookup(r.spec.Zone, func(name string) (string, int, error) {
+		return r.cs.Zone.GetZoneID(name, r.opts...)
+	}); err != nil {
+		return err
+	}
+
+	if r.offeringID, err = r.lookup(r.spec.Offering, func(name string) (string, int, error) {
+		return r.cs.VPC.GetVPCOfferingID(name, r.opts...)
+	}); err != nil {
+		return err
+	}
+
+	for _, t := range r.spec.Tiers {
+		id, err := r.lookup(t.Offering, func(name string) (string, int, error) {
+			return r.cs.NetworkOffering.GetNetworkOfferingID(name, r.opts...)
+		})
+		if err != nil {
+			return err
+		}
+		r.tierOfferings = append(r.tierOfferings, id)
+	}
+
+	if r.spec.VPN != nil {
+		for _, c := range r.spec.VPN.Connections {
+			id, err := r.lookup(c.CustomerGateway, func(name string) (string, int, error) {
+				return r.cs.VPN.GetVpnCustomerGatewayID(name, r.opts...)
+			})
+			if err != nil {
+				return err
+			}
+			r.customerGateways = append(r.customerGateways, id)
+		}
+	}
+
+	return nil
+}
+
+// lookup returns the ID of a name by calling fn, unless the name already is an ID
+func (r *build) lookup(name string, fn func(string) (string, int, error)) (string, error) {
+	if name == "" || cloudstack.IsID(name) {
+		return name, nil
+	}
+	id, _, err := fn(name)
+	return id, err
+}
+
+// aclID returns the ID of an ACL list created from the spec, or of an existing
+// list such as default_allow
+func (r *build) aclID(name string) (string, error) {
+	if id, ok := r.result.ACLIDs[name]; ok {
+		return id, nil
+	}
+	return r.lookup(name, func(name string) (string, int, error) {
+		return r.cs.NetworkACL.GetNetworkACLListID(name, r.opts...)
+	})
+}
+
+func (r *build) createVPC() error {
+	description := r.spec.Description
+	if description == "" {
+		description = r.spec.Name
+	}
+
+	p := r.cs.VPC.NewCreateVPCParams(r.spec.CIDR, description, r.spec.Name, r.offeringID, r.zoneID)
+	if r.spec.NetworkDomain != "" {
+		p.SetNetworkdomain(r.spec.NetworkDomain)
+	}
+	if err := common.ApplyOptions(r.cs, p, r.opts...); err != nil {
+		return err
+	}
+
+	resp, err := r.cs.VPC.CreateVPC(p)
+	if resp != nil {
+		r.onUndo("VPC "+r.spec.Name, resp.JobID, resp, func() string { return resp.Id }, func(id string) (string, error) {
+			dr, err := r.cs.VPC.DeleteVPC(r.cs.VPC.NewDeleteVPCParams(id))
+			if err != nil {
+				return "", err
+			}
+			return dr.JobID, nil
+		})
+	}
+	if err != nil {
+		return err
+	}
+	if err := r.waiter.WaitForJobInto(r.ctx, resp.JobID, resp); err != nil {
+		return err
+	}
+
+	r.result.VPCID = resp.Id
+
+	return nil
+}
+
+func (r *build) createACLs() error {
+	reconciler := networkacl.New(r.cs, networkacl.WithTimeout(r.timeout), networkacl.WithOptions(r.opts...))
+
+	for _, acl := range r.spec.ACLs {
+		p := r.cs.NetworkACL.NewCreateNetworkACLListParams(acl.Name, r.result.VPCID)
+		if acl.Description != "" {
+			p.SetDescription(acl.Description)
+		}
+		if err := common.ApplyOptions(r.cs, p, r.opts...); err != nil {
+			return err
+		}
+
+		resp, err := r.cs.NetworkACL.CreateNetworkACLList(p)
+		if resp != nil {
+			r.onUndo("ACL list "+acl.Name, resp.JobID, resp, func() string { return resp.Id }, func(id string) (string, error) {
+				dr, err := r.cs.NetworkACL.DeleteNetworkACLList(r.cs.NetworkACL.NewDeleteNetworkACLListParams(id))
+				if err != nil {
+					return "", err
+				}
+				return dr.JobID, nil
+			})
+		}
+		if err == nil {
+			err = r.waiter.WaitForJobInto(r.ctx, resp.JobID, resp)
+		}
+		if err != nil {
+			return fmt.Errorf("Error creating ACL list %s: %v", acl.Name, err)
+		}
+
+		r.result.ACLIDs[acl.Name] = resp.Id
+
+		res, err := reconciler.Reconcile(r.ctx, resp.Id, acl.Rules)
+		if err != nil {
+			return fmt.Errorf("Error in rules of ACL list %s: %v", acl.Name, err)
+		}
+		if res.Failed() {
+			return fmt.Errorf("Error creating rules of ACL list %s: %v", acl.Name, res.Err)
+		}
+	}
+
+	return nil
+}
+
+func (r *build) createTiers() error {
+	for n, t := range r.spec.Tiers {
+		_, cidr, _ := parseCIDR(t.CIDR)
+
+		gw := t.Gateway
+		if gw == "" {
+			gw = gateway(cidr).String()
+		}
+
+		description := t.Description
+		if description == "" {
+			description = t.Name
+		}
+
+		p := r.cs.Network.NewCreateNetworkParams(description, t.Name, r.tierOfferings[n], r.zoneID)
+		p.SetVpcid(r.result.VPCID)
+		p.SetGateway(gw)
+		p.SetNetmask(net.IP(cidr.Mask).String())
+		if t.ACL != "" {
+			aclid, err := r.aclID(t.ACL)
+			if err != nil {
+				return fmt.Errorf("Error resolving ACL list %s of tier %s: %v", t.ACL, t.Name, err)
+			}
+			p.SetAclid(aclid)
+		}
+		if err := common.ApplyOptions(r.cs, p, r.opts...); err != nil {
+			return err
+		}
+
+		// Creating a network is not an async call
+		resp, err := r.cs.Network.CreateNetwork(p)
+		if err != nil {
+			return fmt.Errorf("Error creating tier %s: %v", t.Name, err)
+		}
+
+		r.result.TierIDs[t.Name] = resp.Id
+		r.onUndo("tier "+t.Name, "", resp, func() string { return resp.Id }, func(id string) (string, error) {
+			dr, err := r.cs.Network.DeleteNetwork(r.cs.Network.NewDeleteNetworkParams(id))
+			if err != nil {
+				return "", err
+			}
+			return dr.JobID, nil
+		})
+	}
+
+	return nil
+}
+
+func (r *build) createPrivateGateways() error {
+	for _, gw := range r.spec.PrivateGateways {
+		p := r.cs.VPC.NewCreatePrivateGatewayParams(gw.Gateway, gw.IPAddress, gw.Netmask, gw.VLAN, r.result.VPCID)
+		if gw.SourceNAT {
+			p.SetSourcenatsupported(true)
+		}
+		if gw.ACL != "" {
+			aclid, err := r.aclID(gw.ACL)
+			if err != nil {
+				return fmt.Errorf("Error resolving ACL list %s of private gateway %s: %v", gw.ACL, gw.IPAddress, err)
+			}
+			p.SetAclid(aclid)
+		}
+		if err := common.ApplyOptions(r.cs, p, r.opts...); err != nil {
+			return err
+		}
+
+		resp, err := r.cs.VPC.CreatePrivateGateway(p)
+		if resp != nil {
+			r.onUndo("private gateway "+gw.IPAddress, resp.JobID, resp, func() string { return resp.Id }, func(id string) (string, error) {
+				dr, err := r.cs.VPC.DeletePrivateGateway(r.cs.VPC.NewDeletePrivateGatewayParams(id))
+				if err != nil {
+					return "", err
+				}
+				return dr.JobID, nil
+			})
+		}
+		if err == nil {
+			err = r.waiter.WaitForJobInto(r.ctx, resp.JobID, resp)
+		}
+		if err != nil {
+			return fmt.Errorf("Error creating private gateway %s: %v", gw.IPAddress, err)
+		}
+
+		gwid := resp.Id
+		r.result.PrivateGatewayIDs[gw.IPAddress] = gwid
+
+		for _, route := range gw.Routes {
+			rp := r.cs.VPC.NewCreateStaticRouteParams(route, gwid)
+			if err := common.ApplyOptions(r.cs, rp, r.opts...); err != nil {
+				return err
+			}
+
+			resp, err := r.cs.VPC.CreateStaticRoute(rp)
+			if resp != nil {
+				r.onUndo("static route "+route, resp.JobID, resp, func() string { return resp.Id }, func(id string) (string, error) {
+					dr, err := r.cs.VPC.DeleteStaticRoute(r.cs.VPC.NewDeleteStaticRouteParams(id))
+					if err != nil {
+						return "", err
+					}
+					return dr.JobID, nil
+				})
+			}
+			if err == nil {
+				err = r.waiter.WaitForJobInto(r.ctx, resp.JobID, resp)
+			}
+			if err != nil {
+				return fmt.Errorf("Error creating static route %s: %v", route, err)
+			}
+
+			r.result.StaticRouteIDs[route] = resp.Id
+		}
+	}
+
+	return nil
+}
+
+func (r *build) createVPN() error {
+	if r.spec.VPN == nil {
+		return nil
+	}
+
+	p := r.cs.VPN.NewCreateVpnGatewayParams(r.result.VPCID)
+	if err := common.ApplyOptions(r.cs, p, r.opts...); err != nil {
+		return err
+	}
+
+	resp, err := r.cs.VPN.CreateVpnGateway(p)
+	if resp != nil {
+		r.onUndo("VPN gateway", resp.JobID, resp, func() string { return resp.Id }, func(id string) (string, error) {
+			dr, err := r.cs.VPN.DeleteVpnGateway(r.cs.VPN.NewDeleteVpnGatewayParams(id))
+			if err != nil {
+				return "", err
+			}
+			return dr.JobID, nil
+		})
+	}
+	if err == nil {
+		err = r.waiter.WaitForJobInto(r.ctx, resp.JobID, resp)
+	}
+	if err != nil {
+		return fmt.Errorf("Error creating VPN gateway: %v", err)
+	}
+
+	gwid := resp.Id
+	r.result.VPNGatewayID = gwid
+
+	for n, c := range r.spec.VPN.Connections {
+		cp := r.cs.VPN.NewCreateVpnConnectionParams(r.customerGateways[n], gwid)
+		if c.Passive {
+			cp.SetPassive(true)
+		}
+		if err := common.ApplyOptions(r.cs, cp, r.opts...); err != nil {
+			return err
+		}
+
+		resp, err := r.cs.VPN.CreateVpnConnection(cp)
+		if resp != nil {
+			r.onUndo("VPN connection to "+c.CustomerGateway, resp.JobID, resp, func() string { return resp.Id }, func(id string) (string, error) {
+				dr, err := r.cs.VPN.DeleteVpnConnection(r.cs.VPN.NewDeleteVpnConnectionParams(id))
+				if err != nil {
+					return "", err
+				}
+				return dr.JobID, nil
+			})
+		}
+		if err == nil {
+			err = r.waiter.WaitForJobInto(r.ctx, resp.JobID, resp)
+		}
+		if err != nil {
+			return fmt.Errorf("Error creating VPN connection to %s: %v", c.CustomerGateway, err)
+		}
+
+		r.result.VPNConnectionIDs[c.CustomerGateway] = resp.Id
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package vpctopology
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRollback(t *testing.T) {
+	var deleted []string
+	del := func(fail bool) func(id string) (string, error) {
+		return func(id string) (string, error) {
+			deleted = append(deleted, id)
+			if fail {
+				return "", errors.New("in use")
+			}
+			return "", nil
+		}
+	}
+	id := func(id string) func() string {
+		return func() string { return id }
+	}
+
+	r := &build{Builder: New(nil)}
+	r.onUndo("VPC", "", nil, id("vpc1"), del(false))
+	r.onUndo("tier", "", nil, id("tier1"), del(true))
+	r.onUndo("tier", "", nil, id("tier2"), del(false))
+
+	errs := r.rollback()
+
+	if !reflect.DeepEqual(deleted, []string{"tier2", "tier1", "vpc1"}) {
+		t.Fatalf("Expected the resources to be deleted in reverse order, got %v", deleted)
+	}
+	if len(errs) != 1 || !strings.Contains(errs[0].Error(), "Error deleting tier tier1: in use") {
+		t.Fatalf("Expected the failed deletion to be reported, got %v", errs)
+	}
+
+	err := &Error{Step: "create tiers", Err: errors.New("failed"), RollbackErrs: errs}
+	if got := err.Error(); got != "Failed to create tiers: failed (rollback failed: Error deleting tier tier1: in use)" {
+		t.Fatalf("Unexpected error message: %s", got)
+	}
+	if !errors.Is(err, err.Err) {
+		t.Fatal("Expected the error to unwrap to the error of the step")
+	}
+}
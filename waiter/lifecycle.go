@@ -0,0 +1,164 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package waiter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/xanzy/go-cloudstack/v2/cloudstack"
+	"github.com/xanzy/go-cloudstack/v2/internal/common"
+)
+
+// The states of a virtual machine
+const (
+	Running   = "Running"
+	Stopped   = "Stopped"
+	Starting  = "Starting"
+	Stopping  = "Stopping"
+	Migrating = "Migrating"
+	Destroyed = "Destroyed"
+	Expunging = "Expunging"
+	Error     = "Error"
+)
+
+// stableStates are the virtual machine states that do not change by themselves
+var stableStates = []string{Running, Stopped, Destroyed, Error}
+
+// Action is an API call that moves a virtual machine to another state
+type Action string
+
+// The actions used to move a virtual machine between states
+const (
+	Start   Action = "start"
+	Stop    Action = "stop"
+	Recover Action = "recover"
+	Destroy Action = "destroy"
+)
+
+// resultStates are the states a virtual machine is in after an action
+var resultStates = map[Action]string{
+	Start:   Running,
+	Stop:    Stopped,
+	Recover: Stopped,
+	Destroy: Destroyed,
+}
+
+// Transitions lists, for each stable state, the actions needed to move a
+// virtual machine to another stable state. Transitions that are not listed
+// are not possible.
+var Transitions = map[string]map[string][]Action{
+	Running: {
+		Stopped:   {Stop},
+		Destroyed: {Destroy},
+	},
+	Stopped: {
+		Running:   {Start},
+		Destroyed: {Destroy},
+	},
+	Destroyed: {
+		Stopped: {Recover},
+		Running: {Recover, Start},
+	},
+	Error: {
+		Destroyed: {Destroy},
+	},
+}
+
+// EnsureVirtualMachineState moves the virtual machine to the given state by
+// issuing the actions listed in Transitions. If the virtual machine is in a
+// transitional state (e.g. Starting) it first waits for it to become stable.
+func (w *Waiter) EnsureVirtualMachineState(ctx context.Context, id string, state string) (*cloudstack.VirtualMachine, error) {
+	vm, err := w.WaitForVirtualMachineState(ctx, id, stableStates...)
+	if err != nil {
+		return nil, err
+	}
+
+	if vm.State == state {
+		return vm, nil
+	}
+
+	actions, ok := Transitions[vm.State][state]
+	if !ok {
+		return nil, fmt.Errorf("Cannot move virtual machine %s from state %s to state %s", id, vm.State, state)
+	}
+
+	for _, action := range actions {
+		if err := w.do(ctx, id, action); err != nil {
+			return nil, fmt.Errorf("Failed to %s virtual machine %s: %w", action, id, err)
+		}
+
+		vm, err = w.WaitForVirtualMachineState(ctx, id, resultStates[action])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return vm, nil
+}
+
+// do issues a single action and waits for its async job to finish
+func (w *Waiter) do(ctx context.Context, id string, action Action) error {
+	var jobid string
+
+	switch action {
+	case Start:
+		p := w.cs.VirtualMachine.NewStartVirtualMachineParams(id)
+		if err := common.ApplyOptions(w.cs, p, w.opts...); err != nil {
+			return err
+		}
+		r, err := w.cs.VirtualMachine.StartVirtualMachine(p)
+		if err != nil {
+			return err
+		}
+		jobid = r.JobID
+	case Stop:
+		p := w.cs.VirtualMachine.NewStopVirtualMachineParams(id)
+		if err := common.ApplyOptions(w.cs, p, w.opts...); err != nil {
+			return err
+		}
+		r, err := w.cs.VirtualMachine.StopVirtualMachine(p)
+		if err != nil {
+			return err
+		}
+		jobid = r.JobID
+	case Recover:
+		p := w.cs.VirtualMachine.NewRecoverVirtualMachineParams(id)
+		if err := common.ApplyOptions(w.cs, p, w.opts...); err != nil {
+			return err
+		}
+		_, err := w.cs.VirtualMachine.RecoverVirtualMachine(p)
+		return err
+	case Destroy:
+		p := w.cs.VirtualMachine.NewDestroyVirtualMachineParams(id)
+		if err := common.ApplyOptions(w.cs, p, w.opts...); err != nil {
+			return err
+		}
+		r, err := w.cs.VirtualMachine.DestroyVirtualMachine(p)
+		if err != nil {
+			return err
+		}
+		jobid = r.JobID
+	default:
+		return fmt.Errorf("Unknown action: %s", action)
+	}
+
+	return w.WaitForJob(ctx, jobid)
+}
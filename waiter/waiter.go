@@ -0,0 +1,461 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+// Package waiter polls CloudStack until resources reach a desired state, and
+// drives virtual machines from one lifecycle state to another.
+package waiter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/xanzy/go-cloudstack/v2/cloudstack"
+	"github.com/xanzy/go-cloudstack/v2/internal/common"
+)
+
+// Backoff controls the interval between two polls
+type Backoff struct {
+	Initial time.Duration // The interval after the first poll
+	Max     time.Duration // The maximum interval
+	Factor  float64       // The factor the interval grows with after each poll
+}
+
+// DefaultBackoff is used when no other backoff is configured
+var DefaultBackoff = Backoff{
+	Initial: 2 * time.Second,
+	Max:     30 * time.Second,
+	Factor:  1.5,
+}
+
+// next returns the interval to use after the given interval
+func (b Backoff) next(interval time.Duration) time.Duration {
+	if interval == 0 {
+		return b.Initial
+	}
+	if next := time.Duration(float64(interval) * b.Factor); next < b.Max {
+		return next
+	}
+	return b.Max
+}
+
+// Poll calls fn until it returns true or an error, sleeping according to the
+// backoff between the calls. It returns the error of the context if the context
+// is done before fn returns true.
+func Poll(ctx context.Context, b Backoff, fn func() (bool, error)) error {
+	var interval time.Duration
+	for {
+		done, err := fn()
+		if err != nil || done {
+			return err
+		}
+
+		interval = b.next(interval)
+
+		t := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return ctx.Err()
+		case <-t.C:
+		}
+	}
+}
+
+// StateError is returned when a resource reaches a state it cannot recover from
+// by itself while waiting for another state
+type StateError struct {
+	Kind  string
+	ID    string
+	State string
+}
+
+func (e *StateError) Error() string {
+	return fmt.Sprintf("%s %s is in state %s", e.Kind, e.ID, e.State)
+}
+
+// retrier keeps track of failed polls. These are retried, as they are often
+// caused by a management server that is temporarily unavailable.
+type retrier struct {
+	max    int
+	failed int
+	last   error
+}
+
+// retry records the error of a failed poll and returns true if it should be retried
+func (r *retrier) retry(err error) bool {
+	r.failed++
+	r.last = err
+	return r.max == 0 || r.failed < r.max
+}
+
+// reset is called after a successful poll
+func (r *retrier) reset() {
+	r.failed = 0
+	r.last = nil
+}
+
+// timeout returns the error to return when the context is done while waiting
+func (r *retrier) timeout(msg string, err error) error {
+	if r.last != nil {
+		return fmt.Errorf("%s (last error: %v): %w", msg, r.last, err)
+	}
+	return fmt.Errorf("%s: %w", msg, err)
+}
+
+// Option can be passed to New to set custom options
+type Option func(*Waiter)
+
+// WithBackoff sets the backoff used between two polls
+func WithBackoff(b Backoff) Option {
+	return func(w *Waiter) {
+		w.backoff = b
+	}
+}
+
+// WithTimeout limits the time spent in each wait (e.g. a single WaitForJob call)
+// to the given duration, in addition to any deadline of the context passed in
+func WithTimeout(timeout time.Duration) Option {
+	return func(w *Waiter) {
+		w.timeout = timeout
+	}
+}
+
+// WithMaxErrors sets the number of consecutive failed polls after which waiting
+// stops and the last error is returned. By default failed polls are retried
+// until the context is done.
+func WithMaxErrors(n int) Option {
+	return func(w *Waiter) {
+		w.maxErrors = n
+	}
+}
+
+// WithOptions sets option functions (e.g. cloudstack.WithProject) that are
+// applied to all lookups and lifecycle actions made by the waiter
+func WithOptions(opts ...cloudstack.OptionFunc) Option {
+	return func(w *Waiter) {
+		w.opts = append(w.opts, opts...)
+	}
+}
+
+// WithTemplateFilter sets the template filter used to lookup templates (default: all)
+func WithTemplateFilter(filter string) Option {
+	return func(w *Waiter) {
+		if filter != "" {
+			w.templateFilter = filter
+		}
+	}
+}
+
+// Waiter waits for resources to reach a state
+type Waiter struct {
+	cs             *cloudstack.CloudStackClient
+	backoff        Backoff
+	timeout        time.Duration
+	maxErrors      int
+	opts           []cloudstack.OptionFunc
+	templateFilter string
+}
+
+// New returns a new waiter using the given client
+func New(cs *cloudstack.CloudStackClient, options ...Option) *Waiter {
+	w := &Waiter{
+		cs:             cs,
+		backoff:        DefaultBackoff,
+		templateFilter: "all",
+	}
+
+	for _, fn := range options {
+		fn(w)
+	}
+
+	return w
+}
+
+// withTimeout returns the context for a single wait, limited by the timeout
+func (w *Waiter) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if w.timeout > 0 {
+		return context.WithTimeout(ctx, w.timeout)
+	}
+	return context.WithCancel(ctx)
+}
+
+// wait polls get until it returns one of the states, or until it returns one of
+// the failed states that was not asked for. Errors returned by get are retried.
+func (w *Waiter) wait(ctx context.Context, kind, id string, states, failed []string, get func() (string, error)) error {
+	ctx, cancel := w.withTimeout(ctx)
+	defer cancel()
+
+	var current string
+	errs := &retrier{max: w.maxErrors}
+
+	err := Poll(ctx, w.backoff, func() (bool, error) {
+		state, err := get()
+		if err != nil {
+			if errs.retry(err) {
+				return false, nil
+			}
+			return false, err
+		}
+		errs.reset()
+		current = state
+
+		if contains(states, state) {
+			return true, nil
+		}
+		if contains(failed, state) {
+			return false, &StateError{Kind: kind, ID: id, State: state}
+		}
+
+		return false, nil
+	})
+
+	if err == ctx.Err() && err != nil {
+		return errs.timeout(fmt.Sprintf("Timeout while waiting for %s %s to reach state %s (state: %s)",
+			kind, id, strings.Join(states, " or "), current), err)
+	}
+
+	return err
+}
+
+// WaitForVirtualMachineState waits until the virtual machine reaches one of the states
+func (w *Waiter) WaitForVirtualMachineState(ctx context.Context, id string, states ...string) (*cloudstack.VirtualMachine, error) {
+	var vm *cloudstack.VirtualMachine
+
+	err := w.wait(ctx, "virtual machine", id, states, []string{Error, Destroyed, Expunging}, func() (string, error) {
+		var err error
+		vm, _, err = w.cs.VirtualMachine.GetVirtualMachineByID(id, w.opts...)
+		if err != nil {
+			return "", err
+		}
+		return vm.State, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return vm, nil
+}
+
+// WaitForVolumeState waits until the volume reaches one of the states (e.g. Ready)
+func (w *Waiter) WaitForVolumeState(ctx context.Context, id string, states ...string) (*cloudstack.Volume, error) {
+	var vol *cloudstack.Volume
+
+	err := w.wait(ctx, "volume", id, states, []string{"UploadError", "UploadAbandoned", "Destroy", "Expunged"}, func() (string, error) {
+		var err error
+		vol, _, err = w.cs.Volume.GetVolumeByID(id, w.opts...)
+		if err != nil {
+			return "", err
+		}
+		return vol.State, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return vol, nil
+}
+
+// WaitForSnapshotState waits until the snapshot reaches one of the states (e.g. BackedUp)
+func (w *Waiter) WaitForSnapshotState(ctx context.Context, id string, states ...string) (*cloudstack.Snapshot, error) {
+	var snap *cloudstack.Snapshot
+
+	err := w.wait(ctx, "snapshot", id, states, []string{"Error", "Destroyed"}, func() (string, error) {
+		var err error
+		snap, _, err = w.cs.Snapshot.GetSnapshotByID(id, w.opts...)
+		if err != nil {
+			return "", err
+		}
+		return snap.State, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return snap, nil
+}
+
+// WaitForTemplateReady waits until the template is ready in the given zone. A
+// template that is registered in multiple zones has a separate entry per zone,
+// so the zone can only be left empty if the template exists in a single zone.
+func (w *Waiter) WaitForTemplateReady(ctx context.Context, id string, zoneid string) (*cloudstack.Template, error) {
+	var tmpl *cloudstack.Template
+
+	err := w.wait(ctx, "template", id, []string{"ready"}, []string{"failed"}, func() (string, error) {
+		p := w.cs.Template.NewListTemplatesParams(w.templateFilter)
+		p.SetId(id)
+		if zoneid != "" {
+			p.SetZoneid(zoneid)
+		}
+		if err := common.ApplyOptions(w.cs, p, w.opts...); err != nil {
+			return "", err
+		}
+
+		l, err := w.cs.Template.ListTemplates(p)
+		if err != nil {
+			return "", err
+		}
+		if l.Count == 0 {
+			return "", fmt.Errorf("No match found for template %s in zone %s", id, zoneid)
+		}
+		tmpl = l.Templates[0]
+
+		status := strings.ToLower(tmpl.Status)
+		switch {
+		case tmpl.Isready:
+			return "ready", nil
+		case strings.Contains(status, "error") || strings.Contains(status, "failed"):
+			return "failed", nil
+		default:
+			return tmpl.Status, nil
+		}
+	})
+	if err != nil {
+		if e, ok := err.(*StateError); ok {
+			e.State = tmpl.Status
+		}
+		return nil, err
+	}
+
+	return tmpl, nil
+}
+
+// WaitForHostResourceState waits until the resource state of the host reaches
+// one of the states (e.g. Maintenance or Enabled)
+func (w *Waiter) WaitForHostResourceState(ctx context.Context, id string, states ...string) (*cloudstack.Host, error) {
+	var host *cloudstack.Host
+
+	err := w.wait(ctx, "host", id, states, []string{"ErrorInMaintenance", "ErrorInPrepareForMaintenance"}, func() (string, error) {
+		var err error
+		host, _, err = w.cs.Host.GetHostByID(id, w.opts...)
+		if err != nil {
+			return "", err
+		}
+		return host.Resourcestate, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return host, nil
+}
+
+// WaitForRouterState waits until the router reaches one of the states
+func (w *Waiter) WaitForRouterState(ctx context.Context, id string, states ...string) (*cloudstack.Router, error) {
+	var router *cloudstack.Router
+
+	err := w.wait(ctx, "router", id, states, []string{Error, Destroyed}, func() (string, error) {
+		var err error
+		router, _, err = w.cs.Router.GetRouterByID(id, w.opts...)
+		if err != nil {
+			return "", err
+		}
+		return router.State, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return router, nil
+}
+
+// WaitForJob waits until an async job is finished, returning an error if the job
+// failed. Calls made with an async client only return once their job finished,
+// so for those this only queries the (finished) job once more.
+func (w *Waiter) WaitForJob(ctx context.Context, jobid string) error {
+	_, err := w.WaitForJobResult(ctx, jobid)
+	return err
+}
+
+// WaitForJobResult waits until an async job is finished and returns its raw
+// result, which (like the response of the API call itself) is an object with
+// a single key wrapping the actual result. Failing to query the job is retried,
+// but a failed job is returned immediately.
+func (w *Waiter) WaitForJobResult(ctx context.Context, jobid string) (json.RawMessage, error) {
+	if jobid == "" {
+		return nil, nil
+	}
+
+	ctx, cancel := w.withTimeout(ctx)
+	defer cancel()
+
+	var result json.RawMessage
+	errs := &retrier{max: w.maxErrors}
+
+	err := Poll(ctx, w.backoff, func() (bool, error) {
+		r, err := w.cs.Asyncjob.QueryAsyncJobResult(w.cs.Asyncjob.NewQueryAsyncJobResultParams(jobid))
+		if err != nil {
+			if errs.retry(err) {
+				return false, nil
+			}
+			return false, err
+		}
+		errs.reset()
+
+		switch r.Jobstatus {
+		case 1:
+			result = r.Jobresult
+			return true, nil
+		case 2:
+			return false, fmt.Errorf("Job %s failed: %s", jobid, string(r.Jobresult))
+		default:
+			return false, nil
+		}
+	})
+
+	if err == ctx.Err() && err != nil {
+		return nil, errs.timeout(fmt.Sprintf("Timeout while waiting for job %s", jobid), err)
+	}
+
+	return result, err
+}
+
+// WaitForJobInto waits until an async job is finished and unmarshals the
+// wrapped result into v. Nothing is unmarshalled if the job ID is empty. Note
+// that responses returned by an async client still contain their job ID, so
+// for those the (already finished) job result is fetched once more.
+func (w *Waiter) WaitForJobInto(ctx context.Context, jobid string, v interface{}) error {
+	b, err := w.WaitForJobResult(ctx, jobid)
+	if err != nil || b == nil {
+		return err
+	}
+
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+	for _, raw := range m {
+		if err := json.Unmarshal(raw, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,260 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package waiter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/xanzy/go-cloudstack/v2/cloudstack"
+)
+
+var fastBackoff = Backoff{Initial: time.Millisecond, Max: 5 * time.Millisecond, Factor: 2}
+
+func TestBackoffNext(t *testing.T) {
+	b := Backoff{Initial: time.Second, Max: 5 * time.Second, Factor: 2}
+
+	var interval time.Duration
+	for i, want := range []time.Duration{1, 2, 4, 5, 5} {
+		interval = b.next(interval)
+		if interval != want*time.Second {
+			t.Fatalf("Expected interval %d to be %ds, got %s", i, want, interval)
+		}
+	}
+}
+
+func TestPoll(t *testing.T) {
+	var calls int
+	err := Poll(context.Background(), fastBackoff, func() (bool, error) {
+		calls++
+		return calls == 3, nil
+	})
+	if err != nil || calls != 3 {
+		t.Fatalf("Expected 3 calls without an error, got %d calls: %v", calls, err)
+	}
+
+	failed := errors.New("failed")
+	err = Poll(context.Background(), fastBackoff, func() (bool, error) {
+		return false, failed
+	})
+	if err != failed {
+		t.Fatalf("Expected the error of fn, got: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	err = Poll(ctx, fastBackoff, func() (bool, error) {
+		return false, nil
+	})
+	if err != context.DeadlineExceeded {
+		t.Fatalf("Expected the error of the context, got: %v", err)
+	}
+}
+
+// newVMServer returns a client for a server that reports the given states of
+// a virtual machine, one per poll, repeating the last state. An empty state
+// fails the poll.
+func newVMServer(states ...string) (*cloudstack.CloudStackClient, *httptest.Server) {
+	var polls int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		i := int(atomic.AddInt32(&polls, 1)) - 1
+		if i >= len(states) {
+			i = len(states) - 1
+		}
+		if states[i] == "" {
+			w.WriteHeader(530)
+			w.Write([]byte(`{"errorresponse":{"errorcode":530,"errortext":"Internal error"}}`))
+			return
+		}
+		fmt.Fprintf(w, `{"listvirtualmachinesresponse":{"count":1,"virtualmachine":[{"id":"vm1","state":"%s"}]}}`, states[i])
+	}))
+
+	return cloudstack.NewClient(srv.URL, "key", "secret", false), srv
+}
+
+func TestWaitForVirtualMachineState(t *testing.T) {
+	cs, srv := newVMServer(Starting, Starting, Running)
+	defer srv.Close()
+
+	w := New(cs, WithBackoff(fastBackoff))
+
+	vm, err := w.WaitForVirtualMachineState(context.Background(), "vm1", Running)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if vm.State != Running {
+		t.Fatalf("Expected state %s, got %s", Running, vm.State)
+	}
+}
+
+func TestWaitForVirtualMachineStateFailed(t *testing.T) {
+	cs, srv := newVMServer(Starting, Error)
+	defer srv.Close()
+
+	w := New(cs, WithBackoff(fastBackoff))
+
+	_, err := w.WaitForVirtualMachineState(context.Background(), "vm1", Running)
+
+	var serr *StateError
+	if !errors.As(err, &serr) || serr.State != Error {
+		t.Fatalf("Expected a StateError for state %s, got: %v", Error, err)
+	}
+
+	// A failed state that is asked for is not an error
+	if _, err := w.WaitForVirtualMachineState(context.Background(), "vm1", Error); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestWaitForVirtualMachineStateTimeout(t *testing.T) {
+	cs, srv := newVMServer(Starting)
+	defer srv.Close()
+
+	w := New(cs, WithBackoff(fastBackoff))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := w.WaitForVirtualMachineState(ctx, "vm1", Running)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected a wrapped context error, got: %v", err)
+	}
+}
+
+func TestWithTimeout(t *testing.T) {
+	cs, srv := newVMServer(Starting)
+	defer srv.Close()
+
+	w := New(cs, WithBackoff(fastBackoff), WithTimeout(20*time.Millisecond))
+
+	_, err := w.WaitForVirtualMachineState(context.Background(), "vm1", Running)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected the wait to time out, got: %v", err)
+	}
+}
+
+func TestWaitForVirtualMachineStateRetries(t *testing.T) {
+	cs, srv := newVMServer(Starting, "", "", Running)
+	defer srv.Close()
+
+	vm, err := New(cs, WithBackoff(fastBackoff)).WaitForVirtualMachineState(context.Background(), "vm1", Running)
+	if err != nil {
+		t.Fatalf("Expected the failed polls to be retried, got: %v", err)
+	}
+	if vm.State != Running {
+		t.Fatalf("Expected state %s, got %s", Running, vm.State)
+	}
+
+	// The number of consecutive failed polls can be limited
+	cs, srv = newVMServer(Starting, "", "", Running)
+	defer srv.Close()
+
+	_, err = New(cs, WithBackoff(fastBackoff), WithMaxErrors(2)).WaitForVirtualMachineState(context.Background(), "vm1", Running)
+	if err == nil || !strings.Contains(err.Error(), "Internal error") {
+		t.Fatalf("Expected the error of the last poll, got: %v", err)
+	}
+
+	// Otherwise the last error is part of the timeout error
+	cs, srv = newVMServer(Starting, "")
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err = New(cs, WithBackoff(fastBackoff)).WaitForVirtualMachineState(ctx, "vm1", Running)
+	if !errors.Is(err, context.DeadlineExceeded) || !strings.Contains(err.Error(), "Internal error") {
+		t.Fatalf("Expected a wrapped context error including the last error, got: %v", err)
+	}
+}
+
+func TestWaitForJobResult(t *testing.T) {
+	// The job is queried three times: a failed poll, pending and done
+	var polls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch atomic.AddInt32(&polls, 1) {
+		case 1:
+			w.WriteHeader(530)
+			w.Write([]byte(`{"errorresponse":{"errorcode":530,"errortext":"Internal error"}}`))
+		case 2:
+			w.Write([]byte(`{"queryasyncjobresultresponse":{"jobstatus":0}}`))
+		default:
+			w.Write([]byte(`{"queryasyncjobresultresponse":{"jobstatus":1,"jobresult":{"virtualmachine":{"id":"vm1"}}}}`))
+		}
+	}))
+	defer srv.Close()
+
+	w := New(cloudstack.NewClient(srv.URL, "key", "secret", false), WithBackoff(fastBackoff))
+
+	var vm cloudstack.VirtualMachine
+	if err := w.WaitForJobInto(context.Background(), "job1", &vm); err != nil {
+		t.Fatalf("Expected the failed poll to be retried, got: %v", err)
+	}
+	if vm.Id != "vm1" {
+		t.Fatalf("Expected the result of the job, got %+v", vm)
+	}
+
+	// A failed job is not retried
+	atomic.StoreInt32(&polls, 0)
+	failed := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&polls, 1)
+		w.Write([]byte(`{"queryasyncjobresultresponse":{"jobstatus":2,"jobresult":{"errortext":"failed"}}}`))
+	}))
+	defer failed.Close()
+
+	w = New(cloudstack.NewClient(failed.URL, "key", "secret", false), WithBackoff(fastBackoff))
+	err := w.WaitForJob(context.Background(), "job1")
+	if n := atomic.LoadInt32(&polls); err == nil || n != 1 {
+		t.Fatalf("Expected the failed job to be returned after one poll, got %d polls: %v", n, err)
+	}
+}
+
+func TestEnsureVirtualMachineState(t *testing.T) {
+	cs, srv := newVMServer(Starting, Running)
+	defer srv.Close()
+
+	w := New(cs, WithBackoff(fastBackoff))
+
+	// The virtual machine already reaches the state by itself
+	vm, err := w.EnsureVirtualMachineState(context.Background(), "vm1", Running)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if vm.State != Running {
+		t.Fatalf("Expected state %s, got %s", Running, vm.State)
+	}
+
+	cs, srv = newVMServer(Error)
+	defer srv.Close()
+
+	w = New(cs, WithBackoff(fastBackoff))
+
+	if _, err := w.EnsureVirtualMachineState(context.Background(), "vm1", Running); err == nil {
+		t.Fatalf("Expected an error moving from state %s to state %s", Error, Running)
+	}
+}